@@ -2,15 +2,24 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
+	"os"
 
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
 	"github.com/kunitsucom/ddlctl/pkg/ddlctl"
 )
 
+// exitCodeDriftDetected is the exit code of `ddlctl diff --exit-code` when there is a difference.
+const exitCodeDriftDetected = 2
+
 func main() {
 	ctx := context.Background()
 
 	if err := ddlctl.DDLCtl(ctx); err != nil {
+		if errors.Is(err, apperr.ErrDriftDetected) {
+			os.Exit(exitCodeDriftDetected)
+		}
 		log.Fatalf("ddlctl: %+v", err)
 	}
 }
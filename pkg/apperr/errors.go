@@ -10,9 +10,21 @@ var (
 	ErrCanceled                           = errors.New("canceled")
 	ErrDialectIsEmpty                     = errors.New("dialect is empty")
 	ErrDDLTagGoAnnotationNotFoundInSource = errors.New("go-ddl-tag annotation not found in source")
+	ErrOneArgumentRequired                = errors.New("one argument required")
 	ErrTwoArgumentsRequired               = errors.New("two arguments required")
+	ErrThreeArgumentsRequired             = errors.New("three arguments required")
 	ErrBothArgumentsIsDSN                 = errors.New("both arguments is dsn")
 	ErrBothArgumentsAreNotDSNOrSQLFile    = errors.New("both arguments are not dsn or sql file")
+	ErrDestructiveChangeNotAllowed        = errors.New("destructive change not allowed")
+	ErrPlanVersionNotSupported            = errors.New("plan version not supported")
+	ErrPlanDialectMismatch                = errors.New("plan dialect mismatch")
+	ErrSchemaChangedSincePlan             = errors.New("schema changed since plan")
+	ErrPlanDDLMismatch                    = errors.New("plan ddl mismatch")
+	ErrTxSplitRequired                    = errors.New("transaction split required")
+	ErrShadowNotConverged                 = errors.New("schema not converged on shadow database")
+	ErrDriftDetected                      = errors.New("drift detected")
+	ErrDownOutputRequired                 = errors.New("down output required")
+	ErrInvalidTypeMapping                 = errors.New("invalid type mapping")
 )
 
 //nolint:gochecknoglobals
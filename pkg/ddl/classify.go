@@ -0,0 +1,63 @@
+package ddl
+
+import (
+	"strings"
+)
+
+// ChangeClass is the class of the impact of a DDL statement on the running database.
+type ChangeClass string
+
+const (
+	// ChangeClassSafe is the change that neither loses data nor blocks the table for a long time.
+	ChangeClassSafe ChangeClass = "safe"
+	// ChangeClassLocking is the change that rewrites or scans the table, or builds an index,
+	// while holding a lock that blocks reads or writes.
+	ChangeClassLocking ChangeClass = "locking"
+	// ChangeClassDataLoss is the change that loses data, such as DROP TABLE, DROP COLUMN or a narrowing data type change.
+	ChangeClassDataLoss ChangeClass = "data-loss"
+)
+
+// ChangeClassHintPrefix is the prefix of the comment that annotates the class of the following statement, such as:
+//
+//	-- ddlctl:class data-loss
+//	DROP TABLE "users";
+const ChangeClassHintPrefix = "ddlctl:class "
+
+// Comment returns the comment that annotates the class of the following statement.
+func (c ChangeClass) Comment() string {
+	return "-- " + ChangeClassHintPrefix + string(c) + "\n"
+}
+
+// ChangeClasses is the number of the statements for each class.
+type ChangeClasses map[ChangeClass]int
+
+// CountChangeClasses counts the classes of the classified statements.
+func CountChangeClasses(stmts []*Statement) ChangeClasses {
+	classes := make(ChangeClasses)
+	for _, stmt := range stmts {
+		if stmt.Class != "" {
+			classes[stmt.Class]++
+		}
+	}
+	return classes
+}
+
+// ExtractChangeClasses counts the classes annotated by comments in DDL.
+//
+// NOTE: The comments are only for display, since they can be edited by hand.
+// Use CountChangeClasses of the classified statements to decide whether the DDL can be applied.
+func ExtractChangeClasses(ddl string) ChangeClasses {
+	classes := make(ChangeClasses)
+	for _, line := range strings.Split(ddl, "\n") {
+		comment, ok := strings.CutPrefix(strings.TrimSpace(line), "--")
+		if !ok {
+			continue
+		}
+		class, ok := strings.CutPrefix(strings.TrimSpace(comment), ChangeClassHintPrefix)
+		if !ok {
+			continue
+		}
+		classes[ChangeClass(strings.TrimSpace(class))]++
+	}
+	return classes
+}
@@ -0,0 +1,36 @@
+package ddl
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+)
+
+func TestExtractChangeClasses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ExtractChangeClasses(ChangeClassDataLoss.Comment() + "DROP TABLE users;\n" +
+			ChangeClassSafe.Comment() + "-- -\n-- +age INT\nALTER TABLE users ADD COLUMN age INT;\n" +
+			ChangeClassDataLoss.Comment() + "DROP TABLE groups;\n")
+		assert.Equal(t, ChangeClasses{ChangeClassDataLoss: 2, ChangeClassSafe: 1}, actual)
+	})
+}
+
+func TestCountChangeClasses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		actual := CountChangeClasses([]*Statement{
+			{Kind: "drop_table", Object: "users", Class: ChangeClassDataLoss},
+			{Kind: "add_column", Object: "users", Class: ChangeClassSafe},
+			{Kind: "create_table", Object: "groups"},
+			{Kind: "drop_table", Object: "groups", Class: ChangeClassDataLoss},
+		})
+		assert.Equal(t, ChangeClasses{ChangeClassDataLoss: 2, ChangeClassSafe: 1}, actual)
+	})
+}
@@ -0,0 +1,49 @@
+package cockroachdb
+
+import (
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+//nolint:gochecknoglobals
+var dataTypeFamilies = []internal.DataTypeFamily{
+	{{string(TOKEN_INT2)}, {string(TOKEN_INT4)}, {string(TOKEN_INT8)}, {string(TOKEN_DECIMAL), string(TOKEN_NUMERIC)}}, //diff:ignore-line-postgres-cockroach
+	{{string(TOKEN_REAL), string(TOKEN_FLOAT4)}, {string(TOKEN_FLOAT8)}},                                               //diff:ignore-line-postgres-cockroach
+	{{string(TOKEN_CHARACTER)}, {string(TOKEN_VARCHAR), string(TOKEN_STRING)}},                                         //diff:ignore-line-postgres-cockroach
+	{{string(TOKEN_TIMESTAMP)}, {string(TOKEN_TIMESTAMPTZ)}},                                                           //diff:ignore-line-postgres-cockroach
+}
+
+// ClassifyStmt returns the class of the impact of stmt on the running database.
+func ClassifyStmt(stmt Stmt) ddl.ChangeClass {
+	switch s := stmt.(type) {
+	case *DropTableStmt, *DropSequenceStmt:
+		return ddl.ChangeClassDataLoss
+	case *CreateIndexStmt:
+		return ddl.ChangeClassSafe // MEMO: CockroachDB builds the index online. //diff:ignore-line-postgres-cockroach
+	case *AlterTableStmt:
+		return classifyAlterTableAction(s.Action)
+	default:
+		return ddl.ChangeClassSafe
+	}
+}
+
+func classifyAlterTableAction(action AlterTableAction) ddl.ChangeClass {
+	switch a := action.(type) {
+	case *DropColumn:
+		return ddl.ChangeClassDataLoss
+	case *AlterColumnSetDataType:
+		if a.OldDataType != nil && internal.IsNarrowingDataType(a.OldDataType.StringForDiff(), a.DataType.StringForDiff(), dataTypeFamilies...) {
+			return ddl.ChangeClassDataLoss
+		}
+		return ddl.ChangeClassLocking // MEMO: The table is rewritten.
+	case *AlterColumnSetNotNull:
+		return ddl.ChangeClassLocking // MEMO: The table is scanned.
+	case *AddConstraint:
+		if a.NotValid {
+			return ddl.ChangeClassSafe
+		}
+		return ddl.ChangeClassLocking // MEMO: The table is scanned to validate the constraint.
+	default:
+		return ddl.ChangeClassSafe
+	}
+}
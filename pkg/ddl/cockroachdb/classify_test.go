@@ -0,0 +1,45 @@
+package cockroachdb
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestClassifyStmt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE "groups" (id STRING NOT NULL);
+CREATE TABLE "users" (id STRING NOT NULL, "name" STRING(20) NOT NULL, age INT4, memo STRING, PRIMARY KEY (id));`)).Parse()
+		require.NoError(t, err)
+		after, err := NewParser(NewLexer(`CREATE TABLE "users" (id STRING NOT NULL, "name" STRING(10) NOT NULL, age INT8 NOT NULL, note STRING, PRIMARY KEY (id));
+CREATE INDEX users_idx_name ON "users" ("name");`)).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+
+		expected := []ddl.ChangeClass{
+			ddl.ChangeClassDataLoss, // DROP TABLE
+			ddl.ChangeClassSafe,     // CREATE INDEX
+			ddl.ChangeClassDataLoss, // SET DATA TYPE STRING(10)
+			ddl.ChangeClassLocking,  // SET DATA TYPE INT8
+			ddl.ChangeClassLocking,  // SET NOT NULL
+			ddl.ChangeClassDataLoss, // DROP COLUMN
+			ddl.ChangeClassSafe,     // ADD COLUMN
+		}
+		classes := make([]ddl.ChangeClass, 0, len(actual.Stmts))
+		for _, stmt := range actual.Stmts {
+			classes = append(classes, ClassifyStmt(stmt))
+		}
+		if !assert.Equal(t, expected, classes) {
+			t.Logf("❌: %s", actual)
+		}
+	})
+}
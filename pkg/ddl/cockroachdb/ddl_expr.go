@@ -0,0 +1,207 @@
+package cockroachdb
+
+import (
+	"strings"
+)
+
+// identsForDiff returns the tokens of the expression normalized for diff.
+//
+// MEMO: The database deparses expressions with type casts and redundant parentheses
+// (e.g. CHECK ((price > (0)::numeric)) for CHECK (price > 0)), so they never match the source text as they are.
+// The type casts and the parentheses that do not change the meaning of the expression are removed on both sides.
+func identsForDiff(idents []*Ident) []*Ident {
+	return trimRedundantParens(trimTypeCasts(idents))
+}
+
+func trimTypeCasts(idents []*Ident) []*Ident {
+	result := make([]*Ident, 0, len(idents))
+	for i := 0; i < len(idents); i++ {
+		if isTypeCast(idents[i]) {
+			i = endOfCastType(idents, i+1)
+			continue
+		}
+		result = append(result, idents[i])
+	}
+	return result
+}
+
+func isTypeCast(ident *Ident) bool {
+	return ident.QuotationMark == "" && (ident.Raw == "::" || ident.Raw == ":::") //diff:ignore-line-postgres-cockroach
+}
+
+// endOfCastType returns the index of the last token of the type name that starts at idents[i].
+func endOfCastType(idents []*Ident, i int) int {
+	if i >= len(idents) {
+		return len(idents) - 1
+	}
+	// MEMO: character varying, double precision, timestamp with time zone, ...
+	for i+1 < len(idents) && isKeywordToken(idents[i+1], "VARYING", "PRECISION") {
+		i++
+	}
+	if i+3 < len(idents) && isKeywordToken(idents[i+1], "WITH", "WITHOUT") && isKeywordToken(idents[i+2], "TIME") && isKeywordToken(idents[i+3], "ZONE") {
+		i += 3
+	}
+	// MEMO: numeric(10, 2), character varying(255), ...
+	if i+1 < len(idents) && idents[i+1].Raw == "(" {
+		if end := closingParenIndex(idents, i+1); end > 0 {
+			i = end
+		}
+	}
+	// MEMO: text[], ...
+	for i+2 < len(idents) && idents[i+1].Raw == "[" && idents[i+2].Raw == "]" {
+		i += 2
+	}
+	return i
+}
+
+func trimRedundantParens(idents []*Ident) []*Ident {
+	result := make([]*Ident, 0, len(idents))
+	for i := 0; i < len(idents); i++ {
+		if idents[i].Raw != "(" || idents[i].QuotationMark != "" {
+			result = append(result, idents[i])
+			continue
+		}
+		end := closingParenIndex(idents, i)
+		if end < 0 {
+			return append(result, idents[i:]...)
+		}
+		inner := trimRedundantParens(idents[i+1 : end])
+		var prev, next *Ident
+		if len(result) > 0 {
+			prev = result[len(result)-1]
+		}
+		if end+1 < len(idents) {
+			next = idents[end+1]
+		}
+		if isRedundantParens(prev, inner, next) {
+			result = append(result, inner...)
+		} else {
+			result = append(result, idents[i])
+			result = append(result, inner...)
+			result = append(result, idents[end])
+		}
+		i = end
+	}
+	return result
+}
+
+// isRedundantParens reports whether the parentheses around inner can be removed without changing the meaning.
+// They are the parentheses for grouping, and the operators in them bind tighter than the operators around them.
+func isRedundantParens(prev *Ident, inner []*Ident, next *Ident) bool {
+	if len(inner) == 0 || !isGroupingParens(prev) {
+		return false
+	}
+	if next != nil && (next.Raw == "[" || strings.HasPrefix(next.Raw, ".")) {
+		return false
+	}
+
+	innerPrecedence := maxOperatorPrecedence
+	depth := 0
+	for i, ident := range inner {
+		depth += parenDepth(ident)
+		if depth > 0 || ident.Raw == ")" {
+			continue
+		}
+		if ident.Raw == "," || isKeywordToken(ident, "SELECT", "CASE") {
+			return false
+		}
+		if i > 0 && isKeywordToken(ident, "NOT") && isKeywordToken(inner[i-1], "IS") {
+			// MEMO: IS NOT is a single operator.
+			continue
+		}
+		if p := operatorPrecedence(ident); p > 0 && p < innerPrecedence {
+			innerPrecedence = p
+		}
+	}
+
+	// MEMO: The binary operators are left-associative, so (a - b) - c is a - b - c but a - (b - c) is not.
+	return innerPrecedence > operatorPrecedence(prev) && innerPrecedence >= operatorPrecedence(next)
+}
+
+// isGroupingParens reports whether the parentheses after prev are for grouping, not for a function call, IN (...), and so on.
+func isGroupingParens(prev *Ident) bool {
+	switch {
+	case prev == nil, prev.Raw == "(" || prev.Raw == ",":
+		return true
+	case operatorPrecedence(prev) > 0:
+		return !isKeywordToken(prev, "IN")
+	default:
+		return isKeywordToken(prev, "SELECT", "WHERE", "HAVING", "ON", "BY", "WHEN", "THEN", "ELSE")
+	}
+}
+
+const maxOperatorPrecedence = 100
+
+// operatorPrecedence returns the precedence of the operator, or 0 if ident is not an operator.
+//
+//nolint:cyclop
+func operatorPrecedence(ident *Ident) int {
+	if ident == nil || ident.QuotationMark != "" || strings.HasPrefix(ident.Raw, "'") {
+		return 0
+	}
+	switch strings.ToUpper(ident.Raw) {
+	case "OR":
+		return 1
+	case "AND":
+		return 2
+	case "NOT":
+		return 3
+	case "IS", "ISNULL", "NOTNULL":
+		return 4
+	case "=", "<", ">", "<=", ">=", "<>", "!=":
+		return 5
+	case "LIKE", "ILIKE", "SIMILAR", "IN", "BETWEEN":
+		return 6
+	case "+", "-":
+		return 8
+	case "*", "/", "%":
+		return 9
+	case "^":
+		return 10
+	case "(", ")", "[", "]", ",", ";":
+		return 0
+	}
+	if strings.IndexFunc(ident.Raw, func(r rune) bool {
+		return r == '_' || r == '.' || r == '$' || ('0' <= r && r <= '9') || ('A' <= r && r <= 'Z') || ('a' <= r && r <= 'z')
+	}) >= 0 {
+		return 0
+	}
+	// MEMO: the other operators such as ||, @> and ->.
+	return 7
+}
+
+func closingParenIndex(idents []*Ident, open int) int {
+	depth := 0
+	for i := open; i < len(idents); i++ {
+		depth += parenDepth(idents[i])
+		if depth == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+func parenDepth(ident *Ident) int {
+	switch {
+	case ident.QuotationMark != "":
+		return 0
+	case ident.Raw == "(":
+		return 1
+	case ident.Raw == ")":
+		return -1
+	default:
+		return 0
+	}
+}
+
+func isKeywordToken(ident *Ident, keywords ...string) bool {
+	if ident == nil || ident.QuotationMark != "" {
+		return false
+	}
+	for _, keyword := range keywords {
+		if strings.EqualFold(ident.Raw, keyword) {
+			return true
+		}
+	}
+	return false
+}
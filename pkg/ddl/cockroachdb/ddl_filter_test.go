@@ -0,0 +1,50 @@
+package cockroachdb
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestDDL_FilterTables(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewParser(NewLexer(`CREATE TABLE users (id INTEGER NOT NULL, PRIMARY KEY (id));
+CREATE TABLE ddlctl_schema_history (id INTEGER NOT NULL, PRIMARY KEY (id));
+CREATE INDEX users_idx_id ON users (id);
+`)).Parse()
+		require.NoError(t, err)
+
+		actual := d.FilterTables(func(_, table string) bool { return table != "ddlctl_schema_history" })
+		require.Equal(t, 2, len(actual.Stmts))
+		assert.Equal(t, "users", actual.Stmts[0].(*CreateTableStmt).Name.Name.Name)
+		assert.Equal(t, "users", actual.Stmts[1].(*CreateIndexStmt).TableName.Name.Name)
+	})
+}
+
+func TestDDL_FilterSchemas(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewParser(NewLexer(`CREATE SCHEMA tenant_a;
+CREATE SCHEMA tenant_b;
+CREATE TABLE tenant_a.users (id INTEGER NOT NULL, PRIMARY KEY (id));
+CREATE TABLE tenant_b.users (id INTEGER NOT NULL, PRIMARY KEY (id));
+CREATE INDEX users_idx_id ON tenant_b.users (id);
+CREATE TABLE groups (id INTEGER NOT NULL, PRIMARY KEY (id));
+`)).Parse()
+		require.NoError(t, err)
+
+		actual := d.FilterSchemas(func(schema string) bool { return schema != "tenant_b" })
+		require.Equal(t, 3, len(actual.Stmts))
+		assert.Equal(t, "tenant_a", actual.Stmts[0].(*CreateSchemaStmt).Name.Name)
+		assert.Equal(t, "tenant_a.users", actual.Stmts[1].(*CreateTableStmt).Name.StringForDiff())
+		assert.Equal(t, "groups", actual.Stmts[2].(*CreateTableStmt).Name.StringForDiff())
+	})
+}
@@ -0,0 +1,43 @@
+package cockroachdb
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestDropSchemaStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &DropSchemaStmt{Name: &Ident{Name: "tenant", QuotationMark: `"`, Raw: `"tenant"`}}
+		expected := "tenant"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestDropSchemaStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &DropSchemaStmt{
+			Comment:  "test comment content",
+			IfExists: true,
+			Name:     &Ident{Name: "tenant", QuotationMark: `"`, Raw: `"tenant"`},
+		}
+		expected := `-- test comment content
+DROP SCHEMA IF EXISTS "tenant";
+`
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
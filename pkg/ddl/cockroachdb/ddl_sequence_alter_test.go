@@ -0,0 +1,46 @@
+package cockroachdb
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestAlterSequenceStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterSequenceStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestAlterSequenceStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterSequenceStmt{
+			Comment: "test comment content",
+			Name:    &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Options: []*SequenceOption{
+				{Name: SequenceOptionMaxValue, Value: NewRawIdent("100")},
+				{Name: SequenceOptionNoCycle},
+			},
+		}
+		expected := `-- test comment content
+ALTER SEQUENCE "test" MAXVALUE 100 NO CYCLE;
+`
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
@@ -0,0 +1,85 @@
+package cockroachdb
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestCreateSequenceStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateSequenceStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestCreateSequenceStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateSequenceStmt{
+			Comment:     "test comment content",
+			IfNotExists: true,
+			Name:        &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Options: []*SequenceOption{
+				{Name: SequenceOptionIncrement, Value: NewRawIdent("-1")},
+				{Name: SequenceOptionNoMinValue},
+				{Name: SequenceOptionCycle},
+			},
+		}
+		expected := `-- test comment content
+CREATE SEQUENCE IF NOT EXISTS "test" INCREMENT -1 NO MINVALUE CYCLE;
+`
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
+
+func TestCreateSequenceStmt_StringForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,ascending", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateSequenceStmt{
+			Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Options: []*SequenceOption{
+				{Name: SequenceOptionAs, Value: NewRawIdent("int4")},
+				{Name: SequenceOptionMinValue, Value: NewRawIdent("10")},
+			},
+		}
+		expected := `CREATE SEQUENCE test AS INTEGER INCREMENT 1 MINVALUE 10 MAXVALUE 2147483647 START 10 CACHE 1 NO CYCLE`
+		actual := stmt.StringForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("success,descending", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateSequenceStmt{
+			Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Options: []*SequenceOption{
+				{Name: SequenceOptionAs, Value: NewRawIdent("smallint")},
+				{Name: SequenceOptionIncrement, Value: NewRawIdent("-1")},
+				{Name: SequenceOptionCycle},
+			},
+		}
+		expected := `CREATE SEQUENCE test AS SMALLINT INCREMENT -1 MINVALUE -32768 MAXVALUE -1 START -1 CACHE 1 CYCLE`
+		actual := stmt.StringForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
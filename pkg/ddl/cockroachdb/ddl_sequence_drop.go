@@ -0,0 +1,42 @@
+package cockroachdb
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.cockroachlabs.com/docs/stable/drop-sequence //diff:ignore-line-postgres-cockroach
+
+var _ Stmt = (*DropSequenceStmt)(nil)
+
+type DropSequenceStmt struct {
+	Comment  string
+	IfExists bool
+	Name     *ObjectName
+}
+
+func (s *DropSequenceStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *DropSequenceStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "DROP SEQUENCE "
+	if s.IfExists {
+		str += "IF EXISTS "
+	}
+	str += s.Name.String() + ";\n"
+	return str
+}
+
+func (*DropSequenceStmt) isStmt()            {}
+func (s *DropSequenceStmt) GoString() string { return internal.GoString(*s) }
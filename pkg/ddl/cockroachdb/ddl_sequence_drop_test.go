@@ -0,0 +1,43 @@
+package cockroachdb
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestDropSequenceStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &DropSequenceStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestDropSequenceStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &DropSequenceStmt{
+			Comment:  "test comment content",
+			IfExists: true,
+			Name:     &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+		}
+		expected := `-- test comment content
+DROP SEQUENCE IF EXISTS "test";
+`
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
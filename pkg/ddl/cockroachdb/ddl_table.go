@@ -221,7 +221,7 @@ func (c *CheckConstraint) StringForDiff() string {
 		str += "CONSTRAINT " + c.Name.StringForDiff() + " "
 	}
 	str += "CHECK "
-	for i, v := range c.Expr.Idents {
+	for i, v := range identsForDiff(c.Expr.Idents) {
 		if i != 0 {
 			str += " "
 		}
@@ -344,7 +344,7 @@ func (d *Default) StringForDiff() string {
 	}
 	if e := d.Value; e != nil {
 		str := "DEFAULT "
-		for i, v := range d.Value.Idents {
+		for i, v := range identsForDiff(d.Value.Idents) {
 			if i != 0 {
 				str += " "
 			}
@@ -386,7 +386,7 @@ func (d *As) StringForDiff() string {
 
 	if e := d.Value; e != nil {
 		str := "AS "
-		for i, v := range d.Value.Idents {
+		for i, v := range identsForDiff(d.Value.Idents) {
 			if i != 0 {
 				str += " "
 			}
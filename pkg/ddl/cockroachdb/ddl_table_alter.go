@@ -39,7 +39,7 @@ func (s *AlterTableStmt) String() string {
 	switch a := s.Action.(type) {
 	case *RenameTable:
 		str += "RENAME TO "
-		str += a.NewName.String()
+		str += a.NewName.String() //diff:ignore-line-postgres-cockroach
 	case *RenameColumn:
 		str += "RENAME COLUMN " + a.Name.String() + " TO " + a.NewName.String()
 	case *RenameConstraint:
@@ -138,8 +138,9 @@ func (s *DropColumn) GoString() string { return internal.GoString(*s) }
 
 // AlterColumnSetDataType represents ALTER TABLE table_name ALTER COLUMN column_name SET DATA TYPE.
 type AlterColumnSetDataType struct {
-	Name     *Ident
-	DataType *DataType
+	Name        *Ident
+	DataType    *DataType
+	OldDataType *DataType // MEMO: not printed, but used to detect a narrowing change.
 }
 
 func (*AlterColumnSetDataType) isAlterTableAction() {}
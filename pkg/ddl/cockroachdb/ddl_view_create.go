@@ -0,0 +1,133 @@
+package cockroachdb
+
+import (
+	"strings"
+
+	stringz "github.com/kunitsucom/util.go/strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.cockroachlabs.com/docs/stable/create-view //diff:ignore-line-postgres-cockroach
+// MEMO: https://www.cockroachlabs.com/docs/stable/views#materialized-views //diff:ignore-line-postgres-cockroach
+
+var _ Stmt = (*CreateViewStmt)(nil)
+
+type CreateViewStmt struct {
+	Comment      string
+	OrReplace    bool
+	Materialized bool
+	IfNotExists  bool
+	Name         *ObjectName
+	Columns      []*Ident
+	Query        *Query
+}
+
+func (s *CreateViewStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *CreateViewStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "CREATE "
+	if s.OrReplace {
+		str += "OR REPLACE "
+	}
+	if s.Materialized {
+		str += "MATERIALIZED "
+	}
+	str += "VIEW "
+	if s.IfNotExists {
+		str += "IF NOT EXISTS "
+	}
+	str += s.Name.String()
+	if len(s.Columns) > 0 {
+		str += " (" + stringz.JoinStringers(", ", s.Columns...) + ")"
+	}
+	str += " AS " + s.Query.String() + ";\n"
+	return str
+}
+
+func (s *CreateViewStmt) StringForDiff() string {
+	str := "CREATE "
+	if s.Materialized {
+		str += "MATERIALIZED "
+	}
+	str += "VIEW "
+	str += s.Name.StringForDiff()
+	if len(s.Columns) > 0 {
+		str += " ("
+		for i, c := range s.Columns {
+			if i > 0 {
+				str += ", "
+			}
+			str += c.StringForDiff()
+		}
+		str += ")"
+	}
+	str += " AS " + s.Query.StringForDiff()
+	return str
+}
+
+func (*CreateViewStmt) isStmt()            {}
+func (s *CreateViewStmt) GoString() string { return internal.GoString(*s) }
+
+// Query represents the query of a view as a sequence of tokens.
+type Query struct {
+	Idents []*Ident
+}
+
+func (q *Query) GoString() string { return internal.GoString(*q) }
+
+func (q *Query) String() string {
+	if q == nil {
+		return ""
+	}
+	strs := make([]string, 0, len(q.Idents))
+	for _, ident := range q.Idents {
+		strs = append(strs, ident.String())
+	}
+	return joinQueryTokens(strs)
+}
+
+// StringForDiff returns the query normalized for diff.
+// Unquoted identifiers and keywords are case-insensitive, so they are upper-cased.
+// The type casts and the redundant parentheses are removed as well as the other expressions.
+func (q *Query) StringForDiff() string {
+	if q == nil {
+		return ""
+	}
+	strs := make([]string, 0, len(q.Idents))
+	for _, ident := range identsForDiff(q.Idents) {
+		if ident.QuotationMark == "" && !strings.HasPrefix(ident.Raw, "'") {
+			strs = append(strs, strings.ToUpper(ident.Raw))
+			continue
+		}
+		strs = append(strs, ident.Raw)
+	}
+	return joinQueryTokens(strs)
+}
+
+func joinQueryTokens(tokens []string) string {
+	var str string
+	for i := range tokens {
+		switch {
+		case i == 0,
+			tokens[i-1] == "(" || tokens[i-1] == "::",
+			tokens[i] == ")" || tokens[i] == "," || tokens[i] == "::":
+			// noop
+		default:
+			str += " "
+		}
+		str += tokens[i]
+	}
+	return str
+}
@@ -0,0 +1,77 @@
+package cockroachdb
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestCreateViewStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateViewStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestCreateViewStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateViewStmt{
+			Comment:      "test comment content",
+			OrReplace:    true,
+			Materialized: true,
+			IfNotExists:  true,
+			Name:         &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Columns:      []*Ident{{Name: "id", QuotationMark: `"`, Raw: `"id"`}},
+			Query: &Query{
+				Idents: []*Ident{
+					NewRawIdent("SELECT"), NewRawIdent("count"), NewRawIdent("("), NewRawIdent("*"), NewRawIdent(")"), NewRawIdent("::"), NewRawIdent("INTEGER"),
+					NewRawIdent("FROM"), NewRawIdent(`"users"`),
+				},
+			},
+		}
+		expected := `-- test comment content
+CREATE OR REPLACE MATERIALIZED VIEW IF NOT EXISTS "test" ("id") AS SELECT count (*)::INTEGER FROM "users";
+`
+
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
+
+func TestCreateViewStmt_StringForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateViewStmt{
+			OrReplace: true,
+			Name:      &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Query: &Query{
+				Idents: []*Ident{
+					NewRawIdent("select"), NewRawIdent(`"Name"`), NewRawIdent("from"), NewRawIdent("users"),
+					NewRawIdent("where"), NewRawIdent("name"), NewRawIdent("="), NewRawIdent("'foo'"),
+				},
+			},
+		}
+		expected := `CREATE VIEW test AS SELECT "Name" FROM USERS WHERE NAME = 'foo'`
+
+		actual := stmt.StringForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
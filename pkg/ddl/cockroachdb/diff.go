@@ -2,6 +2,8 @@ package cockroachdb
 
 import (
 	"reflect"
+	"regexp"
+	"strings"
 
 	errorz "github.com/kunitsucom/util.go/errors"
 	"github.com/kunitsucom/util.go/exp/diff/simplediff"
@@ -11,21 +13,106 @@ import (
 	"github.com/kunitsucom/ddlctl/pkg/ddl"
 )
 
-//nolint:funlen,cyclop,gocognit
-func Diff(before, after *DDL) (*DDL, error) {
+type DiffConfig struct {
+	Renames         ddl.Renames
+	RenameHeuristic bool
+}
+
+type DiffOption interface {
+	apply(c *DiffConfig)
+}
+
+// DiffRenames declares the tables and columns renamed from before to after,
+// so that they are renamed by ALTER TABLE instead of being dropped and created.
+func DiffRenames(renames ddl.Renames) DiffOption { //nolint:ireturn
+	return &diffConfigRenames{
+		renames: renames,
+	}
+}
+
+type diffConfigRenames struct {
+	renames ddl.Renames
+}
+
+func (o *diffConfigRenames) apply(c *DiffConfig) {
+	c.Renames = append(c.Renames, o.renames...)
+}
+
+// DiffRenameHeuristic regards a dropped column and an added column that have the same definition as renamed.
+func DiffRenameHeuristic(enabled bool) DiffOption { //nolint:ireturn
+	return &diffConfigRenameHeuristic{
+		renameHeuristic: enabled,
+	}
+}
+
+type diffConfigRenameHeuristic struct {
+	renameHeuristic bool
+}
+
+func (o *diffConfigRenameHeuristic) apply(c *DiffConfig) {
+	c.RenameHeuristic = o.renameHeuristic
+}
+
+//nolint:funlen,cyclop,gocognit,gocyclo
+func Diff(before, after *DDL, opts ...DiffOption) (*DDL, error) {
+	config := &DiffConfig{}
+
+	for _, opt := range opts {
+		opt.apply(config)
+	}
+
 	result := &DDL{}
 
 	switch {
 	case before == nil && after != nil:
-		result.Stmts = append(result.Stmts, after.Stmts...)
+		// MEMO: Schemas must be created before the objects in them.
+		for _, stmt := range after.Stmts {
+			if _, ok := stmt.(*CreateSchemaStmt); ok {
+				result.Stmts = append(result.Stmts, stmt)
+			}
+		}
+		// MEMO: Sequences must be created before the tables that use them.
+		for _, stmt := range after.Stmts {
+			if _, ok := stmt.(*CreateSequenceStmt); ok {
+				result.Stmts = append(result.Stmts, stmt)
+			}
+		}
+		// MEMO: Tables are created in the order of their foreign key dependencies.
+		createTableStmts := make([]*CreateTableStmt, 0)
+		for _, stmt := range after.Stmts {
+			if s, ok := stmt.(*CreateTableStmt); ok {
+				createTableStmts = append(createTableStmts, s)
+			}
+		}
+		sortedCreateTableStmts, addConstraintStmts := sortCreateTableStmts(createTableStmts)
+		result.Stmts = append(result.Stmts, sortedCreateTableStmts...)
+		for _, stmt := range after.Stmts {
+			switch stmt.(type) {
+			case *CreateSchemaStmt, *CreateSequenceStmt, *CreateTableStmt:
+				// do nothing
+			default:
+				result.Stmts = append(result.Stmts, stmt)
+			}
+		}
+		result.Stmts = append(result.Stmts, addConstraintStmts...)
 		return result, nil
 	case before != nil && after == nil:
+		// MEMO: Views depend on tables, so they are dropped first.
+		for _, stmt := range before.Stmts {
+			if s, ok := stmt.(*CreateViewStmt); ok {
+				result.Stmts = append(result.Stmts, &DropViewStmt{
+					Materialized: s.Materialized,
+					Name:         s.Name,
+				})
+			}
+		}
+		dropTableStmts := make([]*CreateTableStmt, 0)
 		for _, stmt := range before.Stmts {
 			switch s := stmt.(type) {
+			case *CreateViewStmt, *CreateSequenceStmt, *CreateSchemaStmt:
+				// do nothing
 			case *CreateTableStmt:
-				result.Stmts = append(result.Stmts, &DropTableStmt{
-					Name: s.Name,
-				})
+				dropTableStmts = append(dropTableStmts, s)
 			case *CreateIndexStmt:
 				result.Stmts = append(result.Stmts, &DropIndexStmt{
 					Name: s.Name,
@@ -34,18 +121,63 @@ func Diff(before, after *DDL) (*DDL, error) {
 				return nil, apperr.Errorf("%s: %T: %w", s.GetNameForDiff(), s, ddl.ErrNotSupported)
 			}
 		}
+		// MEMO: Tables are dropped in the reverse order of their foreign key dependencies.
+		result.Stmts = append(result.Stmts, sortDropTableStmts(dropTableStmts)...)
+		// MEMO: Sequences are dropped after the tables that use them.
+		for _, stmt := range before.Stmts {
+			if s, ok := stmt.(*CreateSequenceStmt); ok {
+				result.Stmts = append(result.Stmts, &DropSequenceStmt{
+					Name: s.Name,
+				})
+			}
+		}
+		// MEMO: Schemas are dropped after the objects in them.
+		for _, stmt := range before.Stmts {
+			if s, ok := stmt.(*CreateSchemaStmt); ok {
+				result.Stmts = append(result.Stmts, &DropSchemaStmt{
+					Name: s.Name,
+				})
+			}
+		}
 		return result, nil
 	case (before == nil && after == nil) || reflect.DeepEqual(before, after) || before.String() == after.String():
 		return nil, ddl.ErrNoDifference
 	}
 
+	// DROP VIEW view_name;
+	// MEMO: Views depend on tables, so they are dropped before the tables are altered.
+	for _, stmt := range before.Stmts {
+		if beforeStmt, ok := stmt.(*CreateViewStmt); ok {
+			afterStmt, ok := findStmtByTypeAndName(beforeStmt, after.Stmts).(*CreateViewStmt)
+			switch {
+			case !ok:
+				result.Stmts = append(result.Stmts, &DropViewStmt{
+					Materialized: beforeStmt.Materialized,
+					Name:         beforeStmt.Name,
+				})
+			case requiresRecreateView(beforeStmt, afterStmt):
+				result.Stmts = append(result.Stmts, &DropViewStmt{
+					Comment:      simplediff.Diff(beforeStmt.StringForDiff(), afterStmt.StringForDiff()).String(),
+					Materialized: beforeStmt.Materialized,
+					Name:         beforeStmt.Name,
+				})
+			}
+		}
+	}
+
 	// DROP TABLE table_name;
+	// MEMO: Tables are dropped in the reverse order of their foreign key dependencies.
+	dropTableStmts := make([]*CreateTableStmt, 0)
 	for _, stmt := range onlyLeftStmt(before, after) {
 		switch beforeStmt := stmt.(type) {
+		case *CreateViewStmt, *CreateSequenceStmt, *CreateSchemaStmt:
+			// do nothing
 		case *CreateTableStmt:
-			result.Stmts = append(result.Stmts, &DropTableStmt{
-				Name: beforeStmt.Name,
-			})
+			if config.findRenamedTable(beforeStmt, before, after) != nil {
+				// MEMO: renamed by ALTER TABLE
+				continue
+			}
+			dropTableStmts = append(dropTableStmts, beforeStmt)
 		case *CreateIndexStmt:
 			result.Stmts = append(result.Stmts, &DropIndexStmt{
 				Name: beforeStmt.Name,
@@ -54,27 +186,70 @@ func Diff(before, after *DDL) (*DDL, error) {
 			return nil, apperr.Errorf("%s: %T: %w", beforeStmt.GetNameForDiff(), beforeStmt, ddl.ErrNotSupported)
 		}
 	}
+	result.Stmts = append(result.Stmts, sortDropTableStmts(dropTableStmts)...)
+
+	// CREATE SCHEMA schema_name;
+	// MEMO: Schemas must be created before the objects in them.
+	//       A schema that is not declared in before but has objects in it already exists.
+	for _, stmt := range onlyLeftStmt(after, before) {
+		if afterStmt, ok := stmt.(*CreateSchemaStmt); ok && !isSchemaInUse(afterStmt, before.Stmts) {
+			result.Stmts = append(result.Stmts, afterStmt)
+		}
+	}
+
+	// CREATE SEQUENCE sequence_name ...;
+	// ALTER SEQUENCE sequence_name ...;
+	// MEMO: Sequences must be created before the tables that use them.
+	for _, stmt := range after.Stmts {
+		if afterStmt, ok := stmt.(*CreateSequenceStmt); ok {
+			beforeStmt, _ := findStmtByTypeAndName(afterStmt, before.Stmts).(*CreateSequenceStmt)
+			alterStmt, err := DiffCreateSequence(beforeStmt, afterStmt)
+			if err == nil {
+				result.Stmts = append(result.Stmts, alterStmt.Stmts...)
+			}
+			errorz.PanicOrIgnore(err, ddl.ErrNoDifference) // MEMO: DiffCreateSequence does not return error except ddl.ErrNoDifference.
+		}
+	}
 
 	// CREATE TABLE table_name
+	// MEMO: Tables are created in the order of their foreign key dependencies, and then the indexes are created.
+	createTableStmts := make([]*CreateTableStmt, 0)
+	createIndexStmts := make([]Stmt, 0)
 	for _, stmt := range onlyLeftStmt(after, before) {
 		switch afterStmt := stmt.(type) {
+		case *CreateViewStmt, *CreateSequenceStmt, *CreateSchemaStmt:
+			// do nothing
 		case *CreateTableStmt:
-			result.Stmts = append(result.Stmts, afterStmt)
+			if config.isRenamedTable(afterStmt, before, after) {
+				// MEMO: renamed by ALTER TABLE
+				continue
+			}
+			createTableStmts = append(createTableStmts, afterStmt)
 		case *CreateIndexStmt:
-			result.Stmts = append(result.Stmts, afterStmt)
+			createIndexStmts = append(createIndexStmts, afterStmt)
 		default:
 			return nil, apperr.Errorf("%s: %T: %w", afterStmt.GetNameForDiff(), afterStmt, ddl.ErrNotSupported)
 		}
 	}
+	sortedCreateTableStmts, addConstraintStmts := sortCreateTableStmts(createTableStmts)
+	result.Stmts = append(result.Stmts, sortedCreateTableStmts...)
+	result.Stmts = append(result.Stmts, createIndexStmts...)
+	result.Stmts = append(result.Stmts, addConstraintStmts...)
 
 	// ALTER TABLE table_name ...
 	// DROP INDEX index_name; CREATE INDEX index_name ...
 	for _, beforeStmt := range before.Stmts {
 		switch beforeStmt := beforeStmt.(type) { //nolint:gocritic
 		case *CreateTableStmt:
-			if afterStmt := findStmtByTypeAndName(beforeStmt, after.Stmts); afterStmt != nil {
-				afterStmt := afterStmt.(*CreateTableStmt) //nolint:forcetypeassert
-				alterStmt, err := DiffCreateTable(beforeStmt, afterStmt)
+			afterStmt, _ := findStmtByTypeAndName(beforeStmt, after.Stmts).(*CreateTableStmt)
+			if afterStmt == nil {
+				afterStmt = config.findRenamedTable(beforeStmt, before, after)
+			}
+			if afterStmt != nil {
+				alterStmt, err := DiffCreateTable(beforeStmt, afterStmt,
+					DiffCreateTableRenames(config.Renames),
+					DiffCreateTableRenameHeuristic(config.RenameHeuristic),
+				)
 				if err == nil {
 					result.Stmts = append(result.Stmts, alterStmt.Stmts...)
 				}
@@ -97,6 +272,45 @@ func Diff(before, after *DDL) (*DDL, error) {
 		}
 	}
 
+	// DROP SEQUENCE sequence_name;
+	// MEMO: Sequences are dropped after the tables that use them are dropped or altered.
+	for _, stmt := range onlyLeftStmt(before, after) {
+		if beforeStmt, ok := stmt.(*CreateSequenceStmt); ok {
+			result.Stmts = append(result.Stmts, &DropSequenceStmt{
+				Name: beforeStmt.Name,
+			})
+		}
+	}
+
+	// DROP SCHEMA schema_name;
+	// MEMO: Schemas are dropped after the objects in them are dropped.
+	//       A schema that is not declared in after but has objects in it is kept.
+	for _, stmt := range onlyLeftStmt(before, after) {
+		if beforeStmt, ok := stmt.(*CreateSchemaStmt); ok && !isSchemaInUse(beforeStmt, after.Stmts) {
+			result.Stmts = append(result.Stmts, &DropSchemaStmt{
+				Name: beforeStmt.Name,
+			})
+		}
+	}
+
+	// CREATE VIEW view_name AS ...
+	// CREATE OR REPLACE VIEW view_name AS ...
+	for _, stmt := range after.Stmts {
+		if afterStmt, ok := stmt.(*CreateViewStmt); ok {
+			beforeStmt, ok := findStmtByTypeAndName(afterStmt, before.Stmts).(*CreateViewStmt)
+			switch {
+			case !ok, requiresRecreateView(beforeStmt, afterStmt):
+				result.Stmts = append(result.Stmts, afterStmt)
+			case beforeStmt.StringForDiff() != afterStmt.StringForDiff():
+				replaceStmt := *afterStmt
+				replaceStmt.Comment = simplediff.Diff(beforeStmt.StringForDiff(), afterStmt.StringForDiff()).String()
+				replaceStmt.OrReplace = true
+				replaceStmt.IfNotExists = false
+				result.Stmts = append(result.Stmts, &replaceStmt)
+			}
+		}
+	}
+
 	if len(result.Stmts) == 0 {
 		return nil, ddl.ErrNoDifference
 	}
@@ -104,6 +318,160 @@ func Diff(before, after *DDL) (*DDL, error) {
 	return result, nil
 }
 
+// requiresRecreateView reports whether the view cannot be changed by CREATE OR REPLACE VIEW.
+// Materialized views cannot be replaced, and CREATE OR REPLACE VIEW can only add new columns at the end of the column list.
+func requiresRecreateView(before, after *CreateViewStmt) bool {
+	if before.StringForDiff() == after.StringForDiff() {
+		return false
+	}
+
+	if before.Materialized || after.Materialized {
+		return true
+	}
+
+	beforeColumns, ok := viewColumns(before)
+	if !ok {
+		return true
+	}
+	afterColumns, ok := viewColumns(after)
+	if !ok || len(afterColumns) < len(beforeColumns) {
+		return true
+	}
+
+	return !reflect.DeepEqual(beforeColumns, afterColumns[:len(beforeColumns)])
+}
+
+// viewColumns returns the output column names of the view.
+// It returns false if the names cannot be determined from the query, e.g. SELECT *.
+//
+// MEMO: A select item without alias is keyed by its whole expression,
+// so that a changed expression is never taken for the same column.
+func viewColumns(stmt *CreateViewStmt) ([]string, bool) {
+	items, ok := selectItems(stmt.Query)
+	if !ok {
+		return nil, false
+	}
+
+	columns := make([]string, 0, len(items))
+	for i, item := range items {
+		if i < len(stmt.Columns) {
+			columns = append(columns, stmt.Columns[i].StringForDiff())
+			continue
+		}
+		last := item[len(item)-1]
+		switch {
+		case last.Raw == "*" || strings.HasSuffix(last.Raw, ".*"):
+			return nil, false
+		case len(item) >= 2 && strings.EqualFold(item[len(item)-2].Raw, "AS"):
+			columns = append(columns, viewColumnName(last))
+		case len(item) == 1 && isIdentToken(last),
+			len(item) >= 3 && item[len(item)-2].Raw == "." && isIdentToken(last):
+			columns = append(columns, viewColumnName(last))
+		default:
+			columns = append(columns, (&Query{Idents: item}).StringForDiff())
+		}
+	}
+
+	return columns, true
+}
+
+// selectItems splits the select list of the top-level SELECT of the query into items.
+func selectItems(query *Query) ([][]*Ident, bool) {
+	if query == nil {
+		return nil, false
+	}
+	idents := query.Idents
+
+	// MEMO: skip to the select list of the top-level SELECT.
+	depth, start := 0, -1
+	for i, ident := range idents {
+		depth += parenDepth(ident)
+		if depth == 0 && isKeywordToken(ident, "SELECT") {
+			start = i + 1
+			break
+		}
+	}
+	if start < 0 {
+		return nil, false
+	}
+	switch {
+	case start < len(idents) && isKeywordToken(idents[start], "ALL"):
+		start++
+	case start < len(idents) && isKeywordToken(idents[start], "DISTINCT"):
+		start++
+		if start < len(idents) && isKeywordToken(idents[start], "ON") {
+			// MEMO: skip the expressions of DISTINCT ON (...).
+			for start++; start < len(idents); start++ {
+				depth += parenDepth(idents[start])
+				if depth == 0 {
+					start++
+					break
+				}
+			}
+		}
+	}
+
+	items := make([][]*Ident, 0)
+	item := make([]*Ident, 0)
+LabelSelectList:
+	for _, ident := range idents[start:] {
+		depth += parenDepth(ident)
+		switch {
+		case depth > 0 || ident.Raw == ")":
+			// noop
+		case ident.Raw == ",":
+			items = append(items, item)
+			item = make([]*Ident, 0)
+			continue
+		case isKeywordToken(ident, "FROM", "WHERE", "GROUP", "HAVING", "WINDOW", "ORDER", "LIMIT", "OFFSET", "FETCH", "UNION", "INTERSECT", "EXCEPT", "INTO"):
+			break LabelSelectList
+		}
+		item = append(item, ident)
+	}
+	items = append(items, item)
+
+	for _, item := range items {
+		if len(item) == 0 {
+			return nil, false
+		}
+	}
+
+	return items, true
+}
+
+//nolint:gochecknoglobals
+var identTokenRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*(\.[A-Za-z_][A-Za-z0-9_$]*)*$`)
+
+func isIdentToken(ident *Ident) bool {
+	return ident.QuotationMark != "" || identTokenRegex.MatchString(ident.Raw)
+}
+
+// viewColumnName returns the column name of the identifier, which may be qualified by the table name.
+func viewColumnName(ident *Ident) string {
+	if ident.QuotationMark != "" {
+		return ident.Name
+	}
+	name := ident.Raw
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	// MEMO: unquoted identifiers are folded to lower case.
+	return strings.ToLower(name)
+}
+
+// isSchemaInUse reports whether any object in stmts belongs to the schema.
+func isSchemaInUse(schema *CreateSchemaStmt, stmts []Stmt) bool {
+	for _, stmt := range stmts {
+		if _, ok := stmt.(*CreateSchemaStmt); ok {
+			continue
+		}
+		if s, ok := schemaOf(stmt); ok && s == schema.Name.Name {
+			return true
+		}
+	}
+	return false
+}
+
 func onlyLeftStmt(left, right *DDL) []Stmt {
 	result := make([]Stmt, 0)
 
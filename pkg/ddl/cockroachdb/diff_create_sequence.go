@@ -0,0 +1,45 @@
+package cockroachdb
+
+import (
+	"github.com/kunitsucom/util.go/exp/diff/simplediff"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+// DiffCreateSequence returns the DDL to migrate the sequence from before to after.
+//
+// Options omitted in either statement are compared with their default values,
+// and only the options that actually differ are applied by ALTER SEQUENCE.
+func DiffCreateSequence(before, after *CreateSequenceStmt) (*DDL, error) {
+	result := &DDL{}
+
+	switch {
+	case before == nil && after != nil:
+		// CREATE SEQUENCE sequence_name ...;
+		result.Stmts = append(result.Stmts, after)
+		return result, nil
+	case before != nil && after == nil:
+		// DROP SEQUENCE sequence_name;
+		result.Stmts = append(result.Stmts, &DropSequenceStmt{
+			Name: before.Name,
+		})
+		return result, nil
+	case (before == nil && after == nil) || before.StringForDiff() == after.StringForDiff():
+		return nil, ddl.ErrNoDifference
+	}
+
+	// ALTER SEQUENCE sequence_name ...;
+	alterSequenceStmt := &AlterSequenceStmt{
+		Comment: simplediff.Diff(before.StringForDiff(), after.StringForDiff()).String(),
+		Name:    after.Name,
+	}
+	beforeOptions, afterOptions := sequenceOptionsForDiff(before.Options), sequenceOptionsForDiff(after.Options)
+	for i := range afterOptions {
+		if beforeOptions[i].StringForDiff() != afterOptions[i].StringForDiff() {
+			alterSequenceStmt.Options = append(alterSequenceStmt.Options, afterOptions[i])
+		}
+	}
+	result.Stmts = append(result.Stmts, alterSequenceStmt)
+
+	return result, nil
+}
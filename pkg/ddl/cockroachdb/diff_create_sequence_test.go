@@ -0,0 +1,61 @@
+package cockroachdb
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestDiffCreateSequence(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,before,nil", func(t *testing.T) {
+		t.Parallel()
+
+		after := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("users_id_seq")}}
+		actual, err := DiffCreateSequence(nil, after)
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE SEQUENCE users_id_seq;\n", actual.String())
+	})
+
+	t.Run("success,after,nil", func(t *testing.T) {
+		t.Parallel()
+
+		before := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("users_id_seq")}}
+		actual, err := DiffCreateSequence(before, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "DROP SEQUENCE users_id_seq;\n", actual.String())
+	})
+
+	t.Run("success,NO_MAXVALUE,CYCLE", func(t *testing.T) {
+		t.Parallel()
+
+		before := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("users_id_seq")}, Options: []*SequenceOption{
+			{Name: SequenceOptionMaxValue, Value: NewRawIdent("100")},
+		}}
+		after := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("users_id_seq")}, Options: []*SequenceOption{
+			{Name: SequenceOptionNoMaxValue},
+			{Name: SequenceOptionCycle},
+		}}
+		actual, err := DiffCreateSequence(before, after)
+		require.NoError(t, err)
+		assert.Equal(t, `-- -CREATE SEQUENCE users_id_seq AS BIGINT INCREMENT 1 MINVALUE 1 MAXVALUE 100 START 1 CACHE 1 NO CYCLE
+-- +CREATE SEQUENCE users_id_seq AS BIGINT INCREMENT 1 MINVALUE 1 MAXVALUE 9223372036854775807 START 1 CACHE 1 CYCLE
+ALTER SEQUENCE users_id_seq MAXVALUE 9223372036854775807 CYCLE;
+`, actual.String())
+	})
+
+	t.Run("failure,ddl.ErrNoDifference", func(t *testing.T) {
+		t.Parallel()
+
+		before := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("users_id_seq")}, Options: []*SequenceOption{
+			{Name: SequenceOptionStart, Value: NewRawIdent("1")},
+		}}
+		after := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("users_id_seq")}}
+		_, err := DiffCreateSequence(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+}
@@ -13,6 +13,8 @@ import (
 
 type DiffCreateTableConfig struct {
 	UseAlterTableAddConstraintNotValid bool
+	Renames                            ddl.Renames
+	RenameHeuristic                    bool
 }
 
 type DiffCreateTableOption interface {
@@ -33,6 +35,34 @@ func (o *diffCreateTableConfigUseConstraintNotValid) apply(c *DiffCreateTableCon
 	c.UseAlterTableAddConstraintNotValid = o.useAlterTableAddConstraintNotValid
 }
 
+func DiffCreateTableRenames(renames ddl.Renames) DiffCreateTableOption { //nolint:ireturn
+	return &diffCreateTableConfigRenames{
+		renames: renames,
+	}
+}
+
+type diffCreateTableConfigRenames struct {
+	renames ddl.Renames
+}
+
+func (o *diffCreateTableConfigRenames) apply(c *DiffCreateTableConfig) {
+	c.Renames = append(c.Renames, o.renames...)
+}
+
+func DiffCreateTableRenameHeuristic(enabled bool) DiffCreateTableOption { //nolint:ireturn
+	return &diffCreateTableConfigRenameHeuristic{
+		renameHeuristic: enabled,
+	}
+}
+
+type diffCreateTableConfigRenameHeuristic struct {
+	renameHeuristic bool
+}
+
+func (o *diffCreateTableConfigRenameHeuristic) apply(c *DiffCreateTableConfig) {
+	c.RenameHeuristic = o.renameHeuristic
+}
+
 //nolint:funlen,cyclop
 func DiffCreateTable(before, after *CreateTableStmt, opts ...DiffCreateTableOption) (*DDL, error) {
 	config := &DiffCreateTableConfig{}
@@ -97,6 +127,10 @@ func DiffCreateTable(before, after *CreateTableStmt, opts ...DiffCreateTableOpti
 		}
 	}
 
+	// ALTER TABLE table_name RENAME COLUMN column_name TO new_column_name;
+	renameColumnStmts, before := config.renameColumns(before, after)
+	result.Stmts = append(result.Stmts, renameColumnStmts...)
+
 	config.diffCreateTableColumn(result, before, after)
 
 	for _, beforeConstraint := range before.Constraints {
@@ -209,8 +243,9 @@ func (config *DiffCreateTableConfig) diffCreateTableColumn(ddls *DDL, before, af
 				Comment: simplediff.Diff(beforeColumn.String(), afterColumn.String()).String(),
 				Name:    after.Name,
 				Action: &AlterColumnSetDataType{
-					Name:     afterColumn.Name,
-					DataType: afterColumn.DataType,
+					Name:        afterColumn.Name,
+					DataType:    afterColumn.DataType,
+					OldDataType: beforeColumn.DataType,
 				},
 			})
 		}
@@ -62,16 +62,19 @@ const (
 	TOKEN_UPDATE   TokenType = "UPDATE"
 
 	// OBJECT.
-	TOKEN_TABLE TokenType = "TABLE"
-	TOKEN_INDEX TokenType = "INDEX"
-	TOKEN_VIEW  TokenType = "VIEW"
+	TOKEN_TABLE        TokenType = "TABLE"
+	TOKEN_INDEX        TokenType = "INDEX"
+	TOKEN_VIEW         TokenType = "VIEW"
+	TOKEN_MATERIALIZED TokenType = "MATERIALIZED"
 
 	// OTHER.
-	TOKEN_IF     TokenType = "IF"
-	TOKEN_EXISTS TokenType = "EXISTS"
-	TOKEN_USING  TokenType = "USING"
-	TOKEN_ON     TokenType = "ON"
-	TOKEN_TO     TokenType = "TO"
+	TOKEN_IF      TokenType = "IF"
+	TOKEN_EXISTS  TokenType = "EXISTS"
+	TOKEN_USING   TokenType = "USING"
+	TOKEN_ON      TokenType = "ON"
+	TOKEN_TO      TokenType = "TO"
+	TOKEN_OR      TokenType = "OR"
+	TOKEN_REPLACE TokenType = "REPLACE"
 
 	// DATA TYPE.
 	TOKEN_BOOL              TokenType = "BOOL" //diff:ignore-line-postgres-cockroach
@@ -168,6 +171,8 @@ func lookupIdent(ident string) TokenType {
 		return TOKEN_INDEX
 	case "VIEW":
 		return TOKEN_VIEW
+	case "MATERIALIZED":
+		return TOKEN_MATERIALIZED
 	case "IF":
 		return TOKEN_IF
 	case "EXISTS":
@@ -178,6 +183,10 @@ func lookupIdent(ident string) TokenType {
 		return TOKEN_ON
 	case "TO":
 		return TOKEN_TO
+	case "OR":
+		return TOKEN_OR
+	case "REPLACE":
+		return TOKEN_REPLACE
 	case "BOOLEAN", "BOOL": //diff:ignore-line-postgres-cockroach
 		return TOKEN_BOOL //diff:ignore-line-postgres-cockroach
 	case "INT2", "SMALLINT": //diff:ignore-line-postgres-cockroach
@@ -31,10 +31,13 @@ func Test_lookupIdent(t *testing.T) {
 		{name: "success,TABLE", input: "TABLE", want: TOKEN_TABLE},
 		{name: "success,INDEX", input: "INDEX", want: TOKEN_INDEX},
 		{name: "success,VIEW", input: "VIEW", want: TOKEN_VIEW},
+		{name: "success,MATERIALIZED", input: "MATERIALIZED", want: TOKEN_MATERIALIZED},
 		{name: "success,IF", input: "IF", want: TOKEN_IF},
 		{name: "success,EXISTS", input: "EXISTS", want: TOKEN_EXISTS},
 		{name: "success,ON", input: "ON", want: TOKEN_ON},
 		{name: "success,TO", input: "TO", want: TOKEN_TO},
+		{name: "success,OR", input: "OR", want: TOKEN_OR},
+		{name: "success,REPLACE", input: "REPLACE", want: TOKEN_REPLACE},
 		{name: "success,BOOL", input: "BOOL", want: TOKEN_BOOL},
 		{name: "success,BOOLEAN", input: "BOOLEAN", want: TOKEN_BOOL},
 		{name: "success,SMALLINT", input: "SMALLINT", want: TOKEN_INT2},
@@ -118,6 +118,30 @@ func (p *Parser) parseCreateStatement() (Stmt, error) { //nolint:ireturn
 			return nil, apperr.Errorf("parseCreateIndexStmt: %w", err)
 		}
 		return stmt, nil
+	case TOKEN_OR, TOKEN_MATERIALIZED, TOKEN_VIEW:
+		stmt, err := p.parseCreateViewStmt()
+		if err != nil {
+			return nil, apperr.Errorf("parseCreateViewStmt: %w", err)
+		}
+		return stmt, nil
+	case TOKEN_IDENT:
+		// MEMO: SEQUENCE and SCHEMA are not tokenized because they are commonly used as column names.
+		switch strings.ToUpper(p.currentToken.Literal.Str) {
+		case "SEQUENCE":
+			stmt, err := p.parseCreateSequenceStmt()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateSequenceStmt: %w", err)
+			}
+			return stmt, nil
+		case "SCHEMA":
+			stmt, err := p.parseCreateSchemaStmt()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateSchemaStmt: %w", err)
+			}
+			return stmt, nil
+		default:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
 	default:
 		return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
 	}
@@ -276,6 +300,240 @@ func (p *Parser) parseCreateIndexStmt() (*CreateIndexStmt, error) {
 	return createIndexStmt, nil
 }
 
+func (p *Parser) parseCreateSchemaStmt() (*CreateSchemaStmt, error) {
+	createSchemaStmt := &CreateSchemaStmt{}
+
+	if p.isPeekToken(TOKEN_IF) {
+		p.nextToken() // current = IF
+		if err := p.checkPeekToken(TOKEN_NOT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = NOT
+		if err := p.checkPeekToken(TOKEN_EXISTS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = EXISTS
+		createSchemaStmt.IfNotExists = true
+	}
+
+	p.nextToken() // current = schema_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+	createSchemaStmt.Name = NewRawIdent(p.currentToken.Literal.Str)
+
+	return createSchemaStmt, nil
+}
+
+//nolint:cyclop,funlen,gocognit
+func (p *Parser) parseCreateSequenceStmt() (*CreateSequenceStmt, error) {
+	createSequenceStmt := &CreateSequenceStmt{}
+
+	if p.isPeekToken(TOKEN_IF) {
+		p.nextToken() // current = IF
+		if err := p.checkPeekToken(TOKEN_NOT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = NOT
+		if err := p.checkPeekToken(TOKEN_EXISTS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = EXISTS
+		createSequenceStmt.IfNotExists = true
+	}
+
+	p.nextToken() // current = sequence_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	createSequenceStmt.Name = NewObjectName(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("sequence_name=%s: ", createSequenceStmt.Name.StringForDiff())
+
+	p.nextToken() // current = option or ;
+
+	// MEMO: the option keywords such as INCREMENT or START are not tokenized for the same reason as SEQUENCE.
+LabelOptions:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_SEMICOLON, TOKEN_EOF:
+			break LabelOptions
+		case TOKEN_AS:
+			p.nextToken() // current = data_type
+			if !isDataType(p.currentToken.Type) && !p.isCurrentToken(TOKEN_IDENT) {
+				return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+			}
+			dataType, err := p.parseDataType()
+			if err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"parseDataType: %w", err)
+			}
+			createSequenceStmt.Options = append(createSequenceStmt.Options, &SequenceOption{Name: SequenceOptionAs, Value: NewRawIdent(dataType.String())})
+		case TOKEN_NO:
+			p.nextToken() // current = MINVALUE or MAXVALUE or CYCLE
+			switch name := strings.ToUpper(p.currentToken.Literal.Str); name {
+			case SequenceOptionMinValue, SequenceOptionMaxValue, SequenceOptionCycle:
+				createSequenceStmt.Options = append(createSequenceStmt.Options, &SequenceOption{Name: "NO " + name})
+			default:
+				return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+			}
+		case TOKEN_IDENT:
+			switch name := strings.ToUpper(p.currentToken.Literal.Str); name {
+			case SequenceOptionIncrement, SequenceOptionStart, SequenceOptionMinValue, SequenceOptionMaxValue, SequenceOptionCache:
+				if name == SequenceOptionIncrement && strings.EqualFold(p.peekToken.Literal.Str, "BY") ||
+					name == SequenceOptionStart && p.isPeekToken(TOKEN_WITH) {
+					p.nextToken() // current = BY or WITH
+				}
+				value, err := p.parseSequenceOptionValue()
+				if err != nil {
+					return nil, apperr.Errorf(errFmtPrefix+"parseSequenceOptionValue: %w", err)
+				}
+				createSequenceStmt.Options = append(createSequenceStmt.Options, &SequenceOption{Name: name, Value: value})
+			case SequenceOptionCycle:
+				createSequenceStmt.Options = append(createSequenceStmt.Options, &SequenceOption{Name: name})
+			default:
+				return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+			}
+		default:
+			return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+
+		p.nextToken()
+	}
+
+	return createSequenceStmt, nil
+}
+
+// parseSequenceOptionValue parses a numeric value of a sequence option, which may be negative.
+func (p *Parser) parseSequenceOptionValue() (*Ident, error) {
+	p.nextToken() // current = value or -
+	var sign string
+	if p.isCurrentToken(TOKEN_MINUS) {
+		sign = p.currentToken.Literal.Str
+		p.nextToken() // current = value
+	}
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+	return NewRawIdent(sign + p.currentToken.Literal.Str), nil
+}
+
+//nolint:cyclop,funlen
+func (p *Parser) parseCreateViewStmt() (*CreateViewStmt, error) {
+	createViewStmt := &CreateViewStmt{}
+
+	if p.isCurrentToken(TOKEN_OR) {
+		if err := p.checkPeekToken(TOKEN_REPLACE); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = REPLACE
+		createViewStmt.OrReplace = true
+		p.nextToken() // current = MATERIALIZED or VIEW
+	}
+
+	if p.isCurrentToken(TOKEN_MATERIALIZED) {
+		createViewStmt.Materialized = true
+		p.nextToken() // current = VIEW
+	}
+
+	if err := p.checkCurrentToken(TOKEN_VIEW); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	if p.isPeekToken(TOKEN_IF) {
+		p.nextToken() // current = IF
+		if err := p.checkPeekToken(TOKEN_NOT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = NOT
+		if err := p.checkPeekToken(TOKEN_EXISTS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = EXISTS
+		createViewStmt.IfNotExists = true
+	}
+
+	p.nextToken() // current = view_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	createViewStmt.Name = NewObjectName(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("view_name=%s: ", createViewStmt.Name.StringForDiff())
+
+	p.nextToken() // current = ( or AS
+
+	if p.isCurrentToken(TOKEN_OPEN_PAREN) {
+		idents, err := p.parseColumnIdents()
+		if err != nil {
+			return nil, apperr.Errorf(errFmtPrefix+"parseColumnIdents: %w", err)
+		}
+		for _, ident := range idents {
+			createViewStmt.Columns = append(createViewStmt.Columns, ident.Ident)
+		}
+	}
+
+	if err := p.checkCurrentToken(TOKEN_AS); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+	}
+
+	p.nextToken() // current = SELECT or ...
+
+	query, err := p.parseQuery()
+	if err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"parseQuery: %w", err)
+	}
+	createViewStmt.Query = query
+
+	return createViewStmt, nil
+}
+
+// parseQuery は SEMICOLON または EOF までのトークンをクエリとして読み込みます。
+func (p *Parser) parseQuery() (*Query, error) {
+	query := &Query{}
+	depth := 0
+
+LabelQuery:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_SEMICOLON:
+			if depth == 0 {
+				break LabelQuery
+			}
+		case TOKEN_EOF:
+			break LabelQuery
+		case TOKEN_OPEN_PAREN:
+			depth++
+		case TOKEN_CLOSE_PAREN:
+			depth--
+		}
+
+		value := p.currentToken.Literal.Str
+		// MEMO: join the operators such as >=, <=, <> and != that the lexer splits into two tokens.
+		for isQueryOperator(p.currentToken.Literal.Str) && p.isPeekToken(TOKEN_EQUAL, TOKEN_GREATER) {
+			p.nextToken()
+			value += p.currentToken.Literal.Str
+		}
+		query.Idents = append(query.Idents, NewRawIdent(value))
+
+		p.nextToken()
+	}
+
+	if depth != 0 || len(query.Idents) == 0 {
+		return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+	}
+
+	return query, nil
+}
+
+func isQueryOperator(literal string) bool {
+	switch literal {
+	case "<", ">", "!":
+		return true
+	default:
+		return false
+	}
+}
+
 //nolint:funlen,cyclop
 func (p *Parser) parseColumn(tableName *Ident) (*Column, []Constraint, error) {
 	column := &Column{}
@@ -152,6 +152,70 @@ CREATE TABLE IF NOT EXISTS complex_defaults (
 		})
 	}
 
+	t.Run("success,CREATE_VIEW", func(t *testing.T) {
+		t.Parallel()
+
+		input := `CREATE VIEW public.active_users AS SELECT id, "name" FROM public.users WHERE (active = true AND age >= 20);
+CREATE OR REPLACE VIEW user_names (id, name) AS
+ SELECT users.id,
+    users.name::text
+   FROM users;
+CREATE MATERIALIZED VIEW IF NOT EXISTS user_counts AS SELECT group_id, count(*) AS cnt FROM users WHERE name != 'a;b' GROUP BY group_id;
+`
+		expected := `CREATE VIEW public.active_users AS SELECT id, "name" FROM public.users WHERE (active = true AND age >= 20);
+CREATE OR REPLACE VIEW user_names (id, name) AS SELECT users.id, users.name::text FROM users;
+CREATE MATERIALIZED VIEW IF NOT EXISTS user_counts AS SELECT group_id, count (*) AS cnt FROM users WHERE name != 'a;b' GROUP BY group_id;
+`
+
+		l := NewLexer(input)
+		p := NewParser(l)
+		actual, err := p.Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+
+		t.Logf("ℹ️: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		t.Logf("ℹ️: %s: stmt: %%s: \n%s", t.Name(), actual)
+	})
+
+	t.Run("success,CREATE_VIEW_SHOW_CREATE_ALL_TABLES", func(t *testing.T) {
+		t.Parallel()
+
+		input := "CREATE VIEW public.active_users (\n\tid,\n\tname\n) AS SELECT users.id, users.name FROM defaultdb.public.users WHERE users.active;\n" +
+			"CREATE MATERIALIZED VIEW public.user_counts (\n\tcnt\n) AS SELECT count(*) AS cnt FROM defaultdb.public.users;\n"
+		expected := `CREATE VIEW public.active_users (id, name) AS SELECT users.id, users.name FROM defaultdb.public.users WHERE users.active;
+CREATE MATERIALIZED VIEW public.user_counts (cnt) AS SELECT count (*) AS cnt FROM defaultdb.public.users;
+`
+
+		actual, err := NewParser(NewLexer(input)).Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,CREATE_SEQUENCE", func(t *testing.T) {
+		t.Parallel()
+
+		// MEMO: SHOW CREATE ALL TABLES format
+		input := `CREATE SEQUENCE public.users_id_seq MINVALUE 1 MAXVALUE 9223372036854775807 INCREMENT 1 START 1;
+CREATE SEQUENCE IF NOT EXISTS public.orders_id_seq AS INT8 INCREMENT BY -1 NO MINVALUE START WITH -1 CACHE 10 CYCLE
+`
+		expected := `CREATE SEQUENCE public.users_id_seq MINVALUE 1 MAXVALUE 9223372036854775807 INCREMENT 1 START 1;
+CREATE SEQUENCE IF NOT EXISTS public.orders_id_seq AS INT8 INCREMENT -1 NO MINVALUE START -1 CACHE 10 CYCLE;
+`
+
+		actual, err := NewParser(NewLexer(input)).Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
 	failureTests := []struct {
 		name    string
 		input   string
@@ -427,6 +491,91 @@ CREATE TABLE IF NOT EXISTS complex_defaults (
 			input:   `CREATE INDEX users_idx_username ON users USING btree (NOT)`,
 			wantErr: ddl.ErrUnexpectedCurrentToken,
 		},
+		{
+			name:    "failure,CREATE_OR_INVALID",
+			input:   `CREATE OR NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_MATERIALIZED_INVALID",
+			input:   `CREATE MATERIALIZED TABLE`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_IF_INVALID",
+			input:   `CREATE VIEW IF;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_IF_NOT_INVALID",
+			input:   `CREATE VIEW IF NOT;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_INVALID",
+			input:   `CREATE VIEW;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_view_name_INVALID",
+			input:   `CREATE VIEW users_view SELECT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_view_name_OPEN_PAREN_INVALID",
+			input:   `CREATE VIEW users_view (NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_view_name_AS_INVALID",
+			input:   `CREATE VIEW users_view AS;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_view_name_AS_OPEN_PAREN_INVALID",
+			input:   `CREATE VIEW users_view AS (SELECT 1;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_INVALID",
+			input:   `CREATE SEQUENCE NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_IF_INVALID",
+			input:   `CREATE SEQUENCE IF;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_IF_NOT_INVALID",
+			input:   `CREATE SEQUENCE IF NOT;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_INVALID",
+			input:   `CREATE SEQUENCE users_id_seq NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_UNKNOWN_OPTION",
+			input:   `CREATE SEQUENCE users_id_seq OWNED BY users.id`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_NO_INVALID",
+			input:   `CREATE SEQUENCE users_id_seq NO CACHE`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_AS_INVALID",
+			input:   `CREATE SEQUENCE users_id_seq AS`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_INCREMENT_INVALID",
+			input:   `CREATE SEQUENCE users_id_seq INCREMENT BY;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
 	}
 
 	for _, tt := range failureTests {
@@ -10,4 +10,6 @@ var (
 	ErrNoDifference            = errors.New("no difference")
 	ErrNotSupported            = errors.New("not supported")
 	ErrAlterOptionNotSupported = errors.New("alter option not supported")
+	ErrInvalidRenameHint       = errors.New("invalid rename hint")
+	ErrInvalidTablePattern     = errors.New("invalid table pattern")
 )
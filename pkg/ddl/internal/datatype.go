@@ -0,0 +1,114 @@
+package internal
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DataTypeFamily is a list of the data types that can be converted to each other, ordered by their ranges.
+// The data types in the same element are synonyms, such as {"DECIMAL", "NUMERIC"}.
+type DataTypeFamily [][]string
+
+func (f DataTypeFamily) rank(name string) int {
+	for i, synonyms := range f {
+		for _, synonym := range synonyms {
+			if synonym == name {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// IsNarrowingDataType reports whether changing the data type from before to after may lose the values.
+//
+// before and after are the data types for diff such as "VARCHAR(255)" or "NUMERIC(10,2)".
+// The change is regarded as narrowing unless it is known to be widening, that is,
+// after has the same or a later rank in one of the families, and its length or precision is not reduced.
+func IsNarrowingDataType(before, after string, families ...DataTypeFamily) bool {
+	if before == after {
+		return false
+	}
+
+	beforeName, beforeArgs := splitDataType(before)
+	afterName, afterArgs := splitDataType(after)
+
+	if beforeName != afterName {
+		widening := false
+		for _, family := range families {
+			beforeRank, afterRank := family.rank(beforeName), family.rank(afterName)
+			if beforeRank >= 0 && afterRank >= 0 && beforeRank <= afterRank {
+				widening = true
+				break
+			}
+		}
+		if !widening {
+			return true
+		}
+		// MEMO: The arguments of the different data types cannot be compared, so only the unlimited one is regarded as widening.
+		return len(afterArgs) != 0
+	}
+
+	return isNarrowingArgs(beforeArgs, afterArgs)
+}
+
+// isNarrowingArgs compares the length, or the precision and the scale of the same data type.
+func isNarrowingArgs(before, after []string) bool {
+	switch {
+	case len(after) == 0:
+		// e.g. VARCHAR(255) -> VARCHAR
+		return false
+	case len(before) == 0:
+		// e.g. VARCHAR -> VARCHAR(255)
+		return true
+	case len(before) != len(after):
+		return true
+	}
+
+	const unlimited = int(^uint(0) >> 1)
+	parse := func(s string) (int, bool) {
+		if strings.EqualFold(s, "MAX") {
+			return unlimited, true
+		}
+		v, err := strconv.Atoi(s)
+		return v, err == nil
+	}
+
+	values := make([][2]int, len(before))
+	for i := range before {
+		b, ok := parse(before[i])
+		if !ok {
+			return true
+		}
+		a, ok := parse(after[i])
+		if !ok {
+			return true
+		}
+		values[i] = [2]int{b, a}
+	}
+
+	const precisionAndScale = 2
+	if len(values) == precisionAndScale {
+		// e.g. NUMERIC(10,2) -> NUMERIC(12,2): both the integer digits and the scale must not be reduced.
+		precision, scale := values[0], values[1]
+		return scale[1] < scale[0] || precision[1]-scale[1] < precision[0]-scale[0]
+	}
+
+	for _, v := range values {
+		if v[1] < v[0] {
+			return true
+		}
+	}
+	return false
+}
+
+func splitDataType(dataType string) (name string, args []string) {
+	name, rest, ok := strings.Cut(dataType, "(")
+	if !ok {
+		return strings.TrimSpace(dataType), nil
+	}
+	for _, arg := range strings.Split(strings.TrimSuffix(strings.TrimSpace(rest), ")"), ",") {
+		args = append(args, strings.TrimSpace(arg))
+	}
+	return strings.TrimSpace(name), args
+}
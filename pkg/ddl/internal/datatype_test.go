@@ -0,0 +1,44 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+)
+
+func TestIsNarrowingDataType(t *testing.T) {
+	t.Parallel()
+
+	families := []DataTypeFamily{
+		{{"SMALLINT"}, {"INTEGER"}, {"BIGINT"}, {"DECIMAL", "NUMERIC"}},
+		{{"VARCHAR"}, {"TEXT"}},
+	}
+
+	for _, tt := range []struct {
+		before, after string
+		expected      bool
+	}{
+		{"INTEGER", "INTEGER", false},
+		{"INTEGER", "BIGINT", false},
+		{"BIGINT", "INTEGER", true},
+		{"INTEGER", "NUMERIC", false},
+		{"INTEGER", "NUMERIC(5)", true},
+		{"INTEGER", "TEXT", true},
+		{"VARCHAR(10)", "VARCHAR(20)", false},
+		{"VARCHAR(20)", "VARCHAR(10)", true},
+		{"VARCHAR(20)", "VARCHAR", false},
+		{"VARCHAR", "VARCHAR(20)", true},
+		{"VARCHAR(20)", "TEXT", false},
+		{"TEXT", "VARCHAR(20)", true},
+		{"STRING(20)", "STRING(MAX)", false},
+		{"STRING(MAX)", "STRING(20)", true},
+		{"NUMERIC(10,2)", "NUMERIC(12,2)", false},
+		{"NUMERIC(10,2)", "NUMERIC(10,0)", true},
+		{"NUMERIC(10,2)", "NUMERIC(10,4)", true},
+		{"NUMERIC(10,2)", "NUMERIC(12,4)", false},
+	} {
+		if !assert.Equal(t, tt.expected, IsNarrowingDataType(tt.before, tt.after, families...)) {
+			t.Errorf("❌: %s -> %s", tt.before, tt.after)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package internal
+
+// TopologicalSort returns the indexes of n nodes ordered so that each node comes after the nodes it depends on.
+// The original order is kept as far as possible.
+//
+// If the remaining nodes form a cycle, the first remaining node on the cycle is taken anyway,
+// and the dependencies that are not satisfied are returned as broken pairs of (node, dependency).
+func TopologicalSort(n int, dependsOn func(i, j int) bool) (order []int, broken [][2]int) {
+	done := make([]bool, n)
+	order = make([]int, 0, n)
+
+	for len(order) < n {
+		next := -1
+	LabelFindReady:
+		for i := range n {
+			if done[i] {
+				continue
+			}
+			for j := range n {
+				if i != j && !done[j] && dependsOn(i, j) {
+					continue LabelFindReady
+				}
+			}
+			next = i
+			break
+		}
+
+		if next < 0 {
+			for i := range n {
+				if !done[i] && isOnCycle(i, n, done, dependsOn) {
+					next = i
+					break
+				}
+			}
+			for j := range n {
+				if next != j && !done[j] && dependsOn(next, j) {
+					broken = append(broken, [2]int{next, j})
+				}
+			}
+		}
+
+		done[next] = true
+		order = append(order, next)
+	}
+
+	return order, broken
+}
+
+// isOnCycle reports whether the node can reach itself through the dependencies among the nodes that are not done yet.
+func isOnCycle(node, n int, done []bool, dependsOn func(i, j int) bool) bool {
+	visited := make([]bool, n)
+	stack := []int{node}
+	for len(stack) > 0 {
+		i := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for j := range n {
+			if i == j || done[j] || !dependsOn(i, j) {
+				continue
+			}
+			if j == node {
+				return true
+			}
+			if !visited[j] {
+				visited[j] = true
+				stack = append(stack, j)
+			}
+		}
+	}
+	return false
+}
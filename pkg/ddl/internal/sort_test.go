@@ -0,0 +1,62 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+)
+
+func TestTopologicalSort(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		// 0 -> 2, 1 -> 0
+		deps := map[int][]int{0: {2}, 1: {0}}
+		order, broken := TopologicalSort(3, func(i, j int) bool {
+			for _, d := range deps[i] {
+				if d == j {
+					return true
+				}
+			}
+			return false
+		})
+		assert.Equal(t, []int{2, 0, 1}, order)
+		assert.Equal(t, 0, len(broken))
+	})
+
+	t.Run("success,cycle", func(t *testing.T) {
+		t.Parallel()
+
+		// 0 -> 1, 1 -> 0, 2 -> 1
+		deps := map[int][]int{0: {1}, 1: {0}, 2: {1}}
+		order, broken := TopologicalSort(3, func(i, j int) bool {
+			for _, d := range deps[i] {
+				if d == j {
+					return true
+				}
+			}
+			return false
+		})
+		assert.Equal(t, []int{0, 1, 2}, order)
+		assert.Equal(t, [][2]int{{0, 1}}, broken)
+	})
+
+	t.Run("success,cycle,blocked", func(t *testing.T) {
+		t.Parallel()
+
+		// 0 -> 1, 1 -> 2, 2 -> 1
+		deps := map[int][]int{0: {1}, 1: {2}, 2: {1}}
+		order, broken := TopologicalSort(3, func(i, j int) bool {
+			for _, d := range deps[i] {
+				if d == j {
+					return true
+				}
+			}
+			return false
+		})
+		assert.Equal(t, []int{1, 0, 2}, order)
+		assert.Equal(t, [][2]int{{1, 2}}, broken)
+	})
+}
@@ -0,0 +1,43 @@
+package mysql
+
+import (
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+//nolint:gochecknoglobals
+var dataTypeFamilies = []internal.DataTypeFamily{
+	{{string(TOKEN_TINYINT)}, {string(TOKEN_SMALLINT)}, {string(TOKEN_INTEGER)}, {string(TOKEN_BIGINT)}, {string(TOKEN_DECIMAL), string(TOKEN_NUMERIC)}},
+	{{string(TOKEN_REAL), string(TOKEN_DOUBLE)}},
+	{{string(TOKEN_CHAR), string(TOKEN_CHARACTER)}, {string(TOKEN_VARCHAR), string(TOKEN_VARYING)}, {string(TOKEN_TEXT)}, {string(TOKEN_MEDIUMTEXT)}, {string(TOKEN_LONGTEXT)}},
+	{{string(TOKEN_DATE)}, {string(TOKEN_DATETIME)}},
+	{{string(TOKEN_TIMESTAMP)}, {string(TOKEN_DATETIME)}},
+}
+
+// ClassifyStmt returns the class of the impact of stmt on the running database.
+func ClassifyStmt(stmt Stmt) ddl.ChangeClass {
+	switch s := stmt.(type) {
+	case *DropTableStmt:
+		return ddl.ChangeClassDataLoss
+	case *AlterTableStmt:
+		return classifyAlterTableAction(s.Action)
+	default:
+		return ddl.ChangeClassSafe // MEMO: CREATE INDEX and DROP INDEX are online DDL in InnoDB.
+	}
+}
+
+func classifyAlterTableAction(action AlterTableAction) ddl.ChangeClass {
+	switch a := action.(type) {
+	case *DropColumn:
+		return ddl.ChangeClassDataLoss
+	case *ModifyColumn:
+		if a.OldDataType != nil && internal.IsNarrowingDataType(a.OldDataType.StringForDiff(), a.DataType.StringForDiff(), dataTypeFamilies...) {
+			return ddl.ChangeClassDataLoss
+		}
+		return ddl.ChangeClassLocking // MEMO: MODIFY COLUMN may copy the table.
+	case *AddConstraint, *AlterTableOption:
+		return ddl.ChangeClassLocking // MEMO: The table is rebuilt or scanned.
+	default:
+		return ddl.ChangeClassSafe
+	}
+}
@@ -0,0 +1,42 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestClassifyStmt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer("CREATE TABLE `groups` (id VARCHAR(36) NOT NULL);\nCREATE TABLE `users` (id VARCHAR(36) NOT NULL, `name` VARCHAR(20) NOT NULL, age INT, memo TEXT, PRIMARY KEY (id));")).Parse()
+		require.NoError(t, err)
+		after, err := NewParser(NewLexer("CREATE TABLE `users` (id VARCHAR(36) NOT NULL, `name` VARCHAR(10) NOT NULL, age BIGINT, note TEXT, PRIMARY KEY (id));\nCREATE INDEX users_idx_name ON `users` (`name`);")).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+
+		expected := []ddl.ChangeClass{
+			ddl.ChangeClassDataLoss, // DROP TABLE
+			ddl.ChangeClassSafe,     // CREATE INDEX
+			ddl.ChangeClassDataLoss, // MODIFY VARCHAR(10)
+			ddl.ChangeClassLocking,  // MODIFY BIGINT
+			ddl.ChangeClassDataLoss, // DROP COLUMN
+			ddl.ChangeClassSafe,     // ADD COLUMN
+		}
+		classes := make([]ddl.ChangeClass, 0, len(actual.Stmts))
+		for _, stmt := range actual.Stmts {
+			classes = append(classes, ClassifyStmt(stmt))
+		}
+		if !assert.Equal(t, expected, classes) {
+			t.Logf("❌: %s", actual)
+		}
+	})
+}
@@ -166,6 +166,7 @@ func (s *DropColumn) GoString() string { return internal.GoString(*s) }
 type ModifyColumn struct {
 	Name          *Ident
 	DataType      *DataType
+	OldDataType   *DataType // MEMO: not printed, but used to detect a narrowing change.
 	CharacterSet  *Ident
 	Collate       *Ident
 	NotNull       bool
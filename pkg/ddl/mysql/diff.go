@@ -11,21 +11,80 @@ import (
 	"github.com/kunitsucom/ddlctl/pkg/ddl"
 )
 
-//nolint:funlen,cyclop,gocognit
-func Diff(before, after *DDL) (*DDL, error) {
+type DiffConfig struct {
+	Renames         ddl.Renames
+	RenameHeuristic bool
+}
+
+type DiffOption interface {
+	apply(c *DiffConfig)
+}
+
+// DiffRenames declares the tables and columns renamed from before to after,
+// so that they are renamed by ALTER TABLE instead of being dropped and created.
+func DiffRenames(renames ddl.Renames) DiffOption { //nolint:ireturn
+	return &diffConfigRenames{
+		renames: renames,
+	}
+}
+
+type diffConfigRenames struct {
+	renames ddl.Renames
+}
+
+func (o *diffConfigRenames) apply(c *DiffConfig) {
+	c.Renames = append(c.Renames, o.renames...)
+}
+
+// DiffRenameHeuristic regards a dropped column and an added column that have the same definition as renamed.
+func DiffRenameHeuristic(enabled bool) DiffOption { //nolint:ireturn
+	return &diffConfigRenameHeuristic{
+		renameHeuristic: enabled,
+	}
+}
+
+type diffConfigRenameHeuristic struct {
+	renameHeuristic bool
+}
+
+func (o *diffConfigRenameHeuristic) apply(c *DiffConfig) {
+	c.RenameHeuristic = o.renameHeuristic
+}
+
+//nolint:funlen,cyclop,gocognit,gocyclo
+func Diff(before, after *DDL, opts ...DiffOption) (*DDL, error) {
+	config := &DiffConfig{}
+
+	for _, opt := range opts {
+		opt.apply(config)
+	}
+
 	result := &DDL{}
 
 	switch {
 	case before == nil && after != nil:
-		result.Stmts = append(result.Stmts, after.Stmts...)
+		// MEMO: Tables are created in the order of their foreign key dependencies.
+		createTableStmts := make([]*CreateTableStmt, 0)
+		for _, stmt := range after.Stmts {
+			if s, ok := stmt.(*CreateTableStmt); ok {
+				createTableStmts = append(createTableStmts, s)
+			}
+		}
+		sortedCreateTableStmts, addConstraintStmts := sortCreateTableStmts(createTableStmts)
+		result.Stmts = append(result.Stmts, sortedCreateTableStmts...)
+		for _, stmt := range after.Stmts {
+			if _, ok := stmt.(*CreateTableStmt); !ok {
+				result.Stmts = append(result.Stmts, stmt)
+			}
+		}
+		result.Stmts = append(result.Stmts, addConstraintStmts...)
 		return result, nil
 	case before != nil && after == nil:
+		dropTableStmts := make([]*CreateTableStmt, 0)
 		for _, stmt := range before.Stmts {
 			switch s := stmt.(type) {
 			case *CreateTableStmt:
-				result.Stmts = append(result.Stmts, &DropTableStmt{
-					Name: s.Name,
-				})
+				dropTableStmts = append(dropTableStmts, s)
 			case *CreateIndexStmt:
 				result.Stmts = append(result.Stmts, &DropIndexStmt{
 					Name: s.Name,
@@ -34,18 +93,24 @@ func Diff(before, after *DDL) (*DDL, error) {
 				return nil, apperr.Errorf("%s: %T: %w", s.GetNameForDiff(), s, ddl.ErrNotSupported)
 			}
 		}
+		// MEMO: Tables are dropped in the reverse order of their foreign key dependencies.
+		result.Stmts = append(result.Stmts, sortDropTableStmts(dropTableStmts)...)
 		return result, nil
 	case (before == nil && after == nil) || reflect.DeepEqual(before, after) || before.String() == after.String():
 		return nil, ddl.ErrNoDifference
 	}
 
 	// DROP TABLE table_name;
+	// MEMO: Tables are dropped in the reverse order of their foreign key dependencies.
+	dropTableStmts := make([]*CreateTableStmt, 0)
 	for _, stmt := range onlyLeftStmt(before, after) {
 		switch beforeStmt := stmt.(type) {
 		case *CreateTableStmt:
-			result.Stmts = append(result.Stmts, &DropTableStmt{
-				Name: beforeStmt.Name,
-			})
+			if config.findRenamedTable(beforeStmt, before, after) != nil {
+				// MEMO: renamed by ALTER TABLE
+				continue
+			}
+			dropTableStmts = append(dropTableStmts, beforeStmt)
 		case *CreateIndexStmt:
 			result.Stmts = append(result.Stmts, &DropIndexStmt{
 				Name: beforeStmt.Name,
@@ -54,27 +119,45 @@ func Diff(before, after *DDL) (*DDL, error) {
 			return nil, apperr.Errorf("%s: %T: %w", beforeStmt.GetNameForDiff(), beforeStmt, ddl.ErrNotSupported)
 		}
 	}
+	result.Stmts = append(result.Stmts, sortDropTableStmts(dropTableStmts)...)
 
 	// CREATE TABLE table_name
+	// MEMO: Tables are created in the order of their foreign key dependencies, and then the indexes are created.
+	createTableStmts := make([]*CreateTableStmt, 0)
+	createIndexStmts := make([]Stmt, 0)
 	for _, stmt := range onlyLeftStmt(after, before) {
 		switch afterStmt := stmt.(type) {
 		case *CreateTableStmt:
-			result.Stmts = append(result.Stmts, afterStmt)
+			if config.isRenamedTable(afterStmt, before, after) {
+				// MEMO: renamed by ALTER TABLE
+				continue
+			}
+			createTableStmts = append(createTableStmts, afterStmt)
 		case *CreateIndexStmt:
-			result.Stmts = append(result.Stmts, afterStmt)
+			createIndexStmts = append(createIndexStmts, afterStmt)
 		default:
 			return nil, apperr.Errorf("%s: %T: %w", afterStmt.GetNameForDiff(), afterStmt, ddl.ErrNotSupported)
 		}
 	}
+	sortedCreateTableStmts, addConstraintStmts := sortCreateTableStmts(createTableStmts)
+	result.Stmts = append(result.Stmts, sortedCreateTableStmts...)
+	result.Stmts = append(result.Stmts, createIndexStmts...)
+	result.Stmts = append(result.Stmts, addConstraintStmts...)
 
 	// ALTER TABLE table_name ...
 	// DROP INDEX index_name; CREATE INDEX index_name ...
 	for _, beforeStmt := range before.Stmts {
 		switch beforeStmt := beforeStmt.(type) { //nolint:gocritic
 		case *CreateTableStmt:
-			if afterStmt := findStmtByTypeAndName(beforeStmt, after.Stmts); afterStmt != nil {
-				afterStmt := afterStmt.(*CreateTableStmt) //nolint:forcetypeassert
-				alterStmt, err := DiffCreateTable(beforeStmt, afterStmt)
+			afterStmt, _ := findStmtByTypeAndName(beforeStmt, after.Stmts).(*CreateTableStmt)
+			if afterStmt == nil {
+				afterStmt = config.findRenamedTable(beforeStmt, before, after)
+			}
+			if afterStmt != nil {
+				alterStmt, err := DiffCreateTable(beforeStmt, afterStmt,
+					DiffCreateTableRenames(config.Renames),
+					DiffCreateTableRenameHeuristic(config.RenameHeuristic),
+				)
 				if err == nil {
 					result.Stmts = append(result.Stmts, alterStmt.Stmts...)
 				}
@@ -13,6 +13,8 @@ import (
 
 type DiffCreateTableConfig struct {
 	UseAlterTableAddConstraintNotValid bool
+	Renames                            ddl.Renames
+	RenameHeuristic                    bool
 }
 
 type DiffCreateTableOption interface {
@@ -33,6 +35,34 @@ func (o *diffCreateTableConfigUseConstraintNotValid) apply(c *DiffCreateTableCon
 	c.UseAlterTableAddConstraintNotValid = o.useAlterTableAddConstraintNotValid
 }
 
+func DiffCreateTableRenames(renames ddl.Renames) DiffCreateTableOption { //nolint:ireturn
+	return &diffCreateTableConfigRenames{
+		renames: renames,
+	}
+}
+
+type diffCreateTableConfigRenames struct {
+	renames ddl.Renames
+}
+
+func (o *diffCreateTableConfigRenames) apply(c *DiffCreateTableConfig) {
+	c.Renames = append(c.Renames, o.renames...)
+}
+
+func DiffCreateTableRenameHeuristic(enabled bool) DiffCreateTableOption { //nolint:ireturn
+	return &diffCreateTableConfigRenameHeuristic{
+		renameHeuristic: enabled,
+	}
+}
+
+type diffCreateTableConfigRenameHeuristic struct {
+	renameHeuristic bool
+}
+
+func (o *diffCreateTableConfigRenameHeuristic) apply(c *DiffCreateTableConfig) {
+	c.RenameHeuristic = o.renameHeuristic
+}
+
 //nolint:funlen,cyclop,gocognit
 func DiffCreateTable(before, after *CreateTableStmt, opts ...DiffCreateTableOption) (*DDL, error) {
 	config := &DiffCreateTableConfig{}
@@ -100,6 +130,10 @@ func DiffCreateTable(before, after *CreateTableStmt, opts ...DiffCreateTableOpti
 		}
 	}
 
+	// ALTER TABLE table_name RENAME COLUMN column_name TO new_column_name;
+	renameColumnStmts, before := config.renameColumns(before, after)
+	result.Stmts = append(result.Stmts, renameColumnStmts...)
+
 	config.diffCreateTableColumn(result, before, after)
 
 	for _, beforeConstraint := range before.Constraints {
@@ -261,6 +295,7 @@ func (config *DiffCreateTableConfig) diffCreateTableColumn(ddls *DDL, before, af
 				Action: &ModifyColumn{
 					Name:          afterColumn.Name,
 					DataType:      afterColumn.DataType,
+					OldDataType:   beforeColumn.DataType,
 					Collate:       afterColumn.Collate,
 					NotNull:       afterColumn.NotNull,
 					AutoIncrement: afterColumn.AutoIncrement,
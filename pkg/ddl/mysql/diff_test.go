@@ -437,4 +437,76 @@ ALTER TABLE users MODIFY user_id BIGINT(36) NOT NULL AUTO_INCREMENT;
 			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
 		}
 	})
+
+	t.Run("success,before,nil,ForeignKey,Cycle", func(t *testing.T) {
+		t.Parallel()
+
+		after, err := NewParser(NewLexer(`CREATE TABLE users (id VARCHAR(36) NOT NULL, group_id VARCHAR(36) NOT NULL, PRIMARY KEY (id), CONSTRAINT users_group_id_fkey FOREIGN KEY (group_id) REFERENCES ` + "`groups`" + ` (id)); CREATE TABLE ` + "`groups`" + ` (id VARCHAR(36) NOT NULL, owner_id VARCHAR(36), PRIMARY KEY (id), CONSTRAINT groups_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES users (id)); CREATE TABLE logs (id VARCHAR(36) NOT NULL, user_id VARCHAR(36), CONSTRAINT logs_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id));`)).Parse()
+		require.NoError(t, err)
+
+		expected := `CREATE TABLE users (
+    id VARCHAR(36) NOT NULL,
+    group_id VARCHAR(36) NOT NULL,
+    PRIMARY KEY (id)
+);
+CREATE TABLE ` + "`groups`" + ` (
+    id VARCHAR(36) NOT NULL,
+    owner_id VARCHAR(36) NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT groups_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES users (id)
+);
+CREATE TABLE logs (
+    id VARCHAR(36) NOT NULL,
+    user_id VARCHAR(36) NULL,
+    CONSTRAINT logs_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id)
+);
+ALTER TABLE users ADD CONSTRAINT users_group_id_fkey FOREIGN KEY (group_id) REFERENCES ` + "`groups`" + ` (id);
+`
+		actual, err := Diff(nil, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+
+		expected = `ALTER TABLE users DROP CONSTRAINT users_group_id_fkey;
+DROP TABLE logs;
+DROP TABLE ` + "`groups`" + `;
+DROP TABLE users;
+`
+		actual, err = Diff(after, nil)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,DiffRenames", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE old_users (id VARCHAR(36) NOT NULL, name VARCHAR(255) NOT NULL, age INT, PRIMARY KEY (id), UNIQUE KEY users_unique_name (name));`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TABLE users (id VARCHAR(36) NOT NULL, full_name VARCHAR(255) NOT NULL, years INT, PRIMARY KEY (id), UNIQUE KEY users_unique_name (full_name));`)).Parse()
+		require.NoError(t, err)
+
+		renames, err := ddl.ParseRenames("old_users=users\nusers.name=users.full_name")
+		require.NoError(t, err)
+
+		expected := `-- -old_users
+-- +users
+ALTER TABLE old_users RENAME TO users;
+-- -name VARCHAR(255) NOT NULL
+-- +full_name VARCHAR(255) NOT NULL
+ALTER TABLE users RENAME COLUMN name TO full_name;
+-- NOTE: the column age is regarded as renamed to years because they have the same definition.
+-- -age INT NULL
+-- +years INT NULL
+ALTER TABLE users RENAME COLUMN age TO years;
+`
+		actual, err := Diff(before, after, DiffRenames(renames), DiffRenameHeuristic(true))
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
 }
@@ -0,0 +1,59 @@
+package postgres
+
+import (
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+//nolint:gochecknoglobals
+var dataTypeFamilies = []internal.DataTypeFamily{
+	{{string(TOKEN_SMALLINT)}, {string(TOKEN_INTEGER)}, {string(TOKEN_BIGINT)}, {string(TOKEN_DECIMAL), string(TOKEN_NUMERIC)}}, //diff:ignore-line-postgres-cockroach
+	{{string(TOKEN_REAL)}, {string(TOKEN_DOUBLE_PRECISION)}},                                                                    //diff:ignore-line-postgres-cockroach
+	{{string(TOKEN_CHARACTER)}, {string(TOKEN_VARYING), string(TOKEN_CHARACTER_VARYING)}, {string(TOKEN_TEXT)}},                 //diff:ignore-line-postgres-cockroach
+	{{string(TOKEN_TIMESTAMP)}, {string(TOKEN_TIMESTAMPTZ), string(TOKEN_TIMESTAMP_WITH_TIME_ZONE)}},                            //diff:ignore-line-postgres-cockroach
+}
+
+// ClassifyStmt returns the class of the impact of stmt on the running database.
+func ClassifyStmt(stmt Stmt) ddl.ChangeClass {
+	switch s := stmt.(type) {
+	case *DropTableStmt, *DropSequenceStmt:
+		return ddl.ChangeClassDataLoss
+	case *DropTypeStmt:
+		return ddl.ChangeClassDataLoss // MEMO: The enum type is dropped after it is recreated without the removed labels.
+	case *AlterTypeStmt:
+		if _, ok := s.Action.(*RenameType); ok {
+			return ddl.ChangeClassDataLoss // MEMO: The enum type is renamed to be recreated, because the labels cannot be removed or reordered.
+		}
+		return ddl.ChangeClassSafe
+	case *CreateIndexStmt:
+		return ddl.ChangeClassLocking // MEMO: CREATE INDEX blocks writes to the table until the index is built. //diff:ignore-line-postgres-cockroach
+	case *AlterTableStmt:
+		return classifyAlterTableAction(s.Action)
+	default:
+		return ddl.ChangeClassSafe
+	}
+}
+
+func classifyAlterTableAction(action AlterTableAction) ddl.ChangeClass {
+	switch a := action.(type) {
+	case *DropColumn:
+		return ddl.ChangeClassDataLoss
+	case *AlterColumnSetDataType:
+		if a.Using != nil {
+			return ddl.ChangeClassDataLoss // MEMO: The values are cast to the recreated enum type, which fails for the removed labels.
+		}
+		if a.OldDataType != nil && internal.IsNarrowingDataType(a.OldDataType.StringForDiff(), a.DataType.StringForDiff(), dataTypeFamilies...) {
+			return ddl.ChangeClassDataLoss
+		}
+		return ddl.ChangeClassLocking // MEMO: The table is rewritten.
+	case *AlterColumnSetNotNull:
+		return ddl.ChangeClassLocking // MEMO: The table is scanned.
+	case *AddConstraint:
+		if a.NotValid {
+			return ddl.ChangeClassSafe
+		}
+		return ddl.ChangeClassLocking // MEMO: The table is scanned to validate the constraint.
+	default:
+		return ddl.ChangeClassSafe
+	}
+}
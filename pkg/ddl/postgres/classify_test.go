@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestClassifyStmt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE "groups" (id TEXT NOT NULL);
+CREATE TABLE "users" (id TEXT NOT NULL, "name" VARCHAR(20) NOT NULL, age INTEGER, memo TEXT, PRIMARY KEY (id));`)).Parse()
+		require.NoError(t, err)
+		after, err := NewParser(NewLexer(`CREATE TABLE "users" (id TEXT NOT NULL, "name" VARCHAR(10) NOT NULL, age BIGINT NOT NULL, note TEXT, PRIMARY KEY (id));
+CREATE INDEX users_idx_name ON "users" ("name");`)).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+
+		expected := []ddl.ChangeClass{
+			ddl.ChangeClassDataLoss, // DROP TABLE
+			ddl.ChangeClassLocking,  // CREATE INDEX
+			ddl.ChangeClassDataLoss, // SET DATA TYPE VARCHAR(10)
+			ddl.ChangeClassLocking,  // SET DATA TYPE BIGINT
+			ddl.ChangeClassLocking,  // SET NOT NULL
+			ddl.ChangeClassDataLoss, // DROP COLUMN
+			ddl.ChangeClassSafe,     // ADD COLUMN
+		}
+		classes := make([]ddl.ChangeClass, 0, len(actual.Stmts))
+		for _, stmt := range actual.Stmts {
+			classes = append(classes, ClassifyStmt(stmt))
+		}
+		if !assert.Equal(t, expected, classes) {
+			t.Logf("❌: %s", actual)
+		}
+	})
+	t.Run("success,enum label removed", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TYPE user_status AS ENUM ('active', 'inactive', 'banned');
+CREATE TABLE "users" (id TEXT NOT NULL, status user_status NOT NULL DEFAULT 'active', PRIMARY KEY (id));`)).Parse()
+		require.NoError(t, err)
+		after, err := NewParser(NewLexer(`CREATE TYPE user_status AS ENUM ('active', 'inactive');
+CREATE TABLE "users" (id TEXT NOT NULL, status user_status NOT NULL DEFAULT 'active', PRIMARY KEY (id));`)).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+
+		expected := []ddl.ChangeClass{
+			ddl.ChangeClassDataLoss, // ALTER TYPE RENAME TO
+			ddl.ChangeClassSafe,     // CREATE TYPE
+			ddl.ChangeClassSafe,     // ALTER COLUMN DROP DEFAULT
+			ddl.ChangeClassDataLoss, // ALTER COLUMN TYPE USING
+			ddl.ChangeClassSafe,     // ALTER COLUMN SET DEFAULT
+			ddl.ChangeClassDataLoss, // DROP TYPE
+		}
+		classes := make([]ddl.ChangeClass, 0, len(actual.Stmts))
+		for _, stmt := range actual.Stmts {
+			classes = append(classes, ClassifyStmt(stmt))
+		}
+		if !assert.Equal(t, expected, classes) {
+			t.Logf("❌: %s", actual)
+		}
+	})
+}
@@ -1,6 +1,8 @@
 package postgres
 
 import (
+	"strings"
+
 	stringz "github.com/kunitsucom/util.go/strings"
 
 	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
@@ -96,9 +98,10 @@ func (i *ColumnIdent) StringForDiff() string {
 }
 
 type DataType struct {
-	Name string
-	Type TokenType
-	Expr *Expr
+	Name  string
+	Type  TokenType
+	Expr  *Expr
+	Array bool // MEMO: such as integer[]. PostgreSQL does not enforce the number of dimensions. //diff:ignore-line-postgres-cockroach
 }
 
 func (s *DataType) String() string {
@@ -107,8 +110,17 @@ func (s *DataType) String() string {
 	}
 	str := s.Name
 	if s.Expr != nil && len(s.Expr.Idents) > 0 {
-		str += "(" + s.Expr.String() + ")"
+		expr := "(" + s.Expr.String() + ")"
+		// MEMO: The precision is placed before WITH TIME ZONE such as timestamp(3) with time zone. //diff:ignore-line-postgres-cockroach
+		if i := strings.Index(str, " "); i > 0 && (s.Type == TOKEN_TIMESTAMP || s.Type == TOKEN_TIMESTAMP_WITH_TIME_ZONE) { //diff:ignore-line-postgres-cockroach
+			str = str[:i] + expr + str[i:] //diff:ignore-line-postgres-cockroach
+		} else { //diff:ignore-line-postgres-cockroach
+			str += expr
+		} //diff:ignore-line-postgres-cockroach
 	}
+	if s.Array { //diff:ignore-line-postgres-cockroach
+		str += "[]" //diff:ignore-line-postgres-cockroach
+	} //diff:ignore-line-postgres-cockroach
 	return str
 }
 
@@ -117,10 +129,17 @@ func (s *DataType) StringForDiff() string {
 		return ""
 	}
 	var str string
-	if s.Type != "" {
-		str += string(s.Type)
-	} else {
+	switch s.Type { //nolint:exhaustive
+	case TOKEN_IDENT: //diff:ignore-line-postgres-cockroach
+		str += s.Name // MEMO: user-defined type such as ENUM //diff:ignore-line-postgres-cockroach
+	case TOKEN_VARYING: //diff:ignore-line-postgres-cockroach
+		str += string(TOKEN_CHARACTER_VARYING) // MEMO: VARCHAR is an alias of CHARACTER VARYING //diff:ignore-line-postgres-cockroach
+	case TOKEN_DECIMAL: //diff:ignore-line-postgres-cockroach
+		str += string(TOKEN_NUMERIC) // MEMO: DECIMAL is an alias of NUMERIC //diff:ignore-line-postgres-cockroach
+	case "":
 		str += string(TOKEN_ILLEGAL)
+	default:
+		str += string(s.Type)
 	}
 
 	if s.Expr != nil && len(s.Expr.Idents) > 0 {
@@ -130,6 +149,9 @@ func (s *DataType) StringForDiff() string {
 		}
 		str += ")"
 	}
+	if s.Array { //diff:ignore-line-postgres-cockroach
+		str += "[]" //diff:ignore-line-postgres-cockroach
+	} //diff:ignore-line-postgres-cockroach
 
 	return str
 }
@@ -0,0 +1,71 @@
+package postgres
+
+// FilterTables returns the DDL that has only the statements of the tables for which keep reports true.
+// The statements that do not belong to a table, such as CREATE SEQUENCE, are kept.
+func (d *DDL) FilterTables(keep func(schema, table string) bool) *DDL {
+	filtered := &DDL{}
+	for _, stmt := range d.Stmts {
+		var table *ObjectName
+		switch s := stmt.(type) {
+		case *CreateTableStmt:
+			table = s.Name
+		case *AlterTableStmt:
+			table = s.Name
+		case *CreateIndexStmt:
+			table = s.TableName
+		}
+		if table != nil && !keep(table.schemaAndName()) {
+			continue
+		}
+		filtered.Stmts = append(filtered.Stmts, stmt)
+	}
+	return filtered
+}
+
+func (t *ObjectName) schemaAndName() (schema, name string) {
+	if t.Schema != nil {
+		schema = t.Schema.Name
+	}
+	if t.Name != nil {
+		name = t.Name.Name
+	}
+	return schema, name
+}
+
+// FilterSchemas returns the DDL that has only the statements of the schemas for which keep reports true.
+// The schema of an object that is not qualified by a schema is passed as "".
+func (d *DDL) FilterSchemas(keep func(schema string) bool) *DDL {
+	filtered := &DDL{}
+	for _, stmt := range d.Stmts {
+		if schema, ok := schemaOf(stmt); ok && !keep(schema) {
+			continue
+		}
+		filtered.Stmts = append(filtered.Stmts, stmt)
+	}
+	return filtered
+}
+
+// schemaOf returns the schema that stmt belongs to.
+func schemaOf(stmt Stmt) (schema string, ok bool) {
+	var name *ObjectName
+	switch s := stmt.(type) {
+	case *CreateSchemaStmt:
+		return s.Name.Name, true
+	case *CreateTableStmt:
+		name = s.Name
+	case *AlterTableStmt:
+		name = s.Name
+	case *CreateIndexStmt:
+		name = s.TableName
+	case *CreateTypeStmt: //diff:ignore-line-postgres-cockroach
+		name = s.Name //diff:ignore-line-postgres-cockroach
+	case *CreateSequenceStmt:
+		name = s.Name
+	case *CreateViewStmt:
+		name = s.Name
+	default:
+		return "", false
+	}
+	schema, _ = name.schemaAndName()
+	return schema, true
+}
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.postgresql.jp/docs/11/sql-createschema.html //diff:ignore-line-postgres-cockroach
+
+var _ Stmt = (*CreateSchemaStmt)(nil)
+
+// CreateSchemaStmt represents CREATE SCHEMA schema_name.
+type CreateSchemaStmt struct {
+	Comment     string
+	IfNotExists bool
+	Name        *Ident
+}
+
+func (s *CreateSchemaStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *CreateSchemaStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "CREATE SCHEMA "
+	if s.IfNotExists {
+		str += "IF NOT EXISTS "
+	}
+	str += s.Name.String() + ";\n"
+	return str
+}
+
+func (*CreateSchemaStmt) isStmt()            {}
+func (s *CreateSchemaStmt) GoString() string { return internal.GoString(*s) }
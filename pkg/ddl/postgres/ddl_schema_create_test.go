@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestCreateSchemaStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateSchemaStmt{Name: &Ident{Name: "tenant", QuotationMark: `"`, Raw: `"tenant"`}}
+		expected := "tenant"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestCreateSchemaStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateSchemaStmt{
+			Comment:     "test comment content",
+			IfNotExists: true,
+			Name:        &Ident{Name: "tenant", QuotationMark: `"`, Raw: `"tenant"`},
+		}
+		expected := `-- test comment content
+CREATE SCHEMA IF NOT EXISTS "tenant";
+`
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.postgresql.jp/docs/11/sql-dropschema.html //diff:ignore-line-postgres-cockroach
+
+var _ Stmt = (*DropSchemaStmt)(nil)
+
+type DropSchemaStmt struct {
+	Comment  string
+	IfExists bool
+	Name     *Ident
+}
+
+func (s *DropSchemaStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *DropSchemaStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "DROP SCHEMA "
+	if s.IfExists {
+		str += "IF EXISTS "
+	}
+	str += s.Name.String() + ";\n"
+	return str
+}
+
+func (*DropSchemaStmt) isStmt()            {}
+func (s *DropSchemaStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,43 @@
+package postgres
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.postgresql.jp/docs/11/sql-altersequence.html //diff:ignore-line-postgres-cockroach
+
+var _ Stmt = (*AlterSequenceStmt)(nil)
+
+// AlterSequenceStmt represents ALTER SEQUENCE sequence_name ....
+type AlterSequenceStmt struct {
+	Comment string
+	Name    *ObjectName
+	Options []*SequenceOption
+}
+
+func (s *AlterSequenceStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *AlterSequenceStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "ALTER SEQUENCE " + s.Name.String()
+	for _, o := range s.Options {
+		str += " " + o.String()
+	}
+	str += ";\n"
+	return str
+}
+
+func (*AlterSequenceStmt) isStmt()            {}
+func (s *AlterSequenceStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,165 @@
+package postgres
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.postgresql.jp/docs/11/sql-createsequence.html //diff:ignore-line-postgres-cockroach
+
+var _ Stmt = (*CreateSequenceStmt)(nil)
+
+// CreateSequenceStmt represents CREATE SEQUENCE sequence_name ....
+type CreateSequenceStmt struct {
+	Comment     string
+	IfNotExists bool
+	Name        *ObjectName
+	Options     []*SequenceOption
+}
+
+func (s *CreateSequenceStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *CreateSequenceStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "CREATE SEQUENCE "
+	if s.IfNotExists {
+		str += "IF NOT EXISTS "
+	}
+	str += s.Name.String()
+	for _, o := range s.Options {
+		str += " " + o.String()
+	}
+	str += ";\n"
+	return str
+}
+
+// StringForDiff returns the statement with all options, including omitted ones filled with their default values.
+func (s *CreateSequenceStmt) StringForDiff() string {
+	str := "CREATE SEQUENCE " + s.Name.StringForDiff()
+	for _, o := range sequenceOptionsForDiff(s.Options) {
+		str += " " + o.StringForDiff()
+	}
+	return str
+}
+
+func (*CreateSequenceStmt) isStmt()            {}
+func (s *CreateSequenceStmt) GoString() string { return internal.GoString(*s) }
+
+const (
+	SequenceOptionAs         = "AS"
+	SequenceOptionIncrement  = "INCREMENT"
+	SequenceOptionMinValue   = "MINVALUE"
+	SequenceOptionNoMinValue = "NO MINVALUE"
+	SequenceOptionMaxValue   = "MAXVALUE"
+	SequenceOptionNoMaxValue = "NO MAXVALUE"
+	SequenceOptionStart      = "START"
+	SequenceOptionCache      = "CACHE"
+	SequenceOptionCycle      = "CYCLE"
+	SequenceOptionNoCycle    = "NO CYCLE"
+)
+
+// SequenceOption represents an option of CREATE SEQUENCE or ALTER SEQUENCE such as INCREMENT 1 or NO CYCLE.
+type SequenceOption struct {
+	Name  string
+	Value *Ident
+}
+
+func (o *SequenceOption) String() string {
+	if o.Value == nil {
+		return o.Name
+	}
+	return o.Name + " " + o.Value.String()
+}
+
+func (o *SequenceOption) StringForDiff() string {
+	if o.Value == nil {
+		return o.Name
+	}
+	return o.Name + " " + o.Value.StringForDiff()
+}
+
+func (o *SequenceOption) GoString() string { return internal.GoString(*o) }
+
+//nolint:gochecknoglobals
+var (
+	sequenceDataTypeAliases = map[string]string{
+		"INT2": "SMALLINT",
+		"INT4": "INTEGER",
+		"INT":  "INTEGER", //diff:ignore-line-postgres-cockroach
+		"INT8": "BIGINT",
+	}
+	sequenceDataTypeBounds = map[string][2]string{
+		"SMALLINT": {"-32768", "32767"},
+		"INTEGER":  {"-2147483648", "2147483647"},
+		"BIGINT":   {"-9223372036854775808", "9223372036854775807"},
+	}
+)
+
+// sequenceOptionsForDiff returns the options in a fixed order with omitted ones filled with their default values,
+// so that sequences which differ only in the way they are written are regarded as the same.
+//
+//nolint:cyclop
+func sequenceOptionsForDiff(options []*SequenceOption) []*SequenceOption {
+	values := make(map[string]string)
+	for _, o := range options {
+		switch o.Name {
+		case SequenceOptionNoMinValue:
+			delete(values, SequenceOptionMinValue)
+		case SequenceOptionNoMaxValue:
+			delete(values, SequenceOptionMaxValue)
+		case SequenceOptionCycle, SequenceOptionNoCycle:
+			values[SequenceOptionCycle] = o.Name
+		default:
+			values[o.Name] = o.Value.StringForDiff()
+		}
+	}
+
+	dataType := strings.ToUpper(values[SequenceOptionAs])
+	if alias, ok := sequenceDataTypeAliases[dataType]; ok {
+		dataType = alias
+	}
+	bounds, ok := sequenceDataTypeBounds[dataType]
+	if !ok {
+		dataType, bounds = "BIGINT", sequenceDataTypeBounds["BIGINT"]
+	}
+
+	valueOrDefault := func(name, defaultValue string) string {
+		if v, ok := values[name]; ok && v != "" {
+			return v
+		}
+		return defaultValue
+	}
+	increment := valueOrDefault(SequenceOptionIncrement, "1")
+	descending := strings.HasPrefix(increment, "-")
+	minValue := valueOrDefault(SequenceOptionMinValue, "1")
+	maxValue := valueOrDefault(SequenceOptionMaxValue, bounds[1])
+	if descending {
+		minValue = valueOrDefault(SequenceOptionMinValue, bounds[0])
+		maxValue = valueOrDefault(SequenceOptionMaxValue, "-1")
+	}
+	start := valueOrDefault(SequenceOptionStart, minValue)
+	if descending {
+		start = valueOrDefault(SequenceOptionStart, maxValue)
+	}
+
+	return []*SequenceOption{
+		{Name: SequenceOptionAs, Value: NewRawIdent(dataType)},
+		{Name: SequenceOptionIncrement, Value: NewRawIdent(increment)},
+		{Name: SequenceOptionMinValue, Value: NewRawIdent(minValue)},
+		{Name: SequenceOptionMaxValue, Value: NewRawIdent(maxValue)},
+		{Name: SequenceOptionStart, Value: NewRawIdent(start)},
+		{Name: SequenceOptionCache, Value: NewRawIdent(valueOrDefault(SequenceOptionCache, "1"))},
+		{Name: valueOrDefault(SequenceOptionCycle, SequenceOptionNoCycle)},
+	}
+}
@@ -119,12 +119,80 @@ func (c *ForeignKeyConstraint) StringForDiff() string {
 		str += v.StringForDiff()
 	}
 	str += ")"
-	if c.OnAction != "" {
-		str += " " + c.OnAction
+	if a := foreignKeyActionForDiff(c.OnAction); a != "" { //diff:ignore-line-postgres-cockroach
+		str += " " + a
 	}
 	return str
 }
 
+// foreignKeyActionForDiff returns the actions such as ON UPDATE, ON DELETE, MATCH and DEFERRABLE in the order of pg_get_constraintdef.
+// The default actions such as NO ACTION and NOT DEFERRABLE are omitted, same as pg_get_constraintdef.
+//
+//nolint:cyclop
+func foreignKeyActionForDiff(onAction string) string {
+	var match, onUpdate, onDelete, deferrable, initially string
+
+	words := strings.Fields(strings.ToUpper(onAction))
+	for i := 0; i < len(words); i++ {
+		next := func() string {
+			if i+1 < len(words) {
+				i++
+				return words[i]
+			}
+			return ""
+		}
+		switch words[i] {
+		case "MATCH":
+			if m := next(); m != "SIMPLE" {
+				match = "MATCH " + m
+			}
+		case "ON":
+			event := next()
+			action := next()
+			if action == "NO" || action == "SET" {
+				action += " " + next()
+			}
+			if action == "NO ACTION" {
+				action = ""
+			}
+			if event == "UPDATE" {
+				onUpdate = action
+			} else {
+				onDelete = action
+			}
+		case "DEFERRABLE":
+			deferrable = "DEFERRABLE"
+		case "NOT":
+			next() // DEFERRABLE
+			deferrable = ""
+		case "INITIALLY":
+			if next() == "DEFERRED" {
+				initially = "INITIALLY DEFERRED"
+			} else {
+				initially = ""
+			}
+		}
+	}
+
+	actions := make([]string, 0)
+	if match != "" {
+		actions = append(actions, match)
+	}
+	if onUpdate != "" {
+		actions = append(actions, "ON UPDATE "+onUpdate)
+	}
+	if onDelete != "" {
+		actions = append(actions, "ON DELETE "+onDelete)
+	}
+	if deferrable != "" {
+		actions = append(actions, deferrable)
+	}
+	if initially != "" {
+		actions = append(actions, initially)
+	}
+	return strings.Join(actions, " ")
+}
+
 // UniqueConstraint represents a UNIQUE constraint. //diff:ignore-line-postgres-cockroach.
 type UniqueConstraint struct { //diff:ignore-line-postgres-cockroach
 	Name    *Ident
@@ -190,7 +258,7 @@ func (c *CheckConstraint) StringForDiff() string {
 		str += "CONSTRAINT " + c.Name.StringForDiff() + " "
 	}
 	str += "CHECK "
-	for i, v := range c.Expr.Idents {
+	for i, v := range identsForDiff(c.Expr.Idents) {
 		if i != 0 {
 			str += " "
 		}
@@ -243,10 +311,13 @@ func (t *ObjectName) StringForDiff() string {
 }
 
 type Column struct {
-	Name     *Ident
-	DataType *DataType
-	Default  *Default
-	NotNull  bool
+	Name      *Ident
+	DataType  *DataType
+	Collate   *Ident //diff:ignore-line-postgres-cockroach
+	Default   *Default
+	NotNull   bool
+	Generated *Expr  // MEMO: GENERATED ALWAYS AS (expr) STORED //diff:ignore-line-postgres-cockroach
+	Identity  string // MEMO: ALWAYS or BY DEFAULT of GENERATED ... AS IDENTITY //diff:ignore-line-postgres-cockroach
 }
 
 type Default struct {
@@ -281,6 +352,7 @@ func (d *Expr) String() string {
 			d.Idents[i-1].String() == "(" || d.Idents[i].String() == "(" ||
 			d.Idents[i].String() == ")" ||
 			d.Idents[i-1].String() == "::" || d.Idents[i].String() == "::" ||
+			d.Idents[i-1].String() == "[" || d.Idents[i].String() == "[" || d.Idents[i].String() == "]" || //diff:ignore-line-postgres-cockroach
 			d.Idents[i].String() == ",":
 			// noop
 		default:
@@ -310,7 +382,7 @@ func (d *Default) StringForDiff() string {
 	}
 	if e := d.Value; e != nil {
 		str := "DEFAULT "
-		for i, v := range d.Value.Idents {
+		for i, v := range identsForDiff(d.Value.Idents) {
 			if i != 0 {
 				str += " "
 			}
@@ -324,12 +396,36 @@ func (d *Default) StringForDiff() string {
 func (c *Column) String() string {
 	str := c.Name.String() + " " +
 		c.DataType.String()
+	if c.Collate != nil { //diff:ignore-line-postgres-cockroach
+		str += " COLLATE " + c.Collate.String() //diff:ignore-line-postgres-cockroach
+	} //diff:ignore-line-postgres-cockroach
 	if s := c.Default.String(); s != "" { //diff:ignore-line-postgres-cockroach
 		str += " " + s //diff:ignore-line-postgres-cockroach
 	} //diff:ignore-line-postgres-cockroach
 	if c.NotNull { //diff:ignore-line-postgres-cockroach
 		str += " NOT NULL" //diff:ignore-line-postgres-cockroach
 	}
+	if c.Generated != nil { //diff:ignore-line-postgres-cockroach
+		str += " GENERATED ALWAYS AS " + c.Generated.String() + " STORED" //diff:ignore-line-postgres-cockroach
+	} //diff:ignore-line-postgres-cockroach
+	if c.Identity != "" { //diff:ignore-line-postgres-cockroach
+		str += " GENERATED " + c.Identity + " AS IDENTITY" //diff:ignore-line-postgres-cockroach
+	} //diff:ignore-line-postgres-cockroach
+	return str
+}
+
+// generatedForDiff returns the generation expression of GENERATED ALWAYS AS (expr) STORED for diff.
+func (c *Column) generatedForDiff() string {
+	if c.Generated == nil {
+		return ""
+	}
+	var str string
+	for i, v := range identsForDiff(c.Generated.Idents) {
+		if i != 0 {
+			str += " "
+		}
+		str += v.StringForDiff()
+	}
 	return str
 }
 
@@ -39,7 +39,7 @@ func (s *AlterTableStmt) String() string {
 	switch a := s.Action.(type) {
 	case *RenameTable:
 		str += "RENAME TO "
-		str += a.NewName.String()
+		str += a.NewName.Name.String() // MEMO: PostgreSQL does not accept a schema-qualified name for RENAME TO. //diff:ignore-line-postgres-cockroach
 	case *RenameColumn:
 		str += "RENAME COLUMN " + a.Name.String() + " TO " + a.NewName.String()
 	case *RenameConstraint:
@@ -50,6 +50,12 @@ func (s *AlterTableStmt) String() string {
 		str += "DROP COLUMN " + a.Name.String()
 	case *AlterColumnSetDataType:
 		str += "ALTER COLUMN " + a.Name.String() + " SET DATA TYPE " + a.DataType.String()
+		if a.Collate != nil { //diff:ignore-line-postgres-cockroach
+			str += " COLLATE " + a.Collate.String() //diff:ignore-line-postgres-cockroach
+		} //diff:ignore-line-postgres-cockroach
+		if a.Using != nil { //diff:ignore-line-postgres-cockroach
+			str += " USING " + a.Using.String() //diff:ignore-line-postgres-cockroach
+		} //diff:ignore-line-postgres-cockroach
 	case *AlterColumnSetDefault:
 		str += "ALTER COLUMN " + a.Name.String() + " SET " + a.Default.String()
 	case *AlterColumnDropDefault:
@@ -58,6 +64,12 @@ func (s *AlterTableStmt) String() string {
 		str += "ALTER COLUMN " + a.Name.String() + " SET NOT NULL"
 	case *AlterColumnDropNotNull:
 		str += "ALTER COLUMN " + a.Name.String() + " DROP NOT NULL"
+	case *AlterColumnAddIdentity: //diff:ignore-line-postgres-cockroach
+		str += "ALTER COLUMN " + a.Name.String() + " ADD GENERATED " + a.Identity + " AS IDENTITY" //diff:ignore-line-postgres-cockroach
+	case *AlterColumnSetIdentity: //diff:ignore-line-postgres-cockroach
+		str += "ALTER COLUMN " + a.Name.String() + " SET GENERATED " + a.Identity //diff:ignore-line-postgres-cockroach
+	case *AlterColumnDropIdentity: //diff:ignore-line-postgres-cockroach
+		str += "ALTER COLUMN " + a.Name.String() + " DROP IDENTITY" //diff:ignore-line-postgres-cockroach
 	case *AddConstraint:
 		str += "ADD " + a.Constraint.String()
 		if a.NotValid {
@@ -138,8 +150,11 @@ func (s *DropColumn) GoString() string { return internal.GoString(*s) }
 
 // AlterColumnSetDataType represents ALTER TABLE table_name ALTER COLUMN column_name SET DATA TYPE.
 type AlterColumnSetDataType struct {
-	Name     *Ident
-	DataType *DataType
+	Name        *Ident
+	DataType    *DataType
+	OldDataType *DataType // MEMO: not printed, but used to detect a narrowing change.
+	Collate     *Ident    //diff:ignore-line-postgres-cockroach
+	Using       *Expr     //diff:ignore-line-postgres-cockroach
 }
 
 func (*AlterColumnSetDataType) isAlterTableAction() {}
@@ -183,6 +198,35 @@ func (*AlterColumnDropNotNull) isAlterTableAction() {}
 
 func (s *AlterColumnDropNotNull) GoString() string { return internal.GoString(*s) }
 
+// AlterColumnAddIdentity represents ALTER TABLE table_name ALTER COLUMN column_name ADD GENERATED { ALWAYS | BY DEFAULT } AS IDENTITY.
+type AlterColumnAddIdentity struct {
+	Name     *Ident
+	Identity string
+}
+
+func (*AlterColumnAddIdentity) isAlterTableAction() {}
+
+func (s *AlterColumnAddIdentity) GoString() string { return internal.GoString(*s) }
+
+// AlterColumnSetIdentity represents ALTER TABLE table_name ALTER COLUMN column_name SET GENERATED { ALWAYS | BY DEFAULT }.
+type AlterColumnSetIdentity struct {
+	Name     *Ident
+	Identity string
+}
+
+func (*AlterColumnSetIdentity) isAlterTableAction() {}
+
+func (s *AlterColumnSetIdentity) GoString() string { return internal.GoString(*s) }
+
+// AlterColumnDropIdentity represents ALTER TABLE table_name ALTER COLUMN column_name DROP IDENTITY.
+type AlterColumnDropIdentity struct {
+	Name *Ident
+}
+
+func (*AlterColumnDropIdentity) isAlterTableAction() {}
+
+func (s *AlterColumnDropIdentity) GoString() string { return internal.GoString(*s) }
+
 // AddConstraint represents ALTER TABLE table_name ADD CONSTRAINT.
 type AddConstraint struct {
 	Constraint Constraint
@@ -57,12 +57,29 @@ func TestForeignKeyConstraint(t *testing.T) {
 		actual := foreignKeyConstraint.String()
 		require.Equal(t, expected, actual)
 
-		expectedForDiff := `CONSTRAINT fk_users_groups FOREIGN KEY (group_id) REFERENCES groups (id) ON DELETE NO ACTION`
+		// MEMO: NO ACTION is the default action, so it is omitted same as pg_get_constraintdef.
+		expectedForDiff := `CONSTRAINT fk_users_groups FOREIGN KEY (group_id) REFERENCES groups (id)`
 		actualForDiff := foreignKeyConstraint.StringForDiff()
 		require.Equal(t, expectedForDiff, actualForDiff)
 
 		t.Logf("✅: %s: foreignKeyConstraint: %#v", t.Name(), foreignKeyConstraint)
 	})
+
+	t.Run("success,ForeignKeyConstraint,actions", func(t *testing.T) {
+		t.Parallel()
+
+		foreignKeyConstraint := &ForeignKeyConstraint{
+			Name:       &Ident{Name: "fk_users_groups", QuotationMark: `"`, Raw: `"fk_users_groups"`},
+			Columns:    []*ColumnIdent{{Ident: &Ident{Name: "group_id", QuotationMark: `"`, Raw: `"group_id"`}}},
+			Ref:        &Ident{Name: "groups", QuotationMark: `"`, Raw: `"groups"`},
+			RefColumns: []*ColumnIdent{{Ident: &Ident{Name: "id", QuotationMark: `"`, Raw: `"id"`}}},
+			OnAction:   "ON DELETE SET NULL ON UPDATE CASCADE MATCH SIMPLE DEFERRABLE INITIALLY DEFERRED",
+		}
+
+		expectedForDiff := `CONSTRAINT fk_users_groups FOREIGN KEY (group_id) REFERENCES groups (id) ON UPDATE CASCADE ON DELETE SET NULL DEFERRABLE INITIALLY DEFERRED`
+		actualForDiff := foreignKeyConstraint.StringForDiff()
+		require.Equal(t, expectedForDiff, actualForDiff)
+	})
 }
 
 func TestUniqueConstraint(t *testing.T) {
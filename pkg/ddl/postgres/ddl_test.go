@@ -14,6 +14,8 @@ func Test_isStmt(t *testing.T) {
 	(&AlterTableStmt{}).isStmt()
 	(&CreateIndexStmt{}).isStmt()
 	(&DropIndexStmt{}).isStmt()
+	(&CreateSchemaStmt{}).isStmt()
+	(&DropSchemaStmt{}).isStmt()
 }
 
 func TestIdent_String(t *testing.T) {
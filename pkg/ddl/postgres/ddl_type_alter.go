@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.postgresql.jp/docs/11/sql-altertype.html
+
+var _ Stmt = (*AlterTypeStmt)(nil)
+
+type AlterTypeStmt struct {
+	Comment string
+	Name    *ObjectName
+	Action  AlterTypeAction
+}
+
+func (*AlterTypeStmt) isStmt() {}
+
+func (s *AlterTypeStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *AlterTypeStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "ALTER TYPE "
+	str += s.Name.String() + " "
+	switch a := s.Action.(type) {
+	case *RenameType:
+		str += "RENAME TO " + a.NewName.String()
+	case *AddValue:
+		str += "ADD VALUE "
+		if a.IfNotExists {
+			str += "IF NOT EXISTS "
+		}
+		str += a.Value.String()
+		switch {
+		case a.Before != nil:
+			str += " BEFORE " + a.Before.String()
+		case a.After != nil:
+			str += " AFTER " + a.After.String()
+		}
+	}
+
+	return str + ";\n"
+}
+
+func (s *AlterTypeStmt) GoString() string { return internal.GoString(*s) }
+
+type AlterTypeAction interface {
+	isAlterTypeAction()
+	GoString() string
+}
+
+// RenameType represents ALTER TYPE type_name RENAME TO new_type_name.
+type RenameType struct {
+	NewName *Ident
+}
+
+func (*RenameType) isAlterTypeAction() {}
+
+func (s *RenameType) GoString() string { return internal.GoString(*s) }
+
+// AddValue represents ALTER TYPE type_name ADD VALUE.
+type AddValue struct {
+	IfNotExists bool
+	Value       *Ident
+	Before      *Ident
+	After       *Ident
+}
+
+func (*AddValue) isAlterTypeAction() {}
+
+func (s *AddValue) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestAlterTypeStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterTypeStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestAlterTypeStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,RenameType", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterTypeStmt{
+			Comment: "test comment content",
+			Name:    &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Action:  &RenameType{NewName: &Ident{Name: "test_old", QuotationMark: `"`, Raw: `"test_old"`}},
+		}
+		expected := `-- test comment content
+ALTER TYPE "test" RENAME TO "test_old";
+`
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+
+	t.Run("success,AddValue", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterTypeStmt{
+			Name:   &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Action: &AddValue{IfNotExists: true, Value: NewRawIdent("'b'"), After: NewRawIdent("'a'")},
+		}
+		expected := `ALTER TYPE "test" ADD VALUE IF NOT EXISTS 'b' AFTER 'a';
+`
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+
+	t.Run("success,AddValue,Before", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterTypeStmt{
+			Name:   &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Action: &AddValue{Value: NewRawIdent("'a'"), Before: NewRawIdent("'b'")},
+		}
+		expected := `ALTER TYPE "test" ADD VALUE 'a' BEFORE 'b';
+`
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
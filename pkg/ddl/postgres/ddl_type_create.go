@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"strings"
+
+	stringz "github.com/kunitsucom/util.go/strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.postgresql.jp/docs/11/sql-createtype.html
+
+var _ Stmt = (*CreateTypeStmt)(nil)
+
+// CreateTypeStmt represents CREATE TYPE type_name AS ENUM (...).
+type CreateTypeStmt struct {
+	Comment string
+	Name    *ObjectName
+	Labels  []*Ident
+}
+
+func (s *CreateTypeStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *CreateTypeStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "CREATE TYPE " + s.Name.String() + " AS ENUM ("
+	str += stringz.JoinStringers(", ", s.Labels...)
+	str += ");\n"
+	return str
+}
+
+func (s *CreateTypeStmt) StringForDiff() string {
+	str := "CREATE TYPE " + s.Name.StringForDiff() + " AS ENUM ("
+	for i, v := range s.Labels {
+		if i != 0 {
+			str += ", "
+		}
+		str += v.StringForDiff()
+	}
+	str += ")"
+	return str
+}
+
+func (*CreateTypeStmt) isStmt()            {}
+func (s *CreateTypeStmt) GoString() string { return internal.GoString(*s) }
@@ -12,6 +12,8 @@ import (
 
 type DiffCreateTableConfig struct {
 	UseAlterTableAddConstraintNotValid bool
+	Renames                            ddl.Renames
+	RenameHeuristic                    bool
 }
 
 type DiffCreateTableOption interface {
@@ -32,6 +34,34 @@ func (o *diffCreateTableConfigUseConstraintNotValid) apply(c *DiffCreateTableCon
 	c.UseAlterTableAddConstraintNotValid = o.useAlterTableAddConstraintNotValid
 }
 
+func DiffCreateTableRenames(renames ddl.Renames) DiffCreateTableOption { //nolint:ireturn
+	return &diffCreateTableConfigRenames{
+		renames: renames,
+	}
+}
+
+type diffCreateTableConfigRenames struct {
+	renames ddl.Renames
+}
+
+func (o *diffCreateTableConfigRenames) apply(c *DiffCreateTableConfig) {
+	c.Renames = append(c.Renames, o.renames...)
+}
+
+func DiffCreateTableRenameHeuristic(enabled bool) DiffCreateTableOption { //nolint:ireturn
+	return &diffCreateTableConfigRenameHeuristic{
+		renameHeuristic: enabled,
+	}
+}
+
+type diffCreateTableConfigRenameHeuristic struct {
+	renameHeuristic bool
+}
+
+func (o *diffCreateTableConfigRenameHeuristic) apply(c *DiffCreateTableConfig) {
+	c.RenameHeuristic = o.renameHeuristic
+}
+
 //nolint:funlen,cyclop
 func DiffCreateTable(before, after *CreateTableStmt, opts ...DiffCreateTableOption) (*DDL, error) {
 	config := &DiffCreateTableConfig{}
@@ -87,6 +117,10 @@ func DiffCreateTable(before, after *CreateTableStmt, opts ...DiffCreateTableOpti
 		}
 	}
 
+	// ALTER TABLE table_name RENAME COLUMN column_name TO new_column_name;
+	renameColumnStmts, before := config.renameColumns(before, after)
+	result.Stmts = append(result.Stmts, renameColumnStmts...)
+
 	config.diffCreateTableColumn(result, before, after)
 
 	for _, beforeConstraint := range before.Constraints {
@@ -153,14 +187,35 @@ func (config *DiffCreateTableConfig) diffCreateTableColumn(ddls *DDL, before, af
 			continue
 		}
 
-		if beforeColumn.DataType.StringForDiff() != afterColumn.DataType.StringForDiff() {
+		if beforeColumn.generatedForDiff() != afterColumn.generatedForDiff() { //diff:ignore-line-postgres-cockroach
+			// MEMO: The generation expression cannot be changed, so the column is recreated.
+			// ALTER TABLE table_name DROP COLUMN column_name;
+			// ALTER TABLE table_name ADD COLUMN column_name data_type GENERATED ALWAYS AS (expr) STORED;
+			ddls.Stmts = append(ddls.Stmts, //diff:ignore-line-postgres-cockroach
+				&AlterTableStmt{ //diff:ignore-line-postgres-cockroach
+					Comment: simplediff.Diff(beforeColumn.String(), afterColumn.String()).String(), //diff:ignore-line-postgres-cockroach
+					Name:    after.Name,                                                            //diff:ignore-line-postgres-cockroach
+					Action:  &DropColumn{Name: beforeColumn.Name},                                  //diff:ignore-line-postgres-cockroach
+				}, //diff:ignore-line-postgres-cockroach
+				&AlterTableStmt{ //diff:ignore-line-postgres-cockroach
+					Name:   after.Name,                      //diff:ignore-line-postgres-cockroach
+					Action: &AddColumn{Column: afterColumn}, //diff:ignore-line-postgres-cockroach
+				}, //diff:ignore-line-postgres-cockroach
+			) //diff:ignore-line-postgres-cockroach
+			continue //diff:ignore-line-postgres-cockroach
+		} //diff:ignore-line-postgres-cockroach
+
+		if beforeColumn.DataType.StringForDiff() != afterColumn.DataType.StringForDiff() ||
+			beforeColumn.Collate.StringForDiff() != afterColumn.Collate.StringForDiff() { //diff:ignore-line-postgres-cockroach
 			// ALTER TABLE table_name ALTER COLUMN column_name SET DATA TYPE data_type;
 			ddls.Stmts = append(ddls.Stmts, &AlterTableStmt{
 				Comment: simplediff.Diff(beforeColumn.String(), afterColumn.String()).String(),
 				Name:    after.Name,
 				Action: &AlterColumnSetDataType{
-					Name:     afterColumn.Name,
-					DataType: afterColumn.DataType,
+					Name:        afterColumn.Name,
+					DataType:    afterColumn.DataType,
+					OldDataType: beforeColumn.DataType,
+					Collate:     afterColumn.Collate, //diff:ignore-line-postgres-cockroach
 				},
 			})
 		}
@@ -207,6 +262,32 @@ func (config *DiffCreateTableConfig) diffCreateTableColumn(ddls *DDL, before, af
 				},
 			})
 		}
+
+		switch { //diff:ignore-line-postgres-cockroach
+		case beforeColumn.Identity == afterColumn.Identity: //diff:ignore-line-postgres-cockroach
+			// noop
+		case afterColumn.Identity == "": //diff:ignore-line-postgres-cockroach
+			// ALTER TABLE table_name ALTER COLUMN column_name DROP IDENTITY;
+			ddls.Stmts = append(ddls.Stmts, &AlterTableStmt{ //diff:ignore-line-postgres-cockroach
+				Comment: simplediff.Diff(beforeColumn.String(), afterColumn.String()).String(),
+				Name:    after.Name,
+				Action:  &AlterColumnDropIdentity{Name: afterColumn.Name}, //diff:ignore-line-postgres-cockroach
+			})
+		case beforeColumn.Identity == "": //diff:ignore-line-postgres-cockroach
+			// ALTER TABLE table_name ALTER COLUMN column_name ADD GENERATED { ALWAYS | BY DEFAULT } AS IDENTITY;
+			ddls.Stmts = append(ddls.Stmts, &AlterTableStmt{ //diff:ignore-line-postgres-cockroach
+				Comment: simplediff.Diff(beforeColumn.String(), afterColumn.String()).String(),
+				Name:    after.Name,
+				Action:  &AlterColumnAddIdentity{Name: afterColumn.Name, Identity: afterColumn.Identity}, //diff:ignore-line-postgres-cockroach
+			})
+		default: //diff:ignore-line-postgres-cockroach
+			// ALTER TABLE table_name ALTER COLUMN column_name SET GENERATED { ALWAYS | BY DEFAULT };
+			ddls.Stmts = append(ddls.Stmts, &AlterTableStmt{ //diff:ignore-line-postgres-cockroach
+				Comment: simplediff.Diff(beforeColumn.String(), afterColumn.String()).String(),
+				Name:    after.Name,
+				Action:  &AlterColumnSetIdentity{Name: afterColumn.Name, Identity: afterColumn.Identity}, //diff:ignore-line-postgres-cockroach
+			})
+		} //diff:ignore-line-postgres-cockroach
 	}
 
 	for _, afterColumn := range onlyLeftColumn(after.Columns, before.Columns) {
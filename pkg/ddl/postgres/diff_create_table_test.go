@@ -206,7 +206,7 @@ ALTER TABLE "users" ADD CONSTRAINT users_age_check CHECK ("age" <> 0);
 
 		expectedStr := `-- -public.users
 -- +public.app_users
-ALTER TABLE "public.users" RENAME TO "public.app_users";
+ALTER TABLE "public.users" RENAME TO "app_users";
 -- -CONSTRAINT users_group_id_fkey FOREIGN KEY (group_id) REFERENCES "groups" ("id")
 -- +
 ALTER TABLE "public.app_users" DROP CONSTRAINT users_group_id_fkey;
@@ -298,6 +298,142 @@ ALTER TABLE "users" ALTER COLUMN "age" DROP NOT NULL;
 		t.Logf("✅: %s:\n%s", t.Name(), actual)
 	})
 
+	t.Run("success,ALTER_COLUMN_SET_DATA_TYPE_COLLATE", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id UUID NOT NULL, "name" TEXT NOT NULL, PRIMARY KEY ("id"));`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "users" (id UUID NOT NULL, "name" TEXT COLLATE "C" NOT NULL, PRIMARY KEY ("id"));`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		expectedStr := `-- -"name" TEXT NOT NULL
+-- +"name" TEXT COLLATE "C" NOT NULL
+ALTER TABLE "users" ALTER COLUMN "name" SET DATA TYPE TEXT COLLATE "C";
+`
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+			DiffCreateTableUseAlterTableAddConstraintNotValid(false),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStr, actual.String())
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
+	t.Run("success,ADD_IDENTITY", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id BIGINT NOT NULL, PRIMARY KEY ("id"));`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "users" (id BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY, PRIMARY KEY ("id"));`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		expectedStr := `-- -id BIGINT NOT NULL
+-- +id BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY
+ALTER TABLE "users" ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY;
+`
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+			DiffCreateTableUseAlterTableAddConstraintNotValid(false),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStr, actual.String())
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
+	t.Run("success,SET_IDENTITY", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY, PRIMARY KEY ("id"));`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "users" (id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY, PRIMARY KEY ("id"));`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		expectedStr := `-- -id BIGINT NOT NULL GENERATED ALWAYS AS IDENTITY
+-- +id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY
+ALTER TABLE "users" ALTER COLUMN id SET GENERATED BY DEFAULT;
+`
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+			DiffCreateTableUseAlterTableAddConstraintNotValid(false),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStr, actual.String())
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
+	t.Run("success,DROP_IDENTITY", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY, PRIMARY KEY ("id"));`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "users" (id BIGINT NOT NULL, PRIMARY KEY ("id"));`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		expectedStr := `-- -id BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY
+-- +id BIGINT NOT NULL
+ALTER TABLE "users" ALTER COLUMN id DROP IDENTITY;
+`
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+			DiffCreateTableUseAlterTableAddConstraintNotValid(false),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStr, actual.String())
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
+	t.Run("success,DROP_ADD_GENERATED_COLUMN", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "items" (id BIGINT NOT NULL, price NUMERIC(10, 2) NOT NULL, total NUMERIC GENERATED ALWAYS AS (price * 2) STORED, PRIMARY KEY ("id"));`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "items" (id BIGINT NOT NULL, price NUMERIC(10, 2) NOT NULL, total NUMERIC GENERATED ALWAYS AS (price * 3) STORED, PRIMARY KEY ("id"));`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		expectedStr := `-- -total NUMERIC GENERATED ALWAYS AS (price * 2) STORED
+-- +total NUMERIC GENERATED ALWAYS AS (price * 3) STORED
+ALTER TABLE "items" DROP COLUMN total;
+ALTER TABLE "items" ADD COLUMN total NUMERIC GENERATED ALWAYS AS (price * 3) STORED;
+`
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+			DiffCreateTableUseAlterTableAddConstraintNotValid(false),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStr, actual.String())
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
 	t.Run("success,DROP_ADD_PRIMARY_KEY", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/kunitsucom/util.go/exp/diff/simplediff"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+const recreateTypeSuffix = "_old"
+
+type DiffCreateTypeConfig struct {
+	Tables []*CreateTableStmt
+}
+
+type DiffCreateTypeOption interface {
+	apply(c *DiffCreateTypeConfig)
+}
+
+// DiffCreateTypeUseTables specifies the tables whose columns are converted when the type is recreated.
+func DiffCreateTypeUseTables(tables ...*CreateTableStmt) DiffCreateTypeOption { //nolint:ireturn
+	return &diffCreateTypeConfigUseTables{
+		tables: tables,
+	}
+}
+
+type diffCreateTypeConfigUseTables struct {
+	tables []*CreateTableStmt
+}
+
+func (o *diffCreateTypeConfigUseTables) apply(c *DiffCreateTypeConfig) {
+	c.Tables = o.tables
+}
+
+// DiffCreateType returns the DDL to migrate the type from before to after.
+//
+// Added labels are applied by ALTER TYPE ... ADD VALUE [BEFORE|AFTER].
+// PostgreSQL cannot remove or reorder enum labels, so in that case the type is recreated
+// and the columns that use it are converted. This is destructive: the conversion fails
+// if any row still uses a removed label.
+//
+//nolint:funlen,cyclop
+func DiffCreateType(before, after *CreateTypeStmt, opts ...DiffCreateTypeOption) (*DDL, error) {
+	config := &DiffCreateTypeConfig{}
+
+	for _, opt := range opts {
+		opt.apply(config)
+	}
+
+	result := &DDL{}
+
+	switch {
+	case before == nil && after != nil:
+		// CREATE TYPE type_name AS ENUM (...);
+		result.Stmts = append(result.Stmts, after)
+		return result, nil
+	case before != nil && after == nil:
+		// DROP TYPE type_name;
+		result.Stmts = append(result.Stmts, &DropTypeStmt{
+			Name: before.Name,
+		})
+		return result, nil
+	case (before == nil && after == nil) || reflect.DeepEqual(before, after) || before.StringForDiff() == after.StringForDiff():
+		return nil, apperr.Errorf("before: %s, after: %s: %w", before.GetNameForDiff(), after.GetNameForDiff(), ddl.ErrNoDifference)
+	}
+
+	if !canAddValues(before.Labels, after.Labels) {
+		result.Stmts = append(result.Stmts, recreateType(before, after, config.Tables)...)
+		return result, nil
+	}
+
+	for i, label := range after.Labels {
+		if findLabel(label, before.Labels) != nil {
+			continue
+		}
+		// ALTER TYPE type_name ADD VALUE 'label' [BEFORE 'neighbor_label' | AFTER 'neighbor_label'];
+		action := &AddValue{Value: label}
+		switch {
+		case i > 0:
+			action.After = after.Labels[i-1]
+		case len(after.Labels) > 1:
+			action.Before = after.Labels[i+1]
+		}
+		result.Stmts = append(result.Stmts, &AlterTypeStmt{
+			Comment: simplediff.Diff(before.StringForDiff(), after.StringForDiff()).String(),
+			Name:    after.Name,
+			Action:  action,
+		})
+	}
+
+	return result, nil
+}
+
+// canAddValues reports whether after can be reached from before only by adding labels.
+func canAddValues(before, after []*Ident) bool {
+	i := 0
+	for _, label := range after {
+		if i < len(before) && label.StringForDiff() == before[i].StringForDiff() {
+			i++
+		}
+	}
+	return i == len(before)
+}
+
+//nolint:funlen
+func recreateType(before, after *CreateTypeStmt, tables []*CreateTableStmt) []Stmt {
+	oldName := &ObjectName{
+		Schema: before.Name.Schema,
+		Name: NewIdent(
+			before.Name.Name.Name+recreateTypeSuffix,
+			before.Name.Name.QuotationMark,
+			before.Name.Name.QuotationMark+before.Name.Name.Name+recreateTypeSuffix+before.Name.Name.QuotationMark,
+		),
+	}
+
+	createTypeStmt := *after
+	createTypeStmt.Comment = ""
+
+	stmts := []Stmt{
+		&AlterTypeStmt{
+			Comment: "DESTRUCTIVE: enum labels cannot be removed or reordered, so the type is recreated.\n" +
+				simplediff.Diff(before.StringForDiff(), after.StringForDiff()).String(),
+			Name: before.Name,
+			Action: &RenameType{
+				NewName: oldName.Name,
+			},
+		},
+		&createTypeStmt,
+	}
+
+	for _, table := range tables {
+		for _, column := range table.Columns {
+			if !isColumnOfType(column, before.Name) {
+				continue
+			}
+			// MEMO: The default value cannot be cast automatically, so it is dropped and set again.
+			if column.Default != nil {
+				stmts = append(stmts, &AlterTableStmt{
+					Name:   table.Name,
+					Action: &AlterColumnDropDefault{Name: column.Name},
+				})
+			}
+			stmts = append(stmts, &AlterTableStmt{
+				Name: table.Name,
+				Action: &AlterColumnSetDataType{
+					Name:     column.Name,
+					DataType: column.DataType,
+					Using:    &Expr{Idents: []*Ident{column.Name, NewRawIdent("::"), NewRawIdent("text"), NewRawIdent("::"), NewRawIdent(after.Name.String())}},
+				},
+			})
+			if column.Default != nil {
+				stmts = append(stmts, &AlterTableStmt{
+					Name:   table.Name,
+					Action: &AlterColumnSetDefault{Name: column.Name, Default: column.Default},
+				})
+			}
+		}
+	}
+
+	stmts = append(stmts, &DropTypeStmt{
+		Name: oldName,
+	})
+
+	return stmts
+}
+
+func isColumnOfType(column *Column, typeName *ObjectName) bool {
+	if column.DataType == nil || column.DataType.Type != TOKEN_IDENT {
+		return false
+	}
+	name := strings.Trim(column.DataType.Name, `"`)
+	return name == typeName.StringForDiff() || name == typeName.Name.StringForDiff()
+}
+
+func findLabel(label *Ident, labels []*Ident) *Ident {
+	for _, l := range labels {
+		if l.StringForDiff() == label.StringForDiff() {
+			return l
+		}
+	}
+	return nil
+}
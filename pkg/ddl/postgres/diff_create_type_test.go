@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestDiffCreateType(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,before,nil", func(t *testing.T) {
+		t.Parallel()
+
+		after := &CreateTypeStmt{Name: &ObjectName{Name: NewRawIdent("user_status")}, Labels: []*Ident{NewRawIdent("'active'")}}
+		actual, err := DiffCreateType(nil, after)
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE TYPE user_status AS ENUM ('active');\n", actual.String())
+	})
+
+	t.Run("success,after,nil", func(t *testing.T) {
+		t.Parallel()
+
+		before := &CreateTypeStmt{Name: &ObjectName{Name: NewRawIdent("user_status")}, Labels: []*Ident{NewRawIdent("'active'")}}
+		actual, err := DiffCreateType(before, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "DROP TYPE user_status;\n", actual.String())
+	})
+
+	t.Run("success,AddValue,single", func(t *testing.T) {
+		t.Parallel()
+
+		before := &CreateTypeStmt{Name: &ObjectName{Name: NewRawIdent("user_status")}}
+		after := &CreateTypeStmt{Name: &ObjectName{Name: NewRawIdent("user_status")}, Labels: []*Ident{NewRawIdent("'active'")}}
+		actual, err := DiffCreateType(before, after)
+		require.NoError(t, err)
+		assert.Equal(t, `-- -CREATE TYPE user_status AS ENUM ()
+-- +CREATE TYPE user_status AS ENUM ('active')
+ALTER TYPE user_status ADD VALUE 'active';
+`, actual.String())
+	})
+
+	t.Run("success,Reorder", func(t *testing.T) {
+		t.Parallel()
+
+		before := &CreateTypeStmt{Name: &ObjectName{Name: NewRawIdent("user_status")}, Labels: []*Ident{NewRawIdent("'a'"), NewRawIdent("'b'")}}
+		after := &CreateTypeStmt{Name: &ObjectName{Name: NewRawIdent("user_status")}, Labels: []*Ident{NewRawIdent("'b'"), NewRawIdent("'a'")}}
+		actual, err := DiffCreateType(before, after)
+		require.NoError(t, err)
+		assert.Equal(t, `-- DESTRUCTIVE: enum labels cannot be removed or reordered, so the type is recreated.
+-- -CREATE TYPE user_status AS ENUM ('a', 'b')
+-- +CREATE TYPE user_status AS ENUM ('b', 'a')
+ALTER TYPE user_status RENAME TO user_status_old;
+CREATE TYPE user_status AS ENUM ('b', 'a');
+DROP TYPE user_status_old;
+`, actual.String())
+	})
+
+	t.Run("failure,ddl.ErrNoDifference", func(t *testing.T) {
+		t.Parallel()
+
+		before := &CreateTypeStmt{Name: &ObjectName{Name: NewRawIdent("user_status")}, Labels: []*Ident{NewRawIdent("'active'")}}
+		after := &CreateTypeStmt{Name: &ObjectName{Name: NewRawIdent("user_status")}, Labels: []*Ident{NewRawIdent("'active'")}}
+		_, err := DiffCreateType(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+}
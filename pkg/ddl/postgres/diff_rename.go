@@ -0,0 +1,201 @@
+package postgres
+
+import (
+	"github.com/kunitsucom/util.go/exp/diff/simplediff"
+)
+
+// renameColumns returns ALTER TABLE ... RENAME COLUMN for the columns renamed from before to after,
+// and a copy of before whose columns are renamed, so that the rest of the difference is detected by the column names.
+//
+// The columns are renamed if the rename hints declare it, or if RenameHeuristic is enabled and
+// only one dropped column and only one added column have the same definition.
+func (config *DiffCreateTableConfig) renameColumns(before, after *CreateTableStmt) ([]Stmt, *CreateTableStmt) {
+	dropped := onlyLeftColumn(before.Columns, after.Columns)
+	added := onlyLeftColumn(after.Columns, before.Columns)
+	if len(dropped) == 0 || len(added) == 0 {
+		return nil, before
+	}
+
+	renamed := make(map[*Column]*Column)
+	used := make(map[*Column]bool)
+	for _, beforeColumn := range dropped {
+		for _, afterColumn := range added {
+			if !used[afterColumn] && config.isRenamedColumn(before, after, beforeColumn, afterColumn) {
+				renamed[beforeColumn] = afterColumn
+				used[afterColumn] = true
+				break
+			}
+		}
+	}
+
+	heuristic := make(map[*Column]bool)
+	if config.RenameHeuristic {
+		for _, beforeColumn := range dropped {
+			if renamed[beforeColumn] != nil {
+				continue
+			}
+			if afterColumn := findSameColumnDefinition(beforeColumn, added, used); afterColumn != nil && findSameColumnDefinition(afterColumn, dropped, renamedColumns(renamed)) == beforeColumn {
+				renamed[beforeColumn] = afterColumn
+				used[afterColumn] = true
+				heuristic[beforeColumn] = true
+			}
+		}
+	}
+
+	if len(renamed) == 0 {
+		return nil, before
+	}
+
+	stmts := make([]Stmt, 0, len(renamed))
+	newNames := make(map[string]*Ident, len(renamed))
+	renamedBefore := *before
+	renamedBefore.Columns = make([]*Column, 0, len(before.Columns))
+	for _, beforeColumn := range before.Columns {
+		afterColumn, ok := renamed[beforeColumn]
+		if !ok {
+			renamedBefore.Columns = append(renamedBefore.Columns, beforeColumn)
+			continue
+		}
+
+		comment := simplediff.Diff(beforeColumn.String(), afterColumn.String()).String()
+		if heuristic[beforeColumn] {
+			comment = "NOTE: the column " + beforeColumn.Name.StringForDiff() + " is regarded as renamed to " + afterColumn.Name.StringForDiff() + " because they have the same definition.\n" + comment
+		}
+		// ALTER TABLE table_name RENAME COLUMN column_name TO new_column_name;
+		stmts = append(stmts, &AlterTableStmt{
+			Comment: comment,
+			Name:    after.Name, // ALTER TABLE RENAME TO で変更された後の可能性があるため after.Name を使用する
+			Action: &RenameColumn{
+				Name:    beforeColumn.Name,
+				NewName: afterColumn.Name,
+			},
+		})
+
+		renamedColumn := *beforeColumn
+		renamedColumn.Name = afterColumn.Name
+		renamedBefore.Columns = append(renamedBefore.Columns, &renamedColumn)
+		newNames[beforeColumn.Name.Name] = afterColumn.Name
+	}
+	// MEMO: RENAME COLUMN also renames the column in the constraints, so the constraints are not regarded as changed.
+	renamedBefore.Constraints = renameConstraintColumns(before.Constraints, newNames)
+
+	return stmts, &renamedBefore
+}
+
+func renameConstraintColumns(constraints Constraints, newNames map[string]*Ident) Constraints {
+	renameColumnIdents := func(columns []*ColumnIdent) []*ColumnIdent {
+		renamed := make([]*ColumnIdent, 0, len(columns))
+		for _, column := range columns {
+			if newName, ok := newNames[column.Ident.Name]; ok {
+				c := *column
+				c.Ident = newName
+				column = &c
+			}
+			renamed = append(renamed, column)
+		}
+		return renamed
+	}
+
+	renamed := make(Constraints, 0, len(constraints))
+	for _, constraint := range constraints {
+		switch c := constraint.(type) {
+		case *PrimaryKeyConstraint:
+			pk := *c
+			pk.Columns = renameColumnIdents(c.Columns)
+			constraint = &pk
+		case *ForeignKeyConstraint:
+			fk := *c
+			fk.Columns = renameColumnIdents(c.Columns)
+			constraint = &fk
+		case *UniqueConstraint: //diff:ignore-line-postgres-cockroach
+			unique := *c                                   //diff:ignore-line-postgres-cockroach
+			unique.Columns = renameColumnIdents(c.Columns) //diff:ignore-line-postgres-cockroach
+			constraint = &unique                           //diff:ignore-line-postgres-cockroach
+		case *CheckConstraint:
+			if c.Expr == nil {
+				break
+			}
+			check := *c
+			check.Expr = &Expr{Idents: make([]*Ident, 0, len(c.Expr.Idents))}
+			for _, ident := range c.Expr.Idents {
+				if newName, ok := newNames[ident.Name]; ok {
+					ident = newName
+				}
+				check.Expr.Idents = append(check.Expr.Idents, ident)
+			}
+			constraint = &check
+		}
+		renamed = append(renamed, constraint)
+	}
+	return renamed
+}
+
+// isRenamedColumn reports whether the rename hints declare that beforeColumn is renamed to afterColumn.
+// The column in the hints may be qualified by either the table name before or after the table is renamed.
+func (config *DiffCreateTableConfig) isRenamedColumn(before, after *CreateTableStmt, beforeColumn, afterColumn *Column) bool {
+	return config.Renames.IsRenamed(columnNamePath(before.Name, beforeColumn), columnNamePath(after.Name, afterColumn)) ||
+		config.Renames.IsRenamed(columnNamePath(after.Name, beforeColumn), columnNamePath(after.Name, afterColumn))
+}
+
+func renamedColumns(renamed map[*Column]*Column) map[*Column]bool {
+	columns := make(map[*Column]bool, len(renamed))
+	for beforeColumn := range renamed {
+		columns[beforeColumn] = true
+	}
+	return columns
+}
+
+// findSameColumnDefinition returns the only column in columns that has the same definition as column except the name.
+func findSameColumnDefinition(column *Column, columns []*Column, excludes map[*Column]bool) *Column {
+	var found *Column
+	for _, c := range columns {
+		if excludes[c] || !isSameColumnDefinition(column, c) {
+			continue
+		}
+		if found != nil {
+			return nil
+		}
+		found = c
+	}
+	return found
+}
+
+func isSameColumnDefinition(left, right *Column) bool {
+	return left.DataType.StringForDiff() == right.DataType.StringForDiff() &&
+		left.Default.StringForDiff() == right.Default.StringForDiff() &&
+		left.NotNull == right.NotNull //diff:ignore-line-postgres-cockroach
+}
+
+// findRenamedTable returns the table in after that the rename hints declare stmt is renamed to.
+func (config *DiffConfig) findRenamedTable(stmt *CreateTableStmt, before, after *DDL) *CreateTableStmt {
+	if len(config.Renames) == 0 || findStmtByTypeAndName(stmt, after.Stmts) != nil {
+		return nil
+	}
+	for _, s := range onlyLeftStmt(after, before) {
+		if afterStmt, ok := s.(*CreateTableStmt); ok && config.Renames.IsRenamed(objectNamePath(stmt.Name), objectNamePath(afterStmt.Name)) {
+			return afterStmt
+		}
+	}
+	return nil
+}
+
+// isRenamedTable reports whether the rename hints declare that a table in before is renamed to stmt.
+func (config *DiffConfig) isRenamedTable(stmt *CreateTableStmt, before, after *DDL) bool {
+	for _, s := range onlyLeftStmt(before, after) {
+		if beforeStmt, ok := s.(*CreateTableStmt); ok && config.findRenamedTable(beforeStmt, before, after) == stmt {
+			return true
+		}
+	}
+	return false
+}
+
+func objectNamePath(name *ObjectName) []string {
+	if name.Schema != nil {
+		return []string{name.Schema.Name, name.Name.Name}
+	}
+	return []string{name.Name.Name}
+}
+
+func columnNamePath(table *ObjectName, column *Column) []string {
+	return append(objectNamePath(table), column.Name.Name)
+}
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/kunitsucom/util.go/testing/assert"
@@ -392,4 +393,566 @@ ALTER TABLE public.users ALTER COLUMN username SET DATA TYPE VARCHAR(11);` + "\n
 			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
 		}
 	})
+
+	t.Run("success,before,nil,View", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL); CREATE MATERIALIZED VIEW public.users_view AS SELECT id FROM public.users;`)).Parse()
+		require.NoError(t, err)
+
+		expected := `DROP MATERIALIZED VIEW public.users_view;
+DROP TABLE public.users;
+`
+		actual, err := Diff(before, nil)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,View", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL, name TEXT); CREATE VIEW public.users_view AS SELECT id FROM public.users; CREATE VIEW public.old_view AS SELECT id FROM public.users;`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL, name TEXT); CREATE VIEW public.users_view AS select id, name from public.users; CREATE VIEW public.new_view AS SELECT name FROM public.users;`)).Parse()
+		require.NoError(t, err)
+
+		expected := `DROP VIEW public.old_view;
+-- -CREATE VIEW public.users_view AS SELECT ID FROM PUBLIC.USERS
+-- +CREATE VIEW public.users_view AS SELECT ID, NAME FROM PUBLIC.USERS
+CREATE OR REPLACE VIEW public.users_view AS select id, name from public.users;
+CREATE VIEW public.new_view AS SELECT name FROM public.users;
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,MaterializedView", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL, name TEXT); CREATE MATERIALIZED VIEW public.users_view AS SELECT id FROM public.users;`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL); CREATE MATERIALIZED VIEW public.users_view AS SELECT id FROM public.users WHERE id IS NOT NULL;`)).Parse()
+		require.NoError(t, err)
+
+		expected := `-- -CREATE MATERIALIZED VIEW public.users_view AS SELECT ID FROM PUBLIC.USERS
+-- +CREATE MATERIALIZED VIEW public.users_view AS SELECT ID FROM PUBLIC.USERS WHERE ID IS NOT NULL
+DROP MATERIALIZED VIEW public.users_view;
+-- -name TEXT
+-- +
+ALTER TABLE public.users DROP COLUMN name;
+CREATE MATERIALIZED VIEW public.users_view AS SELECT id FROM public.users WHERE id IS NOT NULL;
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,View,ColumnsReordered", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE VIEW public.users_view AS SELECT u.id, u.name AS user_name, count (*) FROM public.users u;`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE VIEW public.users_view AS SELECT u.name AS user_name, u.id, count (*) FROM public.users u;`)).Parse()
+		require.NoError(t, err)
+
+		expected := `-- -CREATE VIEW public.users_view AS SELECT U.ID, U.NAME AS USER_NAME, COUNT (*) FROM PUBLIC.USERS U
+-- +CREATE VIEW public.users_view AS SELECT U.NAME AS USER_NAME, U.ID, COUNT (*) FROM PUBLIC.USERS U
+DROP VIEW public.users_view;
+CREATE VIEW public.users_view AS SELECT u.name AS user_name, u.id, count (*) FROM public.users u;
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,View,ColumnRemoved", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE VIEW public.users_view AS SELECT DISTINCT id, name FROM public.users;`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE VIEW public.users_view AS SELECT DISTINCT id FROM public.users;`)).Parse()
+		require.NoError(t, err)
+
+		expected := `-- -CREATE VIEW public.users_view AS SELECT DISTINCT ID, NAME FROM PUBLIC.USERS
+-- +CREATE VIEW public.users_view AS SELECT DISTINCT ID FROM PUBLIC.USERS
+DROP VIEW public.users_view;
+CREATE VIEW public.users_view AS SELECT DISTINCT id FROM public.users;
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,View,ColumnAppended", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE VIEW public.users_view AS SELECT u.id, lower(u.name) AS name FROM public.users u;`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE VIEW public.users_view AS SELECT u.id, lower(u.name) AS name, u.email FROM public.users u WHERE u.id IS NOT NULL;`)).Parse()
+		require.NoError(t, err)
+
+		expected := `-- -CREATE VIEW public.users_view AS SELECT U.ID, LOWER (U.NAME) AS NAME FROM PUBLIC.USERS U
+-- +CREATE VIEW public.users_view AS SELECT U.ID, LOWER (U.NAME) AS NAME, U.EMAIL FROM PUBLIC.USERS U WHERE U.ID IS NOT NULL
+CREATE OR REPLACE VIEW public.users_view AS SELECT u.id, lower (u.name) AS name, u.email FROM public.users u WHERE u.id IS NOT NULL;
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,View,NoDifference", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE VIEW public.users_view AS SELECT id FROM public.users;`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE VIEW public.users_view AS
+ select id
+   from public.users;`)).Parse()
+		require.NoError(t, err)
+
+		_, err = Diff(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,before,after,NoDifference,Deparsed", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE public.products (
+  id UUID NOT NULL,
+  price NUMERIC(10, 2) DEFAULT (0)::numeric NOT NULL,
+  tags TEXT[] DEFAULT '{}'::text[] NOT NULL,
+  name CHARACTER VARYING(255) DEFAULT 'unknown'::character varying NOT NULL,
+  CONSTRAINT products_pkey PRIMARY KEY (id),
+  CONSTRAINT products_price_check CHECK (((price > (0)::numeric) AND (price < ((1000 * 1000))::numeric))),
+  CONSTRAINT products_name_check CHECK (((name)::text <> ''::text))
+);
+CREATE VIEW public.products_view AS
+ SELECT products.id,
+    (products.price * (2)::numeric) AS double_price
+   FROM public.products
+  WHERE ((products.price > (0)::numeric) AND (products.name IS NOT NULL));`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TABLE public.products (
+  id UUID NOT NULL,
+  price NUMERIC(10, 2) DEFAULT 0 NOT NULL,
+  tags TEXT[] DEFAULT '{}' NOT NULL,
+  name CHARACTER VARYING(255) DEFAULT 'unknown' NOT NULL,
+  CONSTRAINT products_pkey PRIMARY KEY (id),
+  CONSTRAINT products_price_check CHECK (price > 0 AND price < 1000 * 1000),
+  CONSTRAINT products_name_check CHECK (name <> '')
+);
+CREATE VIEW public.products_view AS SELECT products.id, products.price * 2 AS double_price FROM public.products WHERE products.price > 0 AND products.name IS NOT NULL;`)).Parse()
+		require.NoError(t, err)
+
+		_, err = Diff(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,before,after,Check,Parentheses", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE public.products (id UUID NOT NULL, a INT, b INT, CONSTRAINT products_pkey PRIMARY KEY (id), CONSTRAINT products_a_check CHECK (((a + b) * 2) > 0));`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TABLE public.products (id UUID NOT NULL, a INT, b INT, CONSTRAINT products_pkey PRIMARY KEY (id), CONSTRAINT products_a_check CHECK (a + b * 2 > 0));`)).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		assert.True(t, strings.Contains(actual.String(), "ADD CONSTRAINT products_a_check CHECK (a + b * 2 > 0);"))
+	})
+
+	t.Run("success,before,nil,Type", func(t *testing.T) {
+		t.Parallel()
+
+		after, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL, status user_status NOT NULL); CREATE TYPE public.user_status AS ENUM ('active', 'inactive');`)).Parse()
+		require.NoError(t, err)
+
+		expected := `CREATE TYPE public.user_status AS ENUM ('active', 'inactive');
+CREATE TABLE public.users (
+    id UUID NOT NULL,
+    status user_status NOT NULL
+);
+`
+		actual, err := Diff(nil, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,after,nil,Type", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TYPE public.user_status AS ENUM ('active', 'inactive'); CREATE TABLE public.users (id UUID NOT NULL, status user_status NOT NULL);`)).Parse()
+		require.NoError(t, err)
+
+		expected := `DROP TABLE public.users;
+DROP TYPE public.user_status;
+`
+		actual, err := Diff(before, nil)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,Type", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TYPE public.user_status AS ENUM ('active', 'inactive'); CREATE TYPE public.old_type AS ENUM ('a'); CREATE TABLE public.users (id UUID NOT NULL, status user_status NOT NULL, old old_type);`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TYPE public.user_status AS ENUM ('pending', 'active', 'inactive', 'banned'); CREATE TYPE public.new_type AS ENUM ('b'); CREATE TABLE public.users (id UUID NOT NULL, status user_status NOT NULL, new new_type);`)).Parse()
+		require.NoError(t, err)
+
+		expected := `-- -CREATE TYPE public.user_status AS ENUM ('active', 'inactive')
+-- +CREATE TYPE public.user_status AS ENUM ('pending', 'active', 'inactive', 'banned')
+ALTER TYPE public.user_status ADD VALUE 'pending' BEFORE 'active';
+-- -CREATE TYPE public.user_status AS ENUM ('active', 'inactive')
+-- +CREATE TYPE public.user_status AS ENUM ('pending', 'active', 'inactive', 'banned')
+ALTER TYPE public.user_status ADD VALUE 'banned' AFTER 'inactive';
+CREATE TYPE public.new_type AS ENUM ('b');
+-- -old old_type
+-- +
+ALTER TABLE public.users DROP COLUMN old;
+-- -
+-- +new new_type
+ALTER TABLE public.users ADD COLUMN new new_type;
+DROP TYPE public.old_type;
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,Type,RemoveLabel", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TYPE public.user_status AS ENUM ('active', 'inactive', 'banned'); CREATE TABLE public.users (id UUID NOT NULL, status user_status NOT NULL DEFAULT 'active'::user_status);`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TYPE public.user_status AS ENUM ('active', 'inactive'); CREATE TABLE public.users (id UUID NOT NULL, status user_status NOT NULL DEFAULT 'active'::user_status);`)).Parse()
+		require.NoError(t, err)
+
+		expected := `-- DESTRUCTIVE: enum labels cannot be removed or reordered, so the type is recreated.
+-- -CREATE TYPE public.user_status AS ENUM ('active', 'inactive', 'banned')
+-- +CREATE TYPE public.user_status AS ENUM ('active', 'inactive')
+ALTER TYPE public.user_status RENAME TO user_status_old;
+CREATE TYPE public.user_status AS ENUM ('active', 'inactive');
+ALTER TABLE public.users ALTER COLUMN status DROP DEFAULT;
+ALTER TABLE public.users ALTER COLUMN status SET DATA TYPE user_status USING status::text::public.user_status;
+ALTER TABLE public.users ALTER COLUMN status SET DEFAULT 'active'::user_status;
+DROP TYPE public.user_status_old;
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,nil,Sequence", func(t *testing.T) {
+		t.Parallel()
+
+		after, err := NewParser(NewLexer(`CREATE TABLE public.users (id BIGINT NOT NULL DEFAULT nextval('public.users_id_seq')); CREATE SEQUENCE public.users_id_seq START WITH 100;`)).Parse()
+		require.NoError(t, err)
+
+		expected := `CREATE SEQUENCE public.users_id_seq START 100;
+CREATE TABLE public.users (
+    id BIGINT DEFAULT nextval('public.users_id_seq') NOT NULL
+);
+`
+		actual, err := Diff(nil, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,after,nil,Sequence", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE SEQUENCE public.users_id_seq; CREATE TABLE public.users (id BIGINT NOT NULL DEFAULT nextval('public.users_id_seq'));`)).Parse()
+		require.NoError(t, err)
+
+		expected := `DROP TABLE public.users;
+DROP SEQUENCE public.users_id_seq;
+`
+		actual, err := Diff(before, nil)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,Sequence", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE SEQUENCE public.users_id_seq; CREATE SEQUENCE public.old_seq; CREATE TABLE public.users (id BIGINT NOT NULL);`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE SEQUENCE public.users_id_seq INCREMENT BY 10 CACHE 20; CREATE SEQUENCE public.new_seq; CREATE TABLE public.users (id BIGINT NOT NULL);`)).Parse()
+		require.NoError(t, err)
+
+		expected := `-- -CREATE SEQUENCE public.users_id_seq AS BIGINT INCREMENT 1 MINVALUE 1 MAXVALUE 9223372036854775807 START 1 CACHE 1 NO CYCLE
+-- +CREATE SEQUENCE public.users_id_seq AS BIGINT INCREMENT 10 MINVALUE 1 MAXVALUE 9223372036854775807 START 1 CACHE 20 NO CYCLE
+ALTER SEQUENCE public.users_id_seq INCREMENT 10 CACHE 20;
+CREATE SEQUENCE public.new_seq;
+DROP SEQUENCE public.old_seq;
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("failure,before,after,Sequence,ddl.ErrNoDifference", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE SEQUENCE public.users_id_seq AS bigint INCREMENT 1 MINVALUE 1 MAXVALUE 9223372036854775807 START 1 CACHE 1 NO CYCLE;`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE SEQUENCE public.users_id_seq;`)).Parse()
+		require.NoError(t, err)
+
+		_, err = Diff(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,before,nil,Schema", func(t *testing.T) {
+		t.Parallel()
+
+		after, err := NewParser(NewLexer(`CREATE TABLE tenant_a.users (id BIGINT NOT NULL); CREATE SCHEMA tenant_a;`)).Parse()
+		require.NoError(t, err)
+
+		expected := `CREATE SCHEMA tenant_a;
+CREATE TABLE tenant_a.users (
+    id BIGINT NOT NULL
+);
+`
+		actual, err := Diff(nil, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,after,nil,Schema", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE SCHEMA tenant_a; CREATE TABLE tenant_a.users (id BIGINT NOT NULL);`)).Parse()
+		require.NoError(t, err)
+
+		expected := `DROP TABLE tenant_a.users;
+DROP SCHEMA tenant_a;
+`
+		actual, err := Diff(before, nil)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,Schema", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE SCHEMA tenant_a; CREATE TABLE tenant_a.users (id BIGINT NOT NULL);`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TABLE tenant_b.users (id BIGINT NOT NULL); CREATE SCHEMA tenant_b;`)).Parse()
+		require.NoError(t, err)
+
+		expected := `DROP TABLE tenant_a.users;
+CREATE SCHEMA tenant_b;
+CREATE TABLE tenant_b.users (
+    id BIGINT NOT NULL
+);
+DROP SCHEMA tenant_a;
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("failure,before,after,Schema,ddl.ErrNoDifference", func(t *testing.T) {
+		t.Parallel()
+
+		// MEMO: CREATE SCHEMA is optional, so the schema that has tables in it is regarded as existing.
+		before, err := NewParser(NewLexer(`CREATE TABLE tenant_a.users (id BIGINT NOT NULL);`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE SCHEMA tenant_a; CREATE TABLE tenant_a.users (id BIGINT NOT NULL);`)).Parse()
+		require.NoError(t, err)
+
+		_, err = Diff(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+
+		_, err = Diff(after, before)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,before,nil,ForeignKey,Cycle", func(t *testing.T) {
+		t.Parallel()
+
+		after, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL, group_id UUID NOT NULL, PRIMARY KEY (id), CONSTRAINT users_group_id_fkey FOREIGN KEY (group_id) REFERENCES public.groups (id)); CREATE TABLE public.groups (id UUID NOT NULL, owner_id UUID, PRIMARY KEY (id), CONSTRAINT groups_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES users (id)); CREATE TABLE public.logs (id UUID NOT NULL, user_id UUID, CONSTRAINT logs_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users (id));`)).Parse()
+		require.NoError(t, err)
+
+		expected := `CREATE TABLE public.users (
+    id UUID NOT NULL,
+    group_id UUID NOT NULL,
+    CONSTRAINT users_pkey PRIMARY KEY (id)
+);
+CREATE TABLE public.groups (
+    id UUID NOT NULL,
+    owner_id UUID,
+    CONSTRAINT groups_pkey PRIMARY KEY (id),
+    CONSTRAINT groups_owner_id_fkey FOREIGN KEY (owner_id) REFERENCES users (id)
+);
+CREATE TABLE public.logs (
+    id UUID NOT NULL,
+    user_id UUID,
+    CONSTRAINT logs_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users (id)
+);
+ALTER TABLE public.users ADD CONSTRAINT users_group_id_fkey FOREIGN KEY (group_id) REFERENCES public.groups (id);
+`
+		actual, err := Diff(nil, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+
+		expected = `ALTER TABLE public.users DROP CONSTRAINT users_group_id_fkey;
+DROP TABLE public.logs;
+DROP TABLE public.groups;
+DROP TABLE public.users;
+`
+		actual, err = Diff(after, nil)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,ForeignKey", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL, PRIMARY KEY (id)); CREATE TABLE public.old_posts (id UUID NOT NULL, parent_id UUID, PRIMARY KEY (id), CONSTRAINT old_posts_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES public.old_comments (id)); CREATE TABLE public.old_comments (id UUID NOT NULL, PRIMARY KEY (id)); CREATE INDEX old_posts_idx_parent_id ON public.old_posts (parent_id);`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL, PRIMARY KEY (id)); CREATE INDEX posts_idx_user_id ON public.posts (user_id); CREATE TABLE public.posts (id UUID NOT NULL, user_id UUID NOT NULL, CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users (id), CONSTRAINT posts_group_id_fkey FOREIGN KEY (user_id) REFERENCES public.groups (id)); CREATE TABLE public.groups (id UUID NOT NULL, PRIMARY KEY (id));`)).Parse()
+		require.NoError(t, err)
+
+		expected := `DROP INDEX old_posts_idx_parent_id;
+DROP TABLE public.old_posts;
+DROP TABLE public.old_comments;
+CREATE TABLE public.groups (
+    id UUID NOT NULL,
+    CONSTRAINT groups_pkey PRIMARY KEY (id)
+);
+CREATE TABLE public.posts (
+    id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users (id),
+    CONSTRAINT posts_group_id_fkey FOREIGN KEY (user_id) REFERENCES public.groups (id)
+);
+CREATE INDEX posts_idx_user_id ON public.posts (user_id);
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,DiffRenames", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE public.old_users (id UUID NOT NULL, name TEXT NOT NULL, PRIMARY KEY (id), CONSTRAINT old_users_name_key UNIQUE (name));`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL, full_name TEXT NOT NULL, PRIMARY KEY (id), CONSTRAINT old_users_name_key UNIQUE (full_name));`)).Parse()
+		require.NoError(t, err)
+
+		renames, err := ddl.ParseRenames("old_users=users,users.name=users.full_name")
+		require.NoError(t, err)
+
+		expected := `-- -public.old_users
+-- +public.users
+ALTER TABLE public.old_users RENAME TO users;
+-- -CONSTRAINT old_users_pkey PRIMARY KEY (id)
+-- +
+ALTER TABLE public.users DROP CONSTRAINT old_users_pkey;
+-- -name TEXT NOT NULL
+-- +full_name TEXT NOT NULL
+ALTER TABLE public.users RENAME COLUMN name TO full_name;
+-- -
+-- +CONSTRAINT users_pkey PRIMARY KEY (id)
+ALTER TABLE public.users ADD CONSTRAINT users_pkey PRIMARY KEY (id);
+`
+		actual, err := Diff(before, after, DiffRenames(renames))
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,DiffRenameHeuristic", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL, name TEXT NOT NULL, age INTEGER, memo TEXT, note TEXT, PRIMARY KEY (id));`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TABLE public.users (id UUID NOT NULL, full_name TEXT NOT NULL, years INTEGER, description TEXT, PRIMARY KEY (id));`)).Parse()
+		require.NoError(t, err)
+
+		expected := `-- NOTE: the column name is regarded as renamed to full_name because they have the same definition.
+-- -name TEXT NOT NULL
+-- +full_name TEXT NOT NULL
+ALTER TABLE public.users RENAME COLUMN name TO full_name;
+-- NOTE: the column age is regarded as renamed to years because they have the same definition.
+-- -age INTEGER
+-- +years INTEGER
+ALTER TABLE public.users RENAME COLUMN age TO years;
+-- -memo TEXT
+-- +
+ALTER TABLE public.users DROP COLUMN memo;
+-- -note TEXT
+-- +
+ALTER TABLE public.users DROP COLUMN note;
+-- -
+-- +description TEXT
+ALTER TABLE public.users ADD COLUMN description TEXT;
+`
+		actual, err := Diff(before, after, DiffRenameHeuristic(true))
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
 }
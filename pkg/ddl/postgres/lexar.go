@@ -50,6 +50,8 @@ const (
 	TOKEN_SLASH         TokenType = "SLASH"         // /
 	TOKEN_STRING_CONCAT TokenType = "STRING_CONCAT" //nolint:gosec // ||
 	TOKEN_TYPECAST      TokenType = "TYPECAST"      // ::
+	TOKEN_OPEN_BRACKET  TokenType = "OPEN_BRACKET"  // [ //diff:ignore-line-postgres-cockroach
+	TOKEN_CLOSE_BRACKET TokenType = "CLOSE_BRACKET" // ] //diff:ignore-line-postgres-cockroach
 
 	// VERB.
 	TOKEN_CREATE   TokenType = "CREATE"
@@ -61,16 +63,19 @@ const (
 	TOKEN_UPDATE   TokenType = "UPDATE"
 
 	// OBJECT.
-	TOKEN_TABLE TokenType = "TABLE"
-	TOKEN_INDEX TokenType = "INDEX"
-	TOKEN_VIEW  TokenType = "VIEW"
+	TOKEN_TABLE        TokenType = "TABLE"
+	TOKEN_INDEX        TokenType = "INDEX"
+	TOKEN_VIEW         TokenType = "VIEW"
+	TOKEN_MATERIALIZED TokenType = "MATERIALIZED"
 
 	// OTHER.
-	TOKEN_IF     TokenType = "IF"
-	TOKEN_EXISTS TokenType = "EXISTS"
-	TOKEN_USING  TokenType = "USING"
-	TOKEN_ON     TokenType = "ON"
-	TOKEN_TO     TokenType = "TO"
+	TOKEN_IF      TokenType = "IF"
+	TOKEN_EXISTS  TokenType = "EXISTS"
+	TOKEN_USING   TokenType = "USING"
+	TOKEN_ON      TokenType = "ON"
+	TOKEN_TO      TokenType = "TO"
+	TOKEN_OR      TokenType = "OR"
+	TOKEN_REPLACE TokenType = "REPLACE"
 
 	// DATA TYPE.
 	TOKEN_BOOLEAN                  TokenType = "BOOLEAN"  //diff:ignore-line-postgres-cockroach
@@ -96,17 +101,27 @@ const (
 	TOKEN_TIMESTAMP_WITH_TIME_ZONE TokenType = "TIMESTAMP WITH TIME ZONE" //diff:ignore-line-postgres-cockroach
 	TOKEN_TIMESTAMP                TokenType = "TIMESTAMP"
 	TOKEN_WITH                     TokenType = "WITH"
+	TOKEN_WITHOUT                  TokenType = "WITHOUT" //diff:ignore-line-postgres-cockroach
 	TOKEN_TIME                     TokenType = "TIME"
 	TOKEN_ZONE                     TokenType = "ZONE"
 
 	// COLUMN.
-	TOKEN_DEFAULT TokenType = "DEFAULT"
-	TOKEN_NOT     TokenType = "NOT"
-	TOKEN_ASC     TokenType = "ASC"
-	TOKEN_DESC    TokenType = "DESC"
-	TOKEN_CASCADE TokenType = "CASCADE"
-	TOKEN_NO      TokenType = "NO"
-	TOKEN_ACTION  TokenType = "ACTION"
+	TOKEN_DEFAULT   TokenType = "DEFAULT"
+	TOKEN_NOT       TokenType = "NOT"
+	TOKEN_AS        TokenType = "AS"
+	TOKEN_ASC       TokenType = "ASC"
+	TOKEN_DESC      TokenType = "DESC"
+	TOKEN_CASCADE   TokenType = "CASCADE"
+	TOKEN_NO        TokenType = "NO"
+	TOKEN_ACTION    TokenType = "ACTION"
+	TOKEN_SET       TokenType = "SET"       //diff:ignore-line-postgres-cockroach
+	TOKEN_RESTRICT  TokenType = "RESTRICT"  //diff:ignore-line-postgres-cockroach
+	TOKEN_COLLATE   TokenType = "COLLATE"   //diff:ignore-line-postgres-cockroach
+	TOKEN_GENERATED TokenType = "GENERATED" //diff:ignore-line-postgres-cockroach
+	TOKEN_ALWAYS    TokenType = "ALWAYS"    //diff:ignore-line-postgres-cockroach
+	TOKEN_BY        TokenType = "BY"        //diff:ignore-line-postgres-cockroach
+	TOKEN_IDENTITY  TokenType = "IDENTITY"  //diff:ignore-line-postgres-cockroach
+	TOKEN_STORED    TokenType = "STORED"    //diff:ignore-line-postgres-cockroach
 
 	// CONSTRAINT.
 	TOKEN_CONSTRAINT TokenType = "CONSTRAINT"
@@ -116,6 +131,11 @@ const (
 	TOKEN_REFERENCES TokenType = "REFERENCES"
 	TOKEN_UNIQUE     TokenType = "UNIQUE"
 	TOKEN_CHECK      TokenType = "CHECK"
+	TOKEN_MATCH      TokenType = "MATCH"      //diff:ignore-line-postgres-cockroach
+	TOKEN_DEFERRABLE TokenType = "DEFERRABLE" //diff:ignore-line-postgres-cockroach
+	TOKEN_INITIALLY  TokenType = "INITIALLY"  //diff:ignore-line-postgres-cockroach
+	TOKEN_DEFERRED   TokenType = "DEFERRED"   //diff:ignore-line-postgres-cockroach
+	TOKEN_IMMEDIATE  TokenType = "IMMEDIATE"  //diff:ignore-line-postgres-cockroach
 
 	// FUNCTION.
 	TOKEN_NULLIF TokenType = "NULLIF"
@@ -161,6 +181,8 @@ func lookupIdent(ident string) TokenType {
 		return TOKEN_INDEX
 	case "VIEW":
 		return TOKEN_VIEW
+	case "MATERIALIZED":
+		return TOKEN_MATERIALIZED
 	case "IF":
 		return TOKEN_IF
 	case "EXISTS":
@@ -171,13 +193,17 @@ func lookupIdent(ident string) TokenType {
 		return TOKEN_ON
 	case "TO":
 		return TOKEN_TO
-	case "BOOLEAN": //diff:ignore-line-postgres-cockroach
+	case "OR":
+		return TOKEN_OR
+	case "REPLACE":
+		return TOKEN_REPLACE
+	case "BOOLEAN", "BOOL": //diff:ignore-line-postgres-cockroach
 		return TOKEN_BOOLEAN //diff:ignore-line-postgres-cockroach
-	case "SMALLINT": //diff:ignore-line-postgres-cockroach
+	case "SMALLINT", "INT2": //diff:ignore-line-postgres-cockroach
 		return TOKEN_SMALLINT //diff:ignore-line-postgres-cockroach
-	case "INTEGER", "INT": //diff:ignore-line-postgres-cockroach
+	case "INTEGER", "INT", "INT4": //diff:ignore-line-postgres-cockroach
 		return TOKEN_INTEGER //diff:ignore-line-postgres-cockroach
-	case "BIGINT": //diff:ignore-line-postgres-cockroach
+	case "BIGINT", "INT8": //diff:ignore-line-postgres-cockroach
 		return TOKEN_BIGINT //diff:ignore-line-postgres-cockroach
 	case "DECIMAL":
 		return TOKEN_DECIMAL
@@ -211,6 +237,8 @@ func lookupIdent(ident string) TokenType {
 		return TOKEN_TIMESTAMPTZ
 	case "WITH":
 		return TOKEN_WITH
+	case "WITHOUT": //diff:ignore-line-postgres-cockroach
+		return TOKEN_WITHOUT //diff:ignore-line-postgres-cockroach
 	case "TIME":
 		return TOKEN_TIME
 	case "ZONE":
@@ -219,6 +247,8 @@ func lookupIdent(ident string) TokenType {
 		return TOKEN_DEFAULT
 	case "NOT":
 		return TOKEN_NOT
+	case "AS":
+		return TOKEN_AS
 	case "ASC":
 		return TOKEN_ASC
 	case "DESC":
@@ -229,6 +259,22 @@ func lookupIdent(ident string) TokenType {
 		return TOKEN_NO
 	case "ACTION":
 		return TOKEN_ACTION
+	case "SET": //diff:ignore-line-postgres-cockroach
+		return TOKEN_SET //diff:ignore-line-postgres-cockroach
+	case "RESTRICT": //diff:ignore-line-postgres-cockroach
+		return TOKEN_RESTRICT //diff:ignore-line-postgres-cockroach
+	case "COLLATE": //diff:ignore-line-postgres-cockroach
+		return TOKEN_COLLATE //diff:ignore-line-postgres-cockroach
+	case "GENERATED": //diff:ignore-line-postgres-cockroach
+		return TOKEN_GENERATED //diff:ignore-line-postgres-cockroach
+	case "ALWAYS": //diff:ignore-line-postgres-cockroach
+		return TOKEN_ALWAYS //diff:ignore-line-postgres-cockroach
+	case "BY": //diff:ignore-line-postgres-cockroach
+		return TOKEN_BY //diff:ignore-line-postgres-cockroach
+	case "IDENTITY": //diff:ignore-line-postgres-cockroach
+		return TOKEN_IDENTITY //diff:ignore-line-postgres-cockroach
+	case "STORED": //diff:ignore-line-postgres-cockroach
+		return TOKEN_STORED //diff:ignore-line-postgres-cockroach
 	case "CONSTRAINT":
 		return TOKEN_CONSTRAINT
 	case "PRIMARY":
@@ -243,6 +289,16 @@ func lookupIdent(ident string) TokenType {
 		return TOKEN_UNIQUE
 	case "CHECK":
 		return TOKEN_CHECK
+	case "MATCH": //diff:ignore-line-postgres-cockroach
+		return TOKEN_MATCH //diff:ignore-line-postgres-cockroach
+	case "DEFERRABLE": //diff:ignore-line-postgres-cockroach
+		return TOKEN_DEFERRABLE //diff:ignore-line-postgres-cockroach
+	case "INITIALLY": //diff:ignore-line-postgres-cockroach
+		return TOKEN_INITIALLY //diff:ignore-line-postgres-cockroach
+	case "DEFERRED": //diff:ignore-line-postgres-cockroach
+		return TOKEN_DEFERRED //diff:ignore-line-postgres-cockroach
+	case "IMMEDIATE": //diff:ignore-line-postgres-cockroach
+		return TOKEN_IMMEDIATE //diff:ignore-line-postgres-cockroach
 	case "NULLIF":
 		return TOKEN_NULLIF
 	case "NULL":
@@ -321,6 +377,10 @@ func (l *Lexer) NextToken() Token {
 		} else {
 			tok = newToken(TOKEN_ILLEGAL, l.ch)
 		}
+	case '[': //diff:ignore-line-postgres-cockroach
+		tok = newToken(TOKEN_OPEN_BRACKET, l.ch) //diff:ignore-line-postgres-cockroach
+	case ']': //diff:ignore-line-postgres-cockroach
+		tok = newToken(TOKEN_CLOSE_BRACKET, l.ch) //diff:ignore-line-postgres-cockroach
 	case '(':
 		tok = newToken(TOKEN_OPEN_PAREN, l.ch)
 	case ')':
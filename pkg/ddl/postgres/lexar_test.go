@@ -31,15 +31,22 @@ func Test_lookupIdent(t *testing.T) {
 		{name: "success,TABLE", input: "TABLE", want: TOKEN_TABLE},
 		{name: "success,INDEX", input: "INDEX", want: TOKEN_INDEX},
 		{name: "success,VIEW", input: "VIEW", want: TOKEN_VIEW},
+		{name: "success,MATERIALIZED", input: "MATERIALIZED", want: TOKEN_MATERIALIZED},
 		{name: "success,IF", input: "IF", want: TOKEN_IF},
 		{name: "success,EXISTS", input: "EXISTS", want: TOKEN_EXISTS},
 		{name: "success,ON", input: "ON", want: TOKEN_ON},
 		{name: "success,TO", input: "TO", want: TOKEN_TO},
+		{name: "success,OR", input: "OR", want: TOKEN_OR},
+		{name: "success,REPLACE", input: "REPLACE", want: TOKEN_REPLACE},
 		{name: "success,BOOLEAN", input: "BOOLEAN", want: TOKEN_BOOLEAN},
 		{name: "success,SMALLINT", input: "SMALLINT", want: TOKEN_SMALLINT},
 		{name: "success,INTEGER", input: "INTEGER", want: TOKEN_INTEGER},
 		{name: "success,INT", input: "INT", want: TOKEN_INTEGER},
 		{name: "success,BIGINT", input: "BIGINT", want: TOKEN_BIGINT},
+		{name: "success,BOOL", input: "BOOL", want: TOKEN_BOOLEAN},
+		{name: "success,INT2", input: "INT2", want: TOKEN_SMALLINT},
+		{name: "success,INT4", input: "INT4", want: TOKEN_INTEGER},
+		{name: "success,INT8", input: "INT8", want: TOKEN_BIGINT},
 		{name: "success,DECIMAL", input: "DECIMAL", want: TOKEN_DECIMAL},
 		{name: "success,NUMERIC", input: "NUMERIC", want: TOKEN_NUMERIC},
 		{name: "success,REAL", input: "REAL", want: TOKEN_REAL},
@@ -61,6 +68,7 @@ func Test_lookupIdent(t *testing.T) {
 		{name: "success,ZONE", input: "ZONE", want: TOKEN_ZONE},
 		{name: "success,DEFAULT", input: "DEFAULT", want: TOKEN_DEFAULT},
 		{name: "success,NOT", input: "NOT", want: TOKEN_NOT},
+		{name: "success,AS", input: "AS", want: TOKEN_AS},
 		{name: "success,NULL", input: "NULL", want: TOKEN_NULL},
 		{name: "success,ASC", input: "ASC", want: TOKEN_ASC},
 		{name: "success,DESC", input: "DESC", want: TOKEN_DESC},
@@ -74,6 +82,20 @@ func Test_lookupIdent(t *testing.T) {
 		{name: "success,REFERENCES", input: "REFERENCES", want: TOKEN_REFERENCES},
 		{name: "success,UNIQUE", input: "UNIQUE", want: TOKEN_UNIQUE},
 		{name: "success,CHECK", input: "CHECK", want: TOKEN_CHECK},
+		{name: "success,WITHOUT", input: "WITHOUT", want: TOKEN_WITHOUT},
+		{name: "success,SET", input: "SET", want: TOKEN_SET},
+		{name: "success,RESTRICT", input: "RESTRICT", want: TOKEN_RESTRICT},
+		{name: "success,COLLATE", input: "COLLATE", want: TOKEN_COLLATE},
+		{name: "success,GENERATED", input: "GENERATED", want: TOKEN_GENERATED},
+		{name: "success,ALWAYS", input: "ALWAYS", want: TOKEN_ALWAYS},
+		{name: "success,BY", input: "BY", want: TOKEN_BY},
+		{name: "success,IDENTITY", input: "IDENTITY", want: TOKEN_IDENTITY},
+		{name: "success,STORED", input: "STORED", want: TOKEN_STORED},
+		{name: "success,MATCH", input: "MATCH", want: TOKEN_MATCH},
+		{name: "success,DEFERRABLE", input: "DEFERRABLE", want: TOKEN_DEFERRABLE},
+		{name: "success,INITIALLY", input: "INITIALLY", want: TOKEN_INITIALLY},
+		{name: "success,DEFERRED", input: "DEFERRED", want: TOKEN_DEFERRED},
+		{name: "success,IMMEDIATE", input: "IMMEDIATE", want: TOKEN_IMMEDIATE},
 		{name: "success,NULLIF", input: "NULLIF", want: TOKEN_NULLIF},
 		{name: "success,IDENT", input: "users", want: TOKEN_IDENT},
 	}
@@ -172,6 +194,18 @@ func TestLex(t *testing.T) {
 				{Type: TOKEN_SEMICOLON, Literal: Literal{Str: ";"}},
 			},
 		},
+		{
+			name:  "success,ARRAY",
+			input: `"tags" TEXT[] NOT NULL`,
+			want: []Token{
+				{Type: TOKEN_IDENT, Literal: Literal{Str: `"tags"`}},
+				{Type: TOKEN_TEXT, Literal: Literal{Str: "TEXT"}},
+				{Type: TOKEN_OPEN_BRACKET, Literal: Literal{Str: "["}},
+				{Type: TOKEN_CLOSE_BRACKET, Literal: Literal{Str: "]"}},
+				{Type: TOKEN_NOT, Literal: Literal{Str: "NOT"}},
+				{Type: TOKEN_NULL, Literal: Literal{Str: "NULL"}},
+			},
+		},
 	}
 
 	for _, tt := range tests {
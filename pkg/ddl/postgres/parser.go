@@ -118,6 +118,36 @@ func (p *Parser) parseCreateStatement() (Stmt, error) { //nolint:ireturn
 			return nil, apperr.Errorf("parseCreateIndexStmt: %w", err)
 		}
 		return stmt, nil
+	case TOKEN_OR, TOKEN_MATERIALIZED, TOKEN_VIEW:
+		stmt, err := p.parseCreateViewStmt()
+		if err != nil {
+			return nil, apperr.Errorf("parseCreateViewStmt: %w", err)
+		}
+		return stmt, nil
+	case TOKEN_IDENT:
+		// MEMO: TYPE, SEQUENCE and SCHEMA are not tokenized because they are commonly used as column names.
+		switch strings.ToUpper(p.currentToken.Literal.Str) {
+		case "TYPE":
+			stmt, err := p.parseCreateTypeStmt()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateTypeStmt: %w", err)
+			}
+			return stmt, nil
+		case "SEQUENCE":
+			stmt, err := p.parseCreateSequenceStmt()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateSequenceStmt: %w", err)
+			}
+			return stmt, nil
+		case "SCHEMA":
+			stmt, err := p.parseCreateSchemaStmt()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateSchemaStmt: %w", err)
+			}
+			return stmt, nil
+		default:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
 	default:
 		return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
 	}
@@ -262,6 +292,280 @@ func (p *Parser) parseCreateIndexStmt() (*CreateIndexStmt, error) {
 	return createIndexStmt, nil
 }
 
+func (p *Parser) parseCreateTypeStmt() (*CreateTypeStmt, error) {
+	createTypeStmt := &CreateTypeStmt{}
+
+	p.nextToken() // current = type_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	createTypeStmt.Name = NewObjectName(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("type_name=%s: ", createTypeStmt.Name.StringForDiff())
+
+	if err := p.checkPeekToken(TOKEN_AS); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = AS
+
+	// MEMO: ENUM is not tokenized for the same reason as TYPE.
+	if !strings.EqualFold(p.peekToken.Literal.Str, "ENUM") {
+		return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedPeekToken)
+	}
+	p.nextToken() // current = ENUM
+
+	if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = (
+
+	labels, err := p.parseIdents()
+	if err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"parseIdents: %w", err)
+	}
+	for _, label := range labels {
+		if label.String() != "," {
+			createTypeStmt.Labels = append(createTypeStmt.Labels, label)
+		}
+	}
+
+	return createTypeStmt, nil
+}
+
+func (p *Parser) parseCreateSchemaStmt() (*CreateSchemaStmt, error) {
+	createSchemaStmt := &CreateSchemaStmt{}
+
+	if p.isPeekToken(TOKEN_IF) {
+		p.nextToken() // current = IF
+		if err := p.checkPeekToken(TOKEN_NOT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = NOT
+		if err := p.checkPeekToken(TOKEN_EXISTS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = EXISTS
+		createSchemaStmt.IfNotExists = true
+	}
+
+	p.nextToken() // current = schema_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+	createSchemaStmt.Name = NewRawIdent(p.currentToken.Literal.Str)
+
+	return createSchemaStmt, nil
+}
+
+//nolint:cyclop,funlen,gocognit
+func (p *Parser) parseCreateSequenceStmt() (*CreateSequenceStmt, error) {
+	createSequenceStmt := &CreateSequenceStmt{}
+
+	if p.isPeekToken(TOKEN_IF) {
+		p.nextToken() // current = IF
+		if err := p.checkPeekToken(TOKEN_NOT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = NOT
+		if err := p.checkPeekToken(TOKEN_EXISTS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = EXISTS
+		createSequenceStmt.IfNotExists = true
+	}
+
+	p.nextToken() // current = sequence_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	createSequenceStmt.Name = NewObjectName(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("sequence_name=%s: ", createSequenceStmt.Name.StringForDiff())
+
+	p.nextToken() // current = option or ;
+
+	// MEMO: the option keywords such as INCREMENT or START are not tokenized for the same reason as SEQUENCE.
+LabelOptions:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_SEMICOLON, TOKEN_EOF:
+			break LabelOptions
+		case TOKEN_AS:
+			p.nextToken() // current = data_type
+			if !isDataType(p.currentToken.Type) && !p.isCurrentToken(TOKEN_IDENT) {
+				return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+			}
+			dataType, err := p.parseDataType()
+			if err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"parseDataType: %w", err)
+			}
+			createSequenceStmt.Options = append(createSequenceStmt.Options, &SequenceOption{Name: SequenceOptionAs, Value: NewRawIdent(dataType.String())})
+		case TOKEN_NO:
+			p.nextToken() // current = MINVALUE or MAXVALUE or CYCLE
+			switch name := strings.ToUpper(p.currentToken.Literal.Str); name {
+			case SequenceOptionMinValue, SequenceOptionMaxValue, SequenceOptionCycle:
+				createSequenceStmt.Options = append(createSequenceStmt.Options, &SequenceOption{Name: "NO " + name})
+			default:
+				return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+			}
+		case TOKEN_IDENT:
+			switch name := strings.ToUpper(p.currentToken.Literal.Str); name {
+			case SequenceOptionIncrement, SequenceOptionStart, SequenceOptionMinValue, SequenceOptionMaxValue, SequenceOptionCache:
+				if name == SequenceOptionIncrement && strings.EqualFold(p.peekToken.Literal.Str, "BY") ||
+					name == SequenceOptionStart && p.isPeekToken(TOKEN_WITH) {
+					p.nextToken() // current = BY or WITH
+				}
+				value, err := p.parseSequenceOptionValue()
+				if err != nil {
+					return nil, apperr.Errorf(errFmtPrefix+"parseSequenceOptionValue: %w", err)
+				}
+				createSequenceStmt.Options = append(createSequenceStmt.Options, &SequenceOption{Name: name, Value: value})
+			case SequenceOptionCycle:
+				createSequenceStmt.Options = append(createSequenceStmt.Options, &SequenceOption{Name: name})
+			default:
+				return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+			}
+		default:
+			return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+
+		p.nextToken()
+	}
+
+	return createSequenceStmt, nil
+}
+
+// parseSequenceOptionValue parses a numeric value of a sequence option, which may be negative.
+func (p *Parser) parseSequenceOptionValue() (*Ident, error) {
+	p.nextToken() // current = value or -
+	var sign string
+	if p.isCurrentToken(TOKEN_MINUS) {
+		sign = p.currentToken.Literal.Str
+		p.nextToken() // current = value
+	}
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+	return NewRawIdent(sign + p.currentToken.Literal.Str), nil
+}
+
+//nolint:cyclop,funlen
+func (p *Parser) parseCreateViewStmt() (*CreateViewStmt, error) {
+	createViewStmt := &CreateViewStmt{}
+
+	if p.isCurrentToken(TOKEN_OR) {
+		if err := p.checkPeekToken(TOKEN_REPLACE); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = REPLACE
+		createViewStmt.OrReplace = true
+		p.nextToken() // current = MATERIALIZED or VIEW
+	}
+
+	if p.isCurrentToken(TOKEN_MATERIALIZED) {
+		createViewStmt.Materialized = true
+		p.nextToken() // current = VIEW
+	}
+
+	if err := p.checkCurrentToken(TOKEN_VIEW); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	if p.isPeekToken(TOKEN_IF) {
+		p.nextToken() // current = IF
+		if err := p.checkPeekToken(TOKEN_NOT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = NOT
+		if err := p.checkPeekToken(TOKEN_EXISTS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = EXISTS
+		createViewStmt.IfNotExists = true
+	}
+
+	p.nextToken() // current = view_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	createViewStmt.Name = NewObjectName(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("view_name=%s: ", createViewStmt.Name.StringForDiff())
+
+	p.nextToken() // current = ( or AS
+
+	if p.isCurrentToken(TOKEN_OPEN_PAREN) {
+		idents, err := p.parseColumnIdents()
+		if err != nil {
+			return nil, apperr.Errorf(errFmtPrefix+"parseColumnIdents: %w", err)
+		}
+		for _, ident := range idents {
+			createViewStmt.Columns = append(createViewStmt.Columns, ident.Ident)
+		}
+	}
+
+	if err := p.checkCurrentToken(TOKEN_AS); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+	}
+
+	p.nextToken() // current = SELECT or ...
+
+	query, err := p.parseQuery()
+	if err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"parseQuery: %w", err)
+	}
+	createViewStmt.Query = query
+
+	return createViewStmt, nil
+}
+
+// parseQuery は SEMICOLON または EOF までのトークンをクエリとして読み込みます。
+func (p *Parser) parseQuery() (*Query, error) {
+	query := &Query{}
+	depth := 0
+
+LabelQuery:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_SEMICOLON:
+			if depth == 0 {
+				break LabelQuery
+			}
+		case TOKEN_EOF:
+			break LabelQuery
+		case TOKEN_OPEN_PAREN:
+			depth++
+		case TOKEN_CLOSE_PAREN:
+			depth--
+		}
+
+		value := p.currentToken.Literal.Str
+		// MEMO: join the operators such as >=, <=, <> and != that the lexer splits into two tokens.
+		for isQueryOperator(p.currentToken.Literal.Str) && p.isPeekToken(TOKEN_EQUAL, TOKEN_GREATER) {
+			p.nextToken()
+			value += p.currentToken.Literal.Str
+		}
+		query.Idents = append(query.Idents, NewRawIdent(value))
+
+		p.nextToken()
+	}
+
+	if depth != 0 || len(query.Idents) == 0 {
+		return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+	}
+
+	return query, nil
+}
+
+func isQueryOperator(literal string) bool {
+	switch literal {
+	case "<", ">", "!":
+		return true
+	default:
+		return false
+	}
+}
+
 //nolint:funlen,cyclop
 func (p *Parser) parseColumn(tableName *Ident) (*Column, []Constraint, error) {
 	column := &Column{}
@@ -277,7 +581,7 @@ func (p *Parser) parseColumn(tableName *Ident) (*Column, []Constraint, error) {
 	p.nextToken() // current = DATA_TYPE
 
 	switch { //nolint:exhaustive
-	case isDataType(p.currentToken.Type):
+	case isDataType(p.currentToken.Type), p.isCurrentToken(TOKEN_IDENT): // MEMO: TOKEN_IDENT is a user-defined type such as ENUM.
 		dataType, err := p.parseDataType()
 		if err != nil {
 			return nil, nil, apperr.Errorf(errFmtPrefix+"parseDataType: %w", err)
@@ -304,6 +608,16 @@ func (p *Parser) parseColumn(tableName *Ident) (*Column, []Constraint, error) {
 				}
 				column.Default = def
 				continue
+			case TOKEN_COLLATE: //diff:ignore-line-postgres-cockroach
+				if err := p.checkPeekToken(TOKEN_IDENT); err != nil { //diff:ignore-line-postgres-cockroach
+					return nil, nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err) //diff:ignore-line-postgres-cockroach
+				} //diff:ignore-line-postgres-cockroach
+				p.nextToken()                                            // current = collation_name //diff:ignore-line-postgres-cockroach
+				column.Collate = NewRawIdent(p.currentToken.Literal.Str) //diff:ignore-line-postgres-cockroach
+			case TOKEN_GENERATED: //diff:ignore-line-postgres-cockroach
+				if err := p.parseColumnGenerated(column); err != nil { //diff:ignore-line-postgres-cockroach
+					return nil, nil, apperr.Errorf(errFmtPrefix+"parseColumnGenerated: %w", err) //diff:ignore-line-postgres-cockroach
+				} //diff:ignore-line-postgres-cockroach
 			default:
 				break LabelDefaultNotNull
 			}
@@ -327,6 +641,51 @@ func (p *Parser) parseColumn(tableName *Ident) (*Column, []Constraint, error) {
 	return column, constraints, nil
 }
 
+// parseColumnGenerated parses GENERATED ALWAYS AS (expr) STORED or GENERATED { ALWAYS | BY DEFAULT } AS IDENTITY.
+// The current token is STORED or IDENTITY after this.
+func (p *Parser) parseColumnGenerated(column *Column) error {
+	if err := p.checkPeekToken(TOKEN_ALWAYS, TOKEN_BY); err != nil {
+		return apperr.Errorf("checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = ALWAYS or BY
+	identity := p.currentToken.Literal.String()
+	if p.isCurrentToken(TOKEN_BY) {
+		if err := p.checkPeekToken(TOKEN_DEFAULT); err != nil {
+			return apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = DEFAULT
+		identity += " " + p.currentToken.Literal.String()
+	}
+	if err := p.checkPeekToken(TOKEN_AS); err != nil {
+		return apperr.Errorf("checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = AS
+
+	if p.isPeekToken(TOKEN_IDENTITY) {
+		p.nextToken() // current = IDENTITY
+		column.Identity = strings.ToUpper(identity)
+		return nil
+	}
+
+	if !strings.EqualFold(identity, "ALWAYS") {
+		return apperr.Errorf("currentToken=%#v, peekToken=%#v: expected=%v, but got=%v: %w", p.currentToken, p.peekToken, TOKEN_IDENTITY, p.peekToken.Type, ddl.ErrUnexpectedPeekToken)
+	}
+	if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil {
+		return apperr.Errorf("checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = (
+	idents, err := p.parseExpr()
+	if err != nil {
+		return apperr.Errorf("parseExpr: %w", err)
+	}
+	if err := p.checkCurrentToken(TOKEN_STORED); err != nil {
+		return apperr.Errorf("checkCurrentToken: %w", err)
+	}
+	column.Generated = column.Generated.Append(idents...)
+
+	return nil
+}
+
 //nolint:cyclop
 func (p *Parser) parseColumnDefault() (*Default, error) {
 	def := &Default{}
@@ -334,7 +693,8 @@ func (p *Parser) parseColumnDefault() (*Default, error) {
 LabelDefault:
 	for {
 		switch p.currentToken.Type { //nolint:exhaustive
-		case TOKEN_IDENT:
+		case TOKEN_IDENT,
+			TOKEN_OPEN_BRACKET, TOKEN_CLOSE_BRACKET: //diff:ignore-line-postgres-cockroach
 			def.Value = def.Value.Append(NewRawIdent(p.currentToken.Literal.String()))
 		case TOKEN_OPEN_PAREN:
 			ids, err := p.parseExpr()
@@ -343,7 +703,8 @@ LabelDefault:
 			}
 			def.Value = def.Value.Append(ids...)
 			continue
-		case TOKEN_NOT, TOKEN_COMMA, TOKEN_CLOSE_PAREN:
+		case TOKEN_NOT, TOKEN_COMMA, TOKEN_CLOSE_PAREN,
+			TOKEN_COLLATE, TOKEN_GENERATED: //diff:ignore-line-postgres-cockroach
 			break LabelDefault
 		default:
 			if isReservedValue(p.currentToken.Type) {
@@ -452,31 +813,14 @@ LabelConstraints:
 			if err != nil {
 				return nil, apperr.Errorf("parseColumnIdents: %w", err)
 			}
-			// TODO: support ON DELETE, ON UPDATE
-			//nolint:nestif
-			if p.isCurrentToken(TOKEN_ON) {
-				onAction := p.currentToken.Literal.String() // current = ON
-				p.nextToken()                               // current = DELETE or UPDATE
-				if err := p.checkCurrentToken(TOKEN_DELETE, TOKEN_UPDATE); err != nil {
-					return nil, apperr.Errorf("checkCurrentToken: %w", err)
-				}
-				onAction += " " + p.currentToken.Literal.String()
-				if err := p.checkPeekToken(TOKEN_CASCADE, TOKEN_NO); err != nil {
-					return nil, apperr.Errorf("checkPeekToken: %w", err)
-				}
-				p.nextToken()                                     // current = CASCADE or NO
-				onAction += " " + p.currentToken.Literal.String() // current = CASCADE or NO
-				if p.isCurrentToken(TOKEN_NO) {
-					if err := p.checkPeekToken(TOKEN_ACTION); err != nil {
-						return nil, apperr.Errorf("checkPeekToken: %w", err)
-					}
-					p.nextToken()                                     // current = ACTION
-					onAction += " " + p.currentToken.Literal.String() // current = ACTION
-				}
-				constraint.OnAction = onAction
-			}
+			onAction, err := p.parseForeignKeyAction() //diff:ignore-line-postgres-cockroach
+			if err != nil {                            //diff:ignore-line-postgres-cockroach
+				return nil, apperr.Errorf("parseForeignKeyAction: %w", err) //diff:ignore-line-postgres-cockroach
+			} //diff:ignore-line-postgres-cockroach
+			constraint.OnAction = onAction //diff:ignore-line-postgres-cockroach
 			constraint.RefColumns = idents
 			constraints = constraints.Append(constraint)
+			continue // MEMO: The current token is the next of the foreign key. //diff:ignore-line-postgres-cockroach
 		case TOKEN_UNIQUE:
 			constraints = constraints.Append(&UniqueConstraint{ //diff:ignore-line-postgres-cockroach
 				Name:    NewRawIdent(fmt.Sprintf("%s_unique_%s", tableName.StringForDiff(), column.Name.StringForDiff())),
@@ -568,25 +912,10 @@ func (p *Parser) parseTableConstraint(tableName *Ident) (Constraint, error) { //
 		if err != nil {
 			return nil, apperr.Errorf("parseColumnIdents: %w", err)
 		}
-		// TODO: support ON DELETE, ON UPDATE
-		var onAction string
-		if p.isCurrentToken(TOKEN_ON) {
-			onAction = p.currentToken.Literal.String() // current = ON
-			p.nextToken()                              // current = DELETE or UPDATE
-			if err := p.checkCurrentToken(TOKEN_DELETE, TOKEN_UPDATE); err != nil {
-				return nil, apperr.Errorf("checkCurrentToken: %w", err)
-			}
-			onAction += " " + p.currentToken.Literal.String()
-			if err := p.checkPeekToken(TOKEN_CASCADE, TOKEN_NO); err != nil {
-				return nil, apperr.Errorf("checkPeekToken: %w", err)
-			}
-			p.nextToken()                                     // current = CASCADE or NO
-			onAction += " " + p.currentToken.Literal.String() // current = CASCADE or NO
-			if p.isCurrentToken(TOKEN_NO) && p.isPeekToken(TOKEN_ACTION) {
-				p.nextToken()                                     // current = ACTION
-				onAction += " " + p.currentToken.Literal.String() // current = ACTION
-			}
-		}
+		onAction, err := p.parseForeignKeyAction() //diff:ignore-line-postgres-cockroach
+		if err != nil {                            //diff:ignore-line-postgres-cockroach
+			return nil, apperr.Errorf("parseForeignKeyAction: %w", err) //diff:ignore-line-postgres-cockroach
+		} //diff:ignore-line-postgres-cockroach
 		if constraintName == nil {
 			name := tableName.StringForDiff()
 			for _, ident := range idents {
@@ -623,6 +952,21 @@ func (p *Parser) parseTableConstraint(tableName *Ident) (Constraint, error) { //
 		c.Name = constraintName
 		c.Columns = idents
 		return c, nil
+	case TOKEN_CHECK: //diff:ignore-line-postgres-cockroach
+		if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil { //diff:ignore-line-postgres-cockroach
+			return nil, apperr.Errorf("checkPeekToken: %w", err) //diff:ignore-line-postgres-cockroach
+		} //diff:ignore-line-postgres-cockroach
+		p.nextToken()                // current = ( //diff:ignore-line-postgres-cockroach
+		idents, err := p.parseExpr() //diff:ignore-line-postgres-cockroach
+		if err != nil {              //diff:ignore-line-postgres-cockroach
+			return nil, apperr.Errorf("parseExpr: %w", err) //diff:ignore-line-postgres-cockroach
+		} //diff:ignore-line-postgres-cockroach
+		if constraintName == nil { //diff:ignore-line-postgres-cockroach
+			constraintName = NewRawIdent(tableName.StringForDiff() + "_check") //diff:ignore-line-postgres-cockroach
+		} //diff:ignore-line-postgres-cockroach
+		c := &CheckConstraint{Name: constraintName} //diff:ignore-line-postgres-cockroach
+		c.Expr = c.Expr.Append(idents...)           //diff:ignore-line-postgres-cockroach
+		return c, nil                               //diff:ignore-line-postgres-cockroach
 	default:
 		return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
 	}
@@ -638,8 +982,18 @@ func (p *Parser) parseDataType() (*DataType, error) {
 		dataType.Type = TOKEN_TIMESTAMP_WITH_TIME_ZONE  //diff:ignore-line-postgres-cockroach
 	case TOKEN_TIMESTAMP:
 		dataType.Name = p.currentToken.Literal.String()
-		if p.isPeekToken(TOKEN_WITH) {
-			p.nextToken() // current = WITH
+		dataType.Type = TOKEN_TIMESTAMP
+		if p.isPeekToken(TOKEN_OPEN_PAREN) { // MEMO: such as timestamp(3) with time zone //diff:ignore-line-postgres-cockroach
+			p.nextToken()                  // current = ( //diff:ignore-line-postgres-cockroach
+			idents, err := p.parseIdents() //diff:ignore-line-postgres-cockroach
+			if err != nil {                //diff:ignore-line-postgres-cockroach
+				return nil, apperr.Errorf("parseIdents: %w", err) //diff:ignore-line-postgres-cockroach
+			} //diff:ignore-line-postgres-cockroach
+			dataType.Expr = dataType.Expr.Append(idents...) //diff:ignore-line-postgres-cockroach
+		} //diff:ignore-line-postgres-cockroach
+		if p.isPeekToken(TOKEN_WITH, TOKEN_WITHOUT) { //diff:ignore-line-postgres-cockroach
+			p.nextToken()                                // current = WITH or WITHOUT //diff:ignore-line-postgres-cockroach
+			withTimeZone := p.isCurrentToken(TOKEN_WITH) //diff:ignore-line-postgres-cockroach
 			dataType.Name += " " + p.currentToken.Literal.String()
 			if err := p.checkPeekToken(TOKEN_TIME); err != nil {
 				return nil, apperr.Errorf("checkPeekToken: %w", err)
@@ -651,9 +1005,9 @@ func (p *Parser) parseDataType() (*DataType, error) {
 			}
 			p.nextToken() // current = ZONE
 			dataType.Name += " " + p.currentToken.Literal.String()
-			dataType.Type = TOKEN_TIMESTAMP_WITH_TIME_ZONE //diff:ignore-line-postgres-cockroach
-		} else {
-			dataType.Type = TOKEN_TIMESTAMP
+			if withTimeZone { //diff:ignore-line-postgres-cockroach
+				dataType.Type = TOKEN_TIMESTAMP_WITH_TIME_ZONE //diff:ignore-line-postgres-cockroach
+			} //diff:ignore-line-postgres-cockroach
 		}
 	case TOKEN_DOUBLE:
 		dataType.Name = p.currentToken.Literal.String()
@@ -665,12 +1019,12 @@ func (p *Parser) parseDataType() (*DataType, error) {
 		dataType.Type = TOKEN_DOUBLE_PRECISION
 	case TOKEN_CHARACTER:
 		dataType.Name = p.currentToken.Literal.String()
-		if err := p.checkPeekToken(TOKEN_VARYING); err != nil {
-			return nil, apperr.Errorf("checkPeekToken: %w", err)
-		}
-		p.nextToken() // current = VARYING
-		dataType.Name += " " + p.currentToken.Literal.String()
-		dataType.Type = TOKEN_CHARACTER_VARYING //diff:ignore-line-postgres-cockroach
+		dataType.Type = TOKEN_CHARACTER   //diff:ignore-line-postgres-cockroach
+		if p.isPeekToken(TOKEN_VARYING) { //diff:ignore-line-postgres-cockroach
+			p.nextToken() // current = VARYING
+			dataType.Name += " " + p.currentToken.Literal.String()
+			dataType.Type = TOKEN_CHARACTER_VARYING //diff:ignore-line-postgres-cockroach
+		} //diff:ignore-line-postgres-cockroach
 	default:
 		dataType.Name = p.currentToken.Literal.String()
 		dataType.Type = p.currentToken.Type
@@ -685,9 +1039,82 @@ func (p *Parser) parseDataType() (*DataType, error) {
 		dataType.Expr = dataType.Expr.Append(idents...)
 	}
 
+	for p.isPeekToken(TOKEN_OPEN_BRACKET) { //diff:ignore-line-postgres-cockroach
+		p.nextToken()                   // current = [ //diff:ignore-line-postgres-cockroach
+		if p.isPeekToken(TOKEN_IDENT) { //diff:ignore-line-postgres-cockroach
+			p.nextToken() // current = array_size //diff:ignore-line-postgres-cockroach
+		} //diff:ignore-line-postgres-cockroach
+		if err := p.checkPeekToken(TOKEN_CLOSE_BRACKET); err != nil { //diff:ignore-line-postgres-cockroach
+			return nil, apperr.Errorf("checkPeekToken: %w", err) //diff:ignore-line-postgres-cockroach
+		} //diff:ignore-line-postgres-cockroach
+		p.nextToken()         // current = ] //diff:ignore-line-postgres-cockroach
+		dataType.Array = true //diff:ignore-line-postgres-cockroach
+	} //diff:ignore-line-postgres-cockroach
+
 	return dataType, nil
 }
 
+// parseForeignKeyAction parses the actions of the foreign key such as ON DELETE CASCADE, MATCH FULL and DEFERRABLE INITIALLY DEFERRED.
+// The current token is the next of the actions after this.
+//
+//nolint:cyclop
+func (p *Parser) parseForeignKeyAction() (string, error) {
+	actions := make([]string, 0)
+
+	for {
+		switch {
+		case p.isCurrentToken(TOKEN_ON):
+			action := p.currentToken.Literal.String()
+			p.nextToken() // current = DELETE or UPDATE
+			if err := p.checkCurrentToken(TOKEN_DELETE, TOKEN_UPDATE); err != nil {
+				return "", apperr.Errorf("checkCurrentToken: %w", err)
+			}
+			action += " " + p.currentToken.Literal.String()
+			if err := p.checkPeekToken(TOKEN_CASCADE, TOKEN_RESTRICT, TOKEN_NO, TOKEN_SET); err != nil {
+				return "", apperr.Errorf("checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = CASCADE or RESTRICT or NO or SET
+			action += " " + p.currentToken.Literal.String()
+			switch p.currentToken.Type { //nolint:exhaustive
+			case TOKEN_NO:
+				if err := p.checkPeekToken(TOKEN_ACTION); err != nil {
+					return "", apperr.Errorf("checkPeekToken: %w", err)
+				}
+				p.nextToken() // current = ACTION
+				action += " " + p.currentToken.Literal.String()
+			case TOKEN_SET:
+				if err := p.checkPeekToken(TOKEN_NULL, TOKEN_DEFAULT); err != nil {
+					return "", apperr.Errorf("checkPeekToken: %w", err)
+				}
+				p.nextToken() // current = NULL or DEFAULT
+				action += " " + p.currentToken.Literal.String()
+			}
+			actions = append(actions, action)
+		case p.isCurrentToken(TOKEN_MATCH):
+			if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+				return "", apperr.Errorf("checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = FULL or PARTIAL or SIMPLE
+			actions = append(actions, "MATCH "+p.currentToken.Literal.String())
+		case p.isCurrentToken(TOKEN_DEFERRABLE):
+			actions = append(actions, p.currentToken.Literal.String())
+		case p.isCurrentToken(TOKEN_NOT) && p.isPeekToken(TOKEN_DEFERRABLE):
+			p.nextToken() // current = DEFERRABLE
+			actions = append(actions, "NOT "+p.currentToken.Literal.String())
+		case p.isCurrentToken(TOKEN_INITIALLY):
+			if err := p.checkPeekToken(TOKEN_DEFERRED, TOKEN_IMMEDIATE); err != nil {
+				return "", apperr.Errorf("checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = DEFERRED or IMMEDIATE
+			actions = append(actions, "INITIALLY "+p.currentToken.Literal.String())
+		default:
+			return strings.Join(actions, " "), nil
+		}
+
+		p.nextToken()
+	}
+}
+
 func (p *Parser) parseColumnIdents() ([]*ColumnIdent, error) {
 	idents := make([]*ColumnIdent, 0)
 
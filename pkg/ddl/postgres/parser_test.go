@@ -55,6 +55,47 @@ CREATE TABLE public.users (
 		t.Logf("ℹ️: %s: stmt: %%s: \n%s", t.Name(), actual)
 	})
 
+	t.Run("success,CREATE_TABLE_pg_catalog", func(t *testing.T) {
+		t.Parallel()
+
+		// MEMO: The output of ShowCreateAllTables built on pg_catalog.
+		input := `CREATE TABLE public.orders (
+    id bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    code text COLLATE "C" NOT NULL,
+    tags character varying(20)[] DEFAULT '{}'::character varying[],
+    price numeric(10,2) NOT NULL,
+    total numeric(12,2) GENERATED ALWAYS AS ((price * (2)::numeric)) STORED,
+    ordered_at timestamp(3) with time zone NOT NULL,
+    user_id bigint,
+    CONSTRAINT orders_pkey PRIMARY KEY (id),
+    CONSTRAINT orders_price_check CHECK ((price > (0)::numeric)),
+    CONSTRAINT orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) MATCH FULL ON UPDATE CASCADE ON DELETE SET NULL DEFERRABLE INITIALLY DEFERRED
+);
+`
+		expected := `CREATE TABLE public.orders (
+    id bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    code text COLLATE "C" NOT NULL,
+    tags character varying(20)[] DEFAULT '{}'::character varying[],
+    price numeric(10, 2) NOT NULL,
+    total numeric(12, 2) GENERATED ALWAYS AS ((price *(2)::numeric)) STORED,
+    ordered_at timestamp(3) with time zone NOT NULL,
+    user_id bigint,
+    CONSTRAINT orders_pkey PRIMARY KEY (id),
+    CONSTRAINT orders_price_check CHECK ((price >(0)::numeric)),
+    CONSTRAINT orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users (id) MATCH FULL ON UPDATE CASCADE ON DELETE SET NULL DEFERRABLE INITIALLY DEFERRED
+);
+`
+
+		l := NewLexer(input)
+		p := NewParser(l)
+		actual, err := p.Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
 	t.Run("success,complex_defaults", func(t *testing.T) {
 		t.Parallel()
 
@@ -97,6 +138,74 @@ CREATE TABLE IF NOT EXISTS complex_defaults (
 		t.Logf("ℹ️: %s: stmt: %%s: \n%s", t.Name(), actual)
 	})
 
+	t.Run("success,CREATE_VIEW", func(t *testing.T) {
+		t.Parallel()
+
+		input := `CREATE VIEW public.active_users AS SELECT id, "name" FROM public.users WHERE (active = true AND age >= 20);
+CREATE OR REPLACE VIEW user_names (id, name) AS
+ SELECT users.id,
+    users.name::text
+   FROM users;
+CREATE MATERIALIZED VIEW IF NOT EXISTS user_counts AS SELECT group_id, count(*) AS cnt FROM users WHERE name != 'a;b' GROUP BY group_id;
+`
+		expected := `CREATE VIEW public.active_users AS SELECT id, "name" FROM public.users WHERE (active = true AND age >= 20);
+CREATE OR REPLACE VIEW user_names (id, name) AS SELECT users.id, users.name::text FROM users;
+CREATE MATERIALIZED VIEW IF NOT EXISTS user_counts AS SELECT group_id, count (*) AS cnt FROM users WHERE name != 'a;b' GROUP BY group_id;
+`
+
+		l := NewLexer(input)
+		p := NewParser(l)
+		actual, err := p.Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+
+		t.Logf("ℹ️: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		t.Logf("ℹ️: %s: stmt: %%s: \n%s", t.Name(), actual)
+	})
+
+	t.Run("success,CREATE_TYPE", func(t *testing.T) {
+		t.Parallel()
+
+		input := `CREATE TYPE public.user_status AS ENUM ('active', 'inactive', 'banned');
+CREATE TABLE public.users (id UUID NOT NULL, status user_status NOT NULL DEFAULT 'active'::user_status, PRIMARY KEY (id));
+`
+		expected := `CREATE TYPE public.user_status AS ENUM ('active', 'inactive', 'banned');
+CREATE TABLE public.users (
+    id UUID NOT NULL,
+    status user_status DEFAULT 'active'::user_status NOT NULL,
+    CONSTRAINT users_pkey PRIMARY KEY (id)
+);
+`
+
+		actual, err := NewParser(NewLexer(input)).Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,CREATE_SEQUENCE", func(t *testing.T) {
+		t.Parallel()
+
+		input := `CREATE SEQUENCE IF NOT EXISTS public.users_id_seq AS integer INCREMENT BY -1 MINVALUE -100 NO MAXVALUE START WITH -1 CACHE 10 NO CYCLE;
+CREATE SEQUENCE public.orders_id_seq CYCLE
+`
+		expected := `CREATE SEQUENCE IF NOT EXISTS public.users_id_seq AS integer INCREMENT -1 MINVALUE -100 NO MAXVALUE START -1 CACHE 10 NO CYCLE;
+CREATE SEQUENCE public.orders_id_seq CYCLE;
+`
+
+		actual, err := NewParser(NewLexer(input)).Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
 	failureTests := []struct {
 		name    string
 		input   string
@@ -290,7 +399,7 @@ CREATE TABLE IF NOT EXISTS complex_defaults (
 		{
 			name:    "failure,CREATE_TABLE_table_name_column_name_CONSTRAINT_FOREIGN_KEY_IDENTS_REFERENCES_ON_DELETE_NO_INVALID",
 			input:   `CREATE TABLE "users" ("id" UUID, FOREIGN KEY ("group_id") REFERENCES "groups" ("id") ON DELETE NO`,
-			wantErr: ddl.ErrUnexpectedCurrentToken,
+			wantErr: ddl.ErrUnexpectedPeekToken,
 		},
 		{
 			name:    "failure,CREATE_TABLE_table_name_column_name_CONSTRAINT_FOREIGN_KEY_IDENTS_REFERENCES_ON_DELETE_NO_INVALID",
@@ -367,6 +476,116 @@ CREATE TABLE IF NOT EXISTS complex_defaults (
 			input:   `CREATE INDEX users_idx_username ON users USING btree (NOT)`,
 			wantErr: ddl.ErrUnexpectedCurrentToken,
 		},
+		{
+			name:    "failure,CREATE_OR_INVALID",
+			input:   `CREATE OR NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_MATERIALIZED_INVALID",
+			input:   `CREATE MATERIALIZED TABLE`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_IF_INVALID",
+			input:   `CREATE VIEW IF;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_IF_NOT_INVALID",
+			input:   `CREATE VIEW IF NOT;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_INVALID",
+			input:   `CREATE VIEW;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_view_name_INVALID",
+			input:   `CREATE VIEW users_view SELECT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_view_name_OPEN_PAREN_INVALID",
+			input:   `CREATE VIEW users_view (NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_view_name_AS_INVALID",
+			input:   `CREATE VIEW users_view AS;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_view_name_AS_OPEN_PAREN_INVALID",
+			input:   `CREATE VIEW users_view AS (SELECT 1;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TYPE_INVALID",
+			input:   `CREATE TYPE NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TYPE_type_name_INVALID",
+			input:   `CREATE TYPE user_status NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TYPE_type_name_AS_INVALID",
+			input:   `CREATE TYPE user_status AS RANGE`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TYPE_type_name_AS_ENUM_INVALID",
+			input:   `CREATE TYPE user_status AS ENUM 'active'`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TYPE_type_name_AS_ENUM_OPEN_PAREN_INVALID",
+			input:   `CREATE TYPE user_status AS ENUM ('active'`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_INVALID",
+			input:   `CREATE SEQUENCE NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_IF_INVALID",
+			input:   `CREATE SEQUENCE IF;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_IF_NOT_INVALID",
+			input:   `CREATE SEQUENCE IF NOT;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_INVALID",
+			input:   `CREATE SEQUENCE users_id_seq NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_UNKNOWN_OPTION",
+			input:   `CREATE SEQUENCE users_id_seq OWNED BY users.id`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_NO_INVALID",
+			input:   `CREATE SEQUENCE users_id_seq NO CACHE`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_AS_INVALID",
+			input:   `CREATE SEQUENCE users_id_seq AS`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_INCREMENT_INVALID",
+			input:   `CREATE SEQUENCE users_id_seq INCREMENT BY;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
 	}
 
 	for _, tt := range failureTests {
@@ -489,10 +708,56 @@ func TestParser_parseDataType(t *testing.T) {
 		require.ErrorIs(t, err, ddl.ErrUnexpectedCurrentToken)
 	})
 
-	t.Run("failure,CHARACTER_NOT", func(t *testing.T) {
+	t.Run("success,CHARACTER", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewParser(NewLexer(`CHARACTER(10) NOT`))
+		p.nextToken()
+		p.nextToken()
+		dataType, err := p.parseDataType()
+		require.NoError(t, err)
+		require.Equal(t, "CHARACTER(10)", dataType.StringForDiff())
+	})
+
+	t.Run("success,TIMESTAMP_precision_WITH_TIME_ZONE", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewParser(NewLexer(`timestamp(3) with time zone`))
+		p.nextToken()
+		p.nextToken()
+		dataType, err := p.parseDataType()
+		require.NoError(t, err)
+		require.Equal(t, "timestamp(3) with time zone", dataType.String())
+		require.Equal(t, "TIMESTAMP WITH TIME ZONE(3)", dataType.StringForDiff())
+	})
+
+	t.Run("success,TIMESTAMP_WITHOUT_TIME_ZONE", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewParser(NewLexer(`timestamp without time zone`))
+		p.nextToken()
+		p.nextToken()
+		dataType, err := p.parseDataType()
+		require.NoError(t, err)
+		require.Equal(t, "TIMESTAMP", dataType.StringForDiff())
+	})
+
+	t.Run("success,ARRAY", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewParser(NewLexer(`character varying(255)[3]`))
+		p.nextToken()
+		p.nextToken()
+		dataType, err := p.parseDataType()
+		require.NoError(t, err)
+		require.Equal(t, "character varying(255)[]", dataType.String())
+		require.Equal(t, "CHARACTER VARYING(255)[]", dataType.StringForDiff())
+	})
+
+	t.Run("failure,ARRAY_NOT", func(t *testing.T) {
 		t.Parallel()
 
-		p := NewParser(NewLexer(`CHARACTER NOT`))
+		p := NewParser(NewLexer(`INTEGER[NOT`))
 		p.nextToken()
 		p.nextToken()
 		_, err := p.parseDataType()
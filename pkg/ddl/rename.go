@@ -0,0 +1,114 @@
+package ddl
+
+import (
+	"strings"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+)
+
+// RenameHintPrefix is the prefix of the comment that declares a rename hint in DDL, such as:
+//
+//	-- ddlctl:rename users.name=users.full_name
+const RenameHintPrefix = "ddlctl:rename "
+
+// Rename is a hint that the table or column named From in the before DDL is renamed to To in the after DDL.
+//
+// A column is qualified by its table name, such as "users.name".
+type Rename struct {
+	From string
+	To   string
+}
+
+type Renames []*Rename
+
+// ParseRenames parses the rename hints in the form of "old=new" separated by commas or new lines.
+// Empty lines and lines starting with "#" are ignored.
+func ParseRenames(s string) (Renames, error) {
+	renames := make(Renames, 0)
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, hint := range strings.Split(line, ",") {
+			hint = strings.TrimSpace(hint)
+			if hint == "" {
+				continue
+			}
+			from, to, ok := strings.Cut(hint, "=")
+			from, to = strings.TrimSpace(from), strings.TrimSpace(to)
+			if !ok || from == "" || to == "" {
+				return nil, apperr.Errorf("hint=%q: %w", hint, ErrInvalidRenameHint)
+			}
+			renames = append(renames, &Rename{From: from, To: to})
+		}
+	}
+	return renames, nil
+}
+
+// ExtractRenames extracts the rename hints declared by comments in DDL, such as:
+//
+//	-- ddlctl:rename users.name=users.full_name
+func ExtractRenames(ddl string) (Renames, error) {
+	renames := make(Renames, 0)
+	for _, line := range strings.Split(ddl, "\n") {
+		comment, ok := strings.CutPrefix(strings.TrimSpace(line), "--")
+		if !ok {
+			continue
+		}
+		hint, ok := strings.CutPrefix(strings.TrimSpace(comment), RenameHintPrefix)
+		if !ok {
+			continue
+		}
+		r, err := ParseRenames(hint)
+		if err != nil {
+			return nil, apperr.Errorf("ParseRenames: %w", err)
+		}
+		renames = append(renames, r...)
+	}
+	return renames, nil
+}
+
+// IsRenamed reports whether the hints declare that the object named from is renamed to the object named to.
+//
+// from and to are the names split into the qualifiers, such as []string{"public", "users", "name"}.
+// A hint matches if its names are the suffixes of them, so "users.name=users.full_name" matches
+// both "users.name" and "public.users.name".
+func (r Renames) IsRenamed(from, to []string) bool {
+	for _, rename := range r {
+		if hasNameSuffix(from, splitName(rename.From)) && hasNameSuffix(to, splitName(rename.To)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Reverse returns the hints from the after DDL to the before DDL, which are used to diff the down migration.
+func (r Renames) Reverse() Renames {
+	reversed := make(Renames, 0, len(r))
+	for _, rename := range r {
+		reversed = append(reversed, &Rename{From: rename.To, To: rename.From})
+	}
+	return reversed
+}
+
+func splitName(name string) []string {
+	names := strings.Split(name, ".")
+	for i := range names {
+		names[i] = strings.Trim(names[i], "\"`")
+	}
+	return names
+}
+
+func hasNameSuffix(name, suffix []string) bool {
+	if len(suffix) > len(name) {
+		return false
+	}
+	offset := len(name) - len(suffix)
+	for i := range suffix {
+		if name[offset+i] != suffix[i] {
+			return false
+		}
+	}
+	return true
+}
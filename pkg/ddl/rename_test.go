@@ -0,0 +1,66 @@
+package ddl
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestParseRenames(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := ParseRenames("# comment\nold_users=users, users.name = users.full_name\n\n")
+		require.NoError(t, err)
+		assert.Equal(t, Renames{{From: "old_users", To: "users"}, {From: "users.name", To: "users.full_name"}}, actual)
+	})
+
+	t.Run("failure,ErrInvalidRenameHint", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseRenames("users.name")
+		require.ErrorIs(t, err, ErrInvalidRenameHint)
+	})
+}
+
+func TestExtractRenames(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := ExtractRenames("CREATE TABLE users (\n    -- ddlctl:rename users.name=users.full_name\n    full_name TEXT\n);\n")
+		require.NoError(t, err)
+		assert.Equal(t, Renames{{From: "users.name", To: "users.full_name"}}, actual)
+	})
+
+	t.Run("failure,ErrInvalidRenameHint", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ExtractRenames("-- ddlctl:rename users.name\n")
+		require.ErrorIs(t, err, ErrInvalidRenameHint)
+	})
+}
+
+func TestRenames_IsRenamed(t *testing.T) {
+	t.Parallel()
+
+	renames := Renames{{From: `"users".name`, To: "users.full_name"}}
+	assert.True(t, renames.IsRenamed([]string{"public", "users", "name"}, []string{"public", "users", "full_name"}))
+	assert.False(t, renames.IsRenamed([]string{"public", "groups", "name"}, []string{"public", "groups", "full_name"}))
+	assert.False(t, renames.IsRenamed([]string{"name"}, []string{"full_name"}))
+}
+
+func TestRenames_Reverse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		actual := Renames{{From: "old_users", To: "users"}, {From: "users.name", To: "users.full_name"}}.Reverse()
+		assert.Equal(t, Renames{{From: "users", To: "old_users"}, {From: "users.full_name", To: "users.name"}}, actual)
+	})
+}
@@ -0,0 +1,38 @@
+package spanner
+
+import (
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// ClassifyStmt returns the class of the impact of stmt on the running database.
+//
+// MEMO: Spanner applies the schema updates without blocking reads and writes, so no statement is regarded as locking.
+func ClassifyStmt(stmt Stmt) ddl.ChangeClass {
+	switch s := stmt.(type) {
+	case *DropTableStmt, *DropSequenceStmt:
+		return ddl.ChangeClassDataLoss
+	case *DropChangeStreamStmt:
+		return ddl.ChangeClassDataLoss // MEMO: The change records that are not read yet are deleted.
+	case *AlterTableStmt:
+		return classifyAlterTableAction(s.Action)
+	default:
+		return ddl.ChangeClassSafe
+	}
+}
+
+func classifyAlterTableAction(action AlterTableAction) ddl.ChangeClass {
+	switch a := action.(type) {
+	case *DropColumn:
+		return ddl.ChangeClassDataLoss
+	case *AddRowDeletionPolicy, *ReplaceRowDeletionPolicy:
+		return ddl.ChangeClassDataLoss // MEMO: The rows older than the policy are deleted.
+	case *AlterColumnDataType:
+		if a.OldDataType != nil && internal.IsNarrowingDataType(a.OldDataType.StringForDiff(), a.DataType.StringForDiff()) {
+			return ddl.ChangeClassDataLoss
+		}
+		return ddl.ChangeClassSafe
+	default:
+		return ddl.ChangeClassSafe
+	}
+}
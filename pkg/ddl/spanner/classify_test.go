@@ -0,0 +1,50 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestClassifyStmt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE Groups (Id STRING(36) NOT NULL) PRIMARY KEY (Id);
+CREATE TABLE Users (Id STRING(36) NOT NULL, Name STRING(20) NOT NULL, Memo STRING(MAX), Note STRING(10)) PRIMARY KEY (Id);`)).Parse()
+		require.NoError(t, err)
+		after, err := NewParser(NewLexer(`CREATE TABLE Users (Id STRING(36) NOT NULL, Name STRING(10) NOT NULL, Note STRING(MAX)) PRIMARY KEY (Id);
+CREATE INDEX UsersIdxName ON Users (Name);`)).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+
+		expected := []ddl.ChangeClass{
+			ddl.ChangeClassDataLoss, // DROP TABLE
+			ddl.ChangeClassSafe,     // CREATE INDEX
+			ddl.ChangeClassDataLoss, // ALTER COLUMN STRING(10)
+			ddl.ChangeClassDataLoss, // DROP COLUMN
+			ddl.ChangeClassSafe,     // ALTER COLUMN STRING(MAX)
+		}
+		classes := make([]ddl.ChangeClass, 0, len(actual.Stmts))
+		for _, stmt := range actual.Stmts {
+			classes = append(classes, ClassifyStmt(stmt))
+		}
+		if !assert.Equal(t, expected, classes) {
+			t.Logf("❌: %s", actual)
+		}
+	})
+
+	t.Run("success,DropChangeStream", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, ddl.ChangeClassDataLoss, ClassifyStmt(&DropChangeStreamStmt{Name: NewObjectName("SingerStream")}))
+		assert.Equal(t, ddl.ChangeClassSafe, ClassifyStmt(&DropViewStmt{Name: NewObjectName("SingerNames")}))
+	})
+}
@@ -1,6 +1,9 @@
 package spanner
 
 import (
+	"sort"
+	"strings"
+
 	stringz "github.com/kunitsucom/util.go/strings"
 
 	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
@@ -110,20 +113,57 @@ func (i *ColumnIdent) StringForDiff() string {
 	return str
 }
 
+// DataType represents the data type of a column.
+//
+// MEMO: The nested data types are represented structurally, such as
+// Elem of ARRAY<STRING(MAX)>, Fields of STRUCT<a INT64>, and TypeName of PROTO<my.package.Message>.
 type DataType struct {
-	Name string
-	Type TokenType
-	Expr *Expr
+	Name     string // e.g. STRING, ARRAY, PROTO, ENUM, or "" for the proto message or enum specified by its name only
+	Type     TokenType
+	Expr     *Expr          // e.g. (MAX) of STRING(MAX)
+	Elem     *DataType      // e.g. STRING(MAX) of ARRAY<STRING(MAX)>
+	Fields   []*StructField // e.g. a INT64 of STRUCT<a INT64>
+	TypeName *Ident         // e.g. my.package.Message of PROTO<my.package.Message> or my.package.Message
+	Args     []*TypeArg     // e.g. vector_length=>768 of ARRAY<FLOAT32>(vector_length=>768)
 }
 
 func (s *DataType) String() string {
 	if s == nil {
 		return ""
 	}
-	str := s.Name
+	var str string
+	switch {
+	case s.TypeName != nil && s.Name == "":
+		str = s.TypeName.String()
+	case s.TypeName != nil:
+		str = s.Name + "<" + s.TypeName.String() + ">"
+	case s.Type == TOKEN_ARRAY:
+		str = s.Name + "<" + s.Elem.String() + ">"
+	case s.Type == TOKEN_STRUCT:
+		str = s.Name + "<"
+		for i, f := range s.Fields {
+			if i != 0 {
+				str += ", "
+			}
+			str += f.String()
+		}
+		str += ">"
+	default:
+		str = s.Name
+	}
 	if s.Expr != nil && len(s.Expr.Idents) > 0 {
 		str += "(" + s.Expr.String() + ")"
 	}
+	if len(s.Args) > 0 {
+		str += "("
+		for i, a := range s.Args {
+			if i != 0 {
+				str += ", "
+			}
+			str += a.String()
+		}
+		str += ")"
+	}
 	return str
 }
 
@@ -132,9 +172,24 @@ func (s *DataType) StringForDiff() string {
 		return ""
 	}
 	var str string
-	if s.Type != "" {
+	switch {
+	case s.TypeName != nil:
+		// MEMO: my.package.Message and PROTO<my.package.Message> are the same data type.
+		str += s.TypeName.StringForDiff()
+	case s.Type == TOKEN_ARRAY:
+		str += string(s.Type) + "<" + s.Elem.StringForDiff() + ">"
+	case s.Type == TOKEN_STRUCT:
+		str += string(s.Type) + "<"
+		for i, f := range s.Fields {
+			if i != 0 {
+				str += ","
+			}
+			str += f.StringForDiff()
+		}
+		str += ">"
+	case s.Type != "":
 		str += string(s.Type)
-	} else {
+	default:
 		str += string(TOKEN_ILLEGAL)
 	}
 
@@ -146,5 +201,116 @@ func (s *DataType) StringForDiff() string {
 		str += ")"
 	}
 
+	if len(s.Args) > 0 {
+		str += "("
+		for i, a := range s.Args {
+			if i != 0 {
+				str += ","
+			}
+			str += a.StringForDiff()
+		}
+		str += ")"
+	}
+
+	return str
+}
+
+// StructField represents a field of STRUCT<field_name data_type, ...>.
+type StructField struct {
+	Name     *Ident // MEMO: nil if the field is unnamed.
+	DataType *DataType
+}
+
+func (f *StructField) String() string {
+	if f.Name == nil {
+		return f.DataType.String()
+	}
+	return f.Name.String() + " " + f.DataType.String()
+}
+
+func (f *StructField) StringForDiff() string {
+	if f.Name == nil {
+		return f.DataType.StringForDiff()
+	}
+	return f.Name.StringForDiff() + " " + f.DataType.StringForDiff()
+}
+
+// TypeArg represents a named argument of the data type such as vector_length=>768.
+type TypeArg struct {
+	Name  *Ident
+	Value *Ident
+}
+
+func (a *TypeArg) String() string {
+	return a.Name.String() + "=>" + a.Value.String()
+}
+
+func (a *TypeArg) StringForDiff() string {
+	return strings.ToLower(a.Name.StringForDiff()) + "=>" + a.Value.StringForDiff()
+}
+
+type optionForDiff struct {
+	Name  string
+	Value string
+}
+
+// optionsForDiff returns the options such as (sequence_kind = 'bit_reversed_positive') as name-value pairs sorted by name.
+func optionsForDiff(options *Expr) []*optionForDiff {
+	if options == nil {
+		return nil
+	}
+
+	result := make([]*optionForDiff, 0)
+	const nameAndEqual = 2 // name = value
+	var current []string
+	flush := func() {
+		if len(current) > 0 {
+			o := &optionForDiff{Name: strings.ToLower(current[0])}
+			if len(current) > nameAndEqual {
+				o.Value = strings.Join(current[nameAndEqual:], " ")
+			}
+			result = append(result, o)
+		}
+		current = nil
+	}
+
+	depth := 0
+	for _, ident := range options.Idents {
+		switch ident.Raw {
+		case "(":
+			depth++
+			if depth == 1 {
+				continue
+			}
+		case ")":
+			depth--
+			if depth == 0 {
+				continue
+			}
+		case ",":
+			if depth == 1 {
+				flush()
+				continue
+			}
+		}
+		current = append(current, ident.StringForDiff())
+	}
+	flush()
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+
+	return result
+}
+
+// optionsStringForDiff returns the options such as OPTIONS (name = value, ...) sorted by name.
+func optionsStringForDiff(options *Expr) string {
+	str := "OPTIONS ("
+	for i, o := range optionsForDiff(options) {
+		if i != 0 {
+			str += ", "
+		}
+		str += o.Name + " = " + o.Value
+	}
+	str += ")"
 	return str
 }
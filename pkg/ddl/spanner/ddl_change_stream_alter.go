@@ -0,0 +1,80 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#alter-change-stream
+
+var _ Stmt = (*AlterChangeStreamStmt)(nil)
+
+type AlterChangeStreamStmt struct {
+	Comment string
+	Name    *ObjectName
+	Action  AlterChangeStreamAction
+}
+
+func (*AlterChangeStreamStmt) isStmt() {}
+
+func (s *AlterChangeStreamStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *AlterChangeStreamStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "ALTER CHANGE STREAM "
+	str += s.Name.String() + " "
+	switch a := s.Action.(type) {
+	case *SetChangeStreamFor:
+		str += "SET " + changeStreamForString(a.ForAll, a.For)
+	case *DropChangeStreamForAll:
+		str += "DROP FOR ALL"
+	case *SetChangeStreamOptions:
+		str += "SET OPTIONS " + a.Options.String()
+	}
+
+	return str + ";\n"
+}
+
+func (s *AlterChangeStreamStmt) GoString() string { return internal.GoString(*s) }
+
+type AlterChangeStreamAction interface {
+	isAlterChangeStreamAction()
+	GoString() string
+}
+
+// SetChangeStreamFor represents ALTER CHANGE STREAM change_stream_name SET FOR ....
+type SetChangeStreamFor struct {
+	ForAll bool
+	For    []*ChangeStreamFor
+}
+
+func (*SetChangeStreamFor) isAlterChangeStreamAction() {}
+
+func (s *SetChangeStreamFor) GoString() string { return internal.GoString(*s) }
+
+// DropChangeStreamForAll represents ALTER CHANGE STREAM change_stream_name DROP FOR ALL.
+type DropChangeStreamForAll struct{}
+
+func (*DropChangeStreamForAll) isAlterChangeStreamAction() {}
+
+func (s *DropChangeStreamForAll) GoString() string { return internal.GoString(*s) }
+
+// SetChangeStreamOptions represents ALTER CHANGE STREAM change_stream_name SET OPTIONS (...).
+type SetChangeStreamOptions struct {
+	Options *Expr
+}
+
+func (*SetChangeStreamOptions) isAlterChangeStreamAction() {}
+
+func (s *SetChangeStreamOptions) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,67 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestAlterChangeStreamStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterChangeStreamStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestAlterChangeStreamStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,SetChangeStreamFor", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterChangeStreamStmt{
+			Comment: "test comment content",
+			Name:    NewObjectName("SingerStream"),
+			Action:  &SetChangeStreamFor{For: []*ChangeStreamFor{{Table: NewRawIdent("Singers"), AllColumns: true}}},
+		}
+		expected := "-- test comment content\nALTER CHANGE STREAM SingerStream SET FOR Singers;\n"
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+
+	t.Run("success,DropChangeStreamForAll", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterChangeStreamStmt{
+			Name:   NewObjectName("SingerStream"),
+			Action: &DropChangeStreamForAll{},
+		}
+		expected := "ALTER CHANGE STREAM SingerStream DROP FOR ALL;\n"
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("success,SetChangeStreamOptions", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterChangeStreamStmt{
+			Name:   NewObjectName("SingerStream"),
+			Action: &SetChangeStreamOptions{Options: &Expr{Idents: []*Ident{NewRawIdent("("), NewRawIdent("retention_period"), NewRawIdent("="), NewRawIdent("NULL"), NewRawIdent(")")}}},
+		}
+		expected := "ALTER CHANGE STREAM SingerStream SET OPTIONS (retention_period = NULL);\n"
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+	})
+}
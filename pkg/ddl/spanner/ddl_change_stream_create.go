@@ -0,0 +1,123 @@
+package spanner
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#create-change-stream
+
+var _ Stmt = (*CreateChangeStreamStmt)(nil)
+
+// CreateChangeStreamStmt represents CREATE CHANGE STREAM change_stream_name FOR ... OPTIONS (...).
+type CreateChangeStreamStmt struct {
+	Comment string
+	Name    *ObjectName
+	ForAll  bool
+	For     []*ChangeStreamFor // MEMO: empty if the change stream watches nothing.
+	Options *Expr
+}
+
+// ChangeStreamFor represents table_name or table_name(column_name, ...) of FOR clause.
+type ChangeStreamFor struct {
+	Table      *Ident
+	AllColumns bool     // MEMO: true if the columns are omitted, such as FOR Singers.
+	Columns    []*Ident // MEMO: empty with AllColumns false means only the primary key, such as FOR Singers().
+}
+
+func (f *ChangeStreamFor) String() string {
+	if f.AllColumns {
+		return f.Table.String()
+	}
+	str := f.Table.String() + "("
+	for i, c := range f.Columns {
+		if i != 0 {
+			str += ", "
+		}
+		str += c.String()
+	}
+	return str + ")"
+}
+
+func (f *ChangeStreamFor) StringForDiff() string {
+	if f.AllColumns {
+		return f.Table.StringForDiff()
+	}
+	columns := make([]string, 0, len(f.Columns))
+	for _, c := range f.Columns {
+		columns = append(columns, c.StringForDiff())
+	}
+	sort.Strings(columns)
+	return f.Table.StringForDiff() + "(" + strings.Join(columns, ", ") + ")"
+}
+
+func changeStreamForString(forAll bool, watches []*ChangeStreamFor) string {
+	if forAll {
+		return "FOR ALL"
+	}
+	str := "FOR "
+	for i, f := range watches {
+		if i != 0 {
+			str += ", "
+		}
+		str += f.String()
+	}
+	return str
+}
+
+func changeStreamForStringForDiff(forAll bool, watches []*ChangeStreamFor) string {
+	if forAll {
+		return "FOR ALL"
+	}
+	if len(watches) == 0 {
+		return ""
+	}
+	fors := make([]string, 0, len(watches))
+	for _, f := range watches {
+		fors = append(fors, f.StringForDiff())
+	}
+	sort.Strings(fors)
+	return "FOR " + strings.Join(fors, ", ")
+}
+
+func (s *CreateChangeStreamStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *CreateChangeStreamStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "CREATE CHANGE STREAM " + s.Name.String()
+	if s.ForAll || len(s.For) > 0 {
+		str += " " + changeStreamForString(s.ForAll, s.For)
+	}
+	if o := s.Options.String(); o != "" {
+		str += " OPTIONS " + o
+	}
+	str += ";\n"
+	return str
+}
+
+// StringForDiff returns the statement with the tables, the columns and the options sorted by name.
+func (s *CreateChangeStreamStmt) StringForDiff() string {
+	str := "CREATE CHANGE STREAM " + s.Name.StringForDiff()
+	if f := changeStreamForStringForDiff(s.ForAll, s.For); f != "" {
+		str += " " + f
+	}
+	if len(optionsForDiff(s.Options)) > 0 {
+		str += " " + optionsStringForDiff(s.Options)
+	}
+	return str
+}
+
+func (*CreateChangeStreamStmt) isStmt()            {}
+func (s *CreateChangeStreamStmt) GoString() string { return internal.GoString(*s) }
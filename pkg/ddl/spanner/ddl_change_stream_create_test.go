@@ -0,0 +1,60 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestCreateChangeStreamStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateChangeStreamStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestCreateChangeStreamStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,FOR_ALL", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateChangeStreamStmt{
+			Comment: "test comment content",
+			Name:    NewObjectName("EverythingStream"),
+			ForAll:  true,
+		}
+		expected := "-- test comment content\nCREATE CHANGE STREAM EverythingStream FOR ALL;\n"
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+
+	t.Run("success,FOR_tables", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateChangeStreamStmt{
+			Name: NewObjectName("SingerStream"),
+			For: []*ChangeStreamFor{
+				{Table: NewRawIdent("Singers"), Columns: []*Ident{NewRawIdent("Name"), NewRawIdent("Age")}},
+				{Table: NewRawIdent("Albums"), AllColumns: true},
+				{Table: NewRawIdent("Songs")},
+			},
+			Options: &Expr{Idents: []*Ident{NewRawIdent("("), NewRawIdent("retention_period"), NewRawIdent("="), NewRawIdent("'36h'"), NewRawIdent(")")}},
+		}
+		expected := "CREATE CHANGE STREAM SingerStream FOR Singers(Name, Age), Albums, Songs() OPTIONS (retention_period = '36h');\n"
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+		require.Equal(t, "CREATE CHANGE STREAM SingerStream FOR Albums, Singers(Age, Name), Songs() OPTIONS (retention_period = 36h)", stmt.StringForDiff())
+	})
+}
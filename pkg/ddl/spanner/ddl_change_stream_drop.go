@@ -0,0 +1,37 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#drop-change-stream
+
+var _ Stmt = (*DropChangeStreamStmt)(nil)
+
+type DropChangeStreamStmt struct {
+	Comment string
+	Name    *ObjectName
+}
+
+func (s *DropChangeStreamStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *DropChangeStreamStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "DROP CHANGE STREAM " + s.Name.String() + ";\n"
+	return str
+}
+
+func (*DropChangeStreamStmt) isStmt()            {}
+func (s *DropChangeStreamStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,28 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestDDL_FilterTables(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		d, err := NewParser(NewLexer(`CREATE TABLE users (id INT64 NOT NULL) PRIMARY KEY (id);
+CREATE TABLE ddlctl_schema_history (id INT64 NOT NULL) PRIMARY KEY (id);
+CREATE INDEX users_idx_id ON users (id);
+CREATE SEARCH INDEX ddlctl_schema_history_idx ON ddlctl_schema_history (tokens);
+`)).Parse()
+		require.NoError(t, err)
+
+		actual := d.FilterTables(func(_, table string) bool { return table != "ddlctl_schema_history" })
+		require.Equal(t, 2, len(actual.Stmts))
+		assert.Equal(t, "users", actual.Stmts[0].(*CreateTableStmt).Name.Name.Name)
+		assert.Equal(t, "users", actual.Stmts[1].(*CreateIndexStmt).TableName.Name.Name)
+	})
+}
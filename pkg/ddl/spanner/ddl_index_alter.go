@@ -0,0 +1,70 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#alter-index
+
+var _ Stmt = (*AlterIndexStmt)(nil)
+
+type AlterIndexStmt struct {
+	Comment string
+	Name    *ObjectName
+	Action  AlterIndexAction
+}
+
+func (*AlterIndexStmt) isStmt() {}
+
+func (s *AlterIndexStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *AlterIndexStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "ALTER INDEX "
+	str += s.Name.String() + " "
+	switch a := s.Action.(type) {
+	case *AddStoredColumn:
+		str += "ADD STORED COLUMN " + a.Name.String()
+	case *DropStoredColumn:
+		str += "DROP STORED COLUMN " + a.Name.String()
+	}
+
+	return str + ";\n"
+}
+
+func (s *AlterIndexStmt) GoString() string { return internal.GoString(*s) }
+
+type AlterIndexAction interface {
+	isAlterIndexAction()
+	GoString() string
+}
+
+// AddStoredColumn represents ALTER INDEX index_name ADD STORED COLUMN.
+type AddStoredColumn struct {
+	Name *Ident
+}
+
+func (*AddStoredColumn) isAlterIndexAction() {}
+
+func (s *AddStoredColumn) GoString() string { return internal.GoString(*s) }
+
+// DropStoredColumn represents ALTER INDEX index_name DROP STORED COLUMN.
+type DropStoredColumn struct {
+	Name *Ident
+}
+
+func (*DropStoredColumn) isAlterIndexAction() {}
+
+func (s *DropStoredColumn) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,40 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestAlterIndexStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,ADD_STORED_COLUMN", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterIndexStmt{
+			Comment: "test comment content",
+			Name:    &ObjectName{Name: NewRawIdent("SingersByName")},
+			Action:  &AddStoredColumn{Name: NewRawIdent("FirstName")},
+		}
+		expected := `-- test comment content
+ALTER INDEX SingersByName ADD STORED COLUMN FirstName;
+`
+		require.Equal(t, expected, stmt.String())
+		require.Equal(t, "SingersByName", stmt.GetNameForDiff())
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+
+	t.Run("success,DROP_STORED_COLUMN", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterIndexStmt{
+			Name:   &ObjectName{Name: NewRawIdent("SingersByName")},
+			Action: &DropStoredColumn{Name: NewRawIdent("FirstName")},
+		}
+		require.Equal(t, "ALTER INDEX SingersByName DROP STORED COLUMN FirstName;\n", stmt.String())
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
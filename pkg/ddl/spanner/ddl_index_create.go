@@ -1,6 +1,7 @@
 package spanner
 
 import (
+	"sort"
 	"strings"
 
 	stringz "github.com/kunitsucom/util.go/strings"
@@ -13,13 +14,16 @@ import (
 var _ Stmt = (*CreateIndexStmt)(nil)
 
 type CreateIndexStmt struct {
-	Comment     string
-	Unique      bool
-	IfNotExists bool
-	Name        *ObjectName
-	TableName   *ObjectName
-	Using       []*Ident
-	Columns     []*ColumnIdent
+	Comment      string
+	Unique       bool
+	NullFiltered bool
+	IfNotExists  bool
+	Name         *ObjectName
+	TableName    *ObjectName
+	Using        []*Ident
+	Columns      []*ColumnIdent
+	Storing      []*Ident
+	InterleaveIn *ObjectName
 }
 
 func (s *CreateIndexStmt) GetNameForDiff() string {
@@ -40,6 +44,9 @@ func (s *CreateIndexStmt) String() string {
 	if s.Unique {
 		str += "UNIQUE "
 	}
+	if s.NullFiltered {
+		str += "NULL_FILTERED "
+	}
 	str += "INDEX "
 	if s.IfNotExists {
 		str += "IF NOT EXISTS "
@@ -49,7 +56,14 @@ func (s *CreateIndexStmt) String() string {
 		str += " USING "
 		str += stringz.JoinStringers(" ", s.Using...)
 	}
-	str += " (" + stringz.JoinStringers(", ", s.Columns...) + ");\n"
+	str += " (" + stringz.JoinStringers(", ", s.Columns...) + ")"
+	if len(s.Storing) > 0 {
+		str += " STORING (" + stringz.JoinStringers(", ", s.Storing...) + ")"
+	}
+	if s.InterleaveIn != nil {
+		str += ", INTERLEAVE IN " + s.InterleaveIn.String()
+	}
+	str += ";\n"
 	return str
 }
 
@@ -58,9 +72,20 @@ func (s *CreateIndexStmt) StringForDiff() string {
 	if s.Unique {
 		str += "UNIQUE "
 	}
+	if s.NullFiltered {
+		str += "NULL_FILTERED "
+	}
 	str += "INDEX "
 	str += s.Name.StringForDiff() + " ON " + s.TableName.StringForDiff()
-	// TODO: add USING
+	if len(s.Using) > 0 {
+		str += " USING "
+		for i, u := range s.Using {
+			if i > 0 {
+				str += " "
+			}
+			str += u.StringForDiff()
+		}
+	}
 	str += " ("
 	for i, c := range s.Columns {
 		if i > 0 {
@@ -68,9 +93,26 @@ func (s *CreateIndexStmt) StringForDiff() string {
 		}
 		str += c.StringForDiff()
 	}
-	str += ");\n"
+	str += ")"
+	if storing := s.storingForDiff(); len(storing) > 0 {
+		str += " STORING (" + strings.Join(storing, ", ") + ")"
+	}
+	if s.InterleaveIn != nil {
+		str += ", INTERLEAVE IN " + s.InterleaveIn.StringForDiff()
+	}
+	str += ";\n"
 	return str
 }
 
+// storingForDiff returns the sorted names of the stored columns, because their order does not matter.
+func (s *CreateIndexStmt) storingForDiff() []string {
+	storing := make([]string, 0, len(s.Storing))
+	for _, c := range s.Storing {
+		storing = append(storing, c.StringForDiff())
+	}
+	sort.Strings(storing)
+	return storing
+}
+
 func (*CreateIndexStmt) isStmt()            {}
 func (s *CreateIndexStmt) GoString() string { return internal.GoString(*s) }
@@ -48,4 +48,20 @@ CREATE INDEX IF NOT EXISTS "test" ON "users" USING btree ("id" ASC);
 
 		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
 	})
+	t.Run("success,NULL_FILTERED,STORING,INTERLEAVE_IN", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateIndexStmt{
+			Unique:       true,
+			NullFiltered: true,
+			Name:         &ObjectName{Name: NewRawIdent("AlbumsByTitle")},
+			TableName:    &ObjectName{Name: NewRawIdent("Albums")},
+			Columns:      []*ColumnIdent{{Ident: NewRawIdent("SingerId")}, {Ident: NewRawIdent("Title"), Order: &Order{Desc: true}}},
+			Storing:      []*Ident{NewRawIdent("MarketingBudget"), NewRawIdent("AlbumId")},
+			InterleaveIn: &ObjectName{Name: NewRawIdent("Singers")},
+		}
+
+		require.Equal(t, "CREATE UNIQUE NULL_FILTERED INDEX AlbumsByTitle ON Albums (SingerId, Title DESC) STORING (MarketingBudget, AlbumId), INTERLEAVE IN Singers;\n", stmt.String())
+		require.Equal(t, "CREATE UNIQUE NULL_FILTERED INDEX AlbumsByTitle ON Albums (SingerId ASC, Title DESC) STORING (AlbumId, MarketingBudget), INTERLEAVE IN Singers;\n", stmt.StringForDiff())
+	})
 }
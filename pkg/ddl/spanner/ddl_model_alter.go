@@ -0,0 +1,39 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#alter_model
+
+var _ Stmt = (*AlterModelStmt)(nil)
+
+// AlterModelStmt represents ALTER MODEL model_name SET OPTIONS (...).
+type AlterModelStmt struct {
+	Comment string
+	Name    *ObjectName
+	Options *Expr
+}
+
+func (s *AlterModelStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *AlterModelStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "ALTER MODEL " + s.Name.String() + " SET OPTIONS " + s.Options.String() + ";\n"
+	return str
+}
+
+func (*AlterModelStmt) isStmt()            {}
+func (s *AlterModelStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,41 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestAlterModelStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterModelStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestAlterModelStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterModelStmt{
+			Comment: "test comment content",
+			Name:    NewObjectName("EmbeddingsModel"),
+			Options: &Expr{Idents: []*Ident{NewRawIdent("("), NewRawIdent("endpoint"), NewRawIdent("="), NewRawIdent("'//aiplatform.googleapis.com/y'"), NewRawIdent(")")}},
+		}
+		expected := "-- test comment content\nALTER MODEL EmbeddingsModel SET OPTIONS (endpoint = '//aiplatform.googleapis.com/y');\n"
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
@@ -0,0 +1,94 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#create_model
+
+var _ Stmt = (*CreateModelStmt)(nil)
+
+// CreateModelStmt represents CREATE [OR REPLACE] MODEL [IF NOT EXISTS] model_name INPUT (...) OUTPUT (...) REMOTE OPTIONS (...).
+type CreateModelStmt struct {
+	Comment     string
+	OrReplace   bool
+	IfNotExists bool
+	Name        *ObjectName
+	Input       []*StructField
+	Output      []*StructField
+	Options     *Expr
+}
+
+func (s *CreateModelStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *CreateModelStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "CREATE "
+	if s.OrReplace {
+		str += "OR REPLACE "
+	}
+	str += "MODEL "
+	if s.IfNotExists {
+		str += "IF NOT EXISTS "
+	}
+	str += s.Name.String()
+	if len(s.Input) > 0 {
+		str += " INPUT (" + modelColumnsString(s.Input) + ")"
+	}
+	if len(s.Output) > 0 {
+		str += " OUTPUT (" + modelColumnsString(s.Output) + ")"
+	}
+	str += " REMOTE"
+	if o := s.Options.String(); o != "" {
+		str += " OPTIONS " + o
+	}
+	str += ";\n"
+	return str
+}
+
+// StringForDiff returns the statement without the options, because the options can be changed by ALTER MODEL.
+func (s *CreateModelStmt) StringForDiff() string {
+	str := "CREATE MODEL " + s.Name.StringForDiff()
+	str += " INPUT ("
+	for i, c := range s.Input {
+		if i != 0 {
+			str += ", "
+		}
+		str += c.StringForDiff()
+	}
+	str += ") OUTPUT ("
+	for i, c := range s.Output {
+		if i != 0 {
+			str += ", "
+		}
+		str += c.StringForDiff()
+	}
+	str += ") REMOTE"
+	return str
+}
+
+func modelColumnsString(columns []*StructField) string {
+	var str string
+	for i, c := range columns {
+		if i != 0 {
+			str += ", "
+		}
+		str += c.String()
+	}
+	return str
+}
+
+func (*CreateModelStmt) isStmt()            {}
+func (s *CreateModelStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,46 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestCreateModelStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateModelStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestCreateModelStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateModelStmt{
+			Comment:     "test comment content",
+			OrReplace:   true,
+			IfNotExists: true,
+			Name:        NewObjectName("EmbeddingsModel"),
+			Input:       []*StructField{{Name: NewRawIdent("content"), DataType: &DataType{Name: "STRING", Type: TOKEN_STRING, Expr: &Expr{Idents: []*Ident{NewRawIdent("MAX")}}}}},
+			Output:      []*StructField{{Name: NewRawIdent("embeddings"), DataType: &DataType{Name: "ARRAY", Type: TOKEN_ARRAY, Elem: &DataType{Name: "FLOAT64", Type: TOKEN_FLOAT64}}}},
+			Options:     &Expr{Idents: []*Ident{NewRawIdent("("), NewRawIdent("endpoint"), NewRawIdent("="), NewRawIdent("'//aiplatform.googleapis.com/x'"), NewRawIdent(")")}},
+		}
+		expected := "-- test comment content\nCREATE OR REPLACE MODEL IF NOT EXISTS EmbeddingsModel INPUT (content STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE OPTIONS (endpoint = '//aiplatform.googleapis.com/x');\n"
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+		require.Equal(t, "CREATE MODEL EmbeddingsModel INPUT (content STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE", stmt.StringForDiff())
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
@@ -0,0 +1,37 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#drop_model
+
+var _ Stmt = (*DropModelStmt)(nil)
+
+type DropModelStmt struct {
+	Comment string
+	Name    *ObjectName
+}
+
+func (s *DropModelStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *DropModelStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "DROP MODEL " + s.Name.String() + ";\n"
+	return str
+}
+
+func (*DropModelStmt) isStmt()            {}
+func (s *DropModelStmt) GoString() string { return internal.GoString(*s) }
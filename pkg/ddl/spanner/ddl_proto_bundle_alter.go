@@ -0,0 +1,60 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#alter-proto-bundle
+
+var _ Stmt = (*AlterProtoBundleStmt)(nil)
+
+// AlterProtoBundleStmt represents ALTER PROTO BUNDLE INSERT (...) UPDATE (...) DELETE (...).
+type AlterProtoBundleStmt struct {
+	Comment string
+	Insert  []*Ident
+	Update  []*Ident
+	Delete  []*Ident
+}
+
+func (*AlterProtoBundleStmt) GetNameForDiff() string {
+	return ProtoBundleNameForDiff
+}
+
+func (s *AlterProtoBundleStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "ALTER PROTO BUNDLE"
+	for _, clause := range []struct {
+		keyword string
+		types   []*Ident
+	}{
+		{"INSERT", s.Insert},
+		{"UPDATE", s.Update},
+		{"DELETE", s.Delete},
+	} {
+		if len(clause.types) == 0 {
+			continue
+		}
+		str += " " + clause.keyword + " ("
+		for i, t := range clause.types {
+			if i != 0 {
+				str += ", "
+			}
+			str += t.String()
+		}
+		str += ")"
+	}
+	return str + ";\n"
+}
+
+func (*AlterProtoBundleStmt) isStmt()            {}
+func (s *AlterProtoBundleStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,42 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestAlterProtoBundleStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterProtoBundleStmt{Insert: []*Ident{NewRawIdent("examples.music.SingerInfo")}}
+		expected := "PROTO BUNDLE"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestAlterProtoBundleStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &AlterProtoBundleStmt{
+			Comment: "test comment content",
+			Insert:  []*Ident{NewRawIdent("examples.music.Album"), NewRawIdent("examples.music.Song")},
+			Update:  []*Ident{NewRawIdent("examples.music.SingerInfo")},
+			Delete:  []*Ident{NewRawIdent("examples.music.Genre")},
+		}
+		expected := "-- test comment content\nALTER PROTO BUNDLE INSERT (examples.music.Album, examples.music.Song) UPDATE (examples.music.SingerInfo) DELETE (examples.music.Genre);\n"
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
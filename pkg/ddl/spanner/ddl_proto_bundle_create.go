@@ -0,0 +1,64 @@
+package spanner
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#create-proto-bundle
+
+var _ Stmt = (*CreateProtoBundleStmt)(nil)
+
+// ProtoBundleNameForDiff is the name for diff of the proto bundle, because a database has only one proto bundle.
+const ProtoBundleNameForDiff = "PROTO BUNDLE"
+
+// CreateProtoBundleStmt represents CREATE PROTO BUNDLE (proto_type_name, ...).
+type CreateProtoBundleStmt struct {
+	Comment string
+	Types   []*Ident
+}
+
+func (*CreateProtoBundleStmt) GetNameForDiff() string {
+	return ProtoBundleNameForDiff
+}
+
+func (s *CreateProtoBundleStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "CREATE PROTO BUNDLE (\n"
+	for i, t := range s.Types {
+		str += Indent + t.String()
+		if i != len(s.Types)-1 {
+			str += ","
+		}
+		str += "\n"
+	}
+	str += ");\n"
+	return str
+}
+
+// StringForDiff returns the statement with the types sorted by name.
+func (s *CreateProtoBundleStmt) StringForDiff() string {
+	return "CREATE PROTO BUNDLE (" + strings.Join(s.typesForDiff(), ", ") + ")"
+}
+
+func (s *CreateProtoBundleStmt) typesForDiff() []string {
+	types := make([]string, 0, len(s.Types))
+	for _, t := range s.Types {
+		types = append(types, t.StringForDiff())
+	}
+	sort.Strings(types)
+	return types
+}
+
+func (*CreateProtoBundleStmt) isStmt()            {}
+func (s *CreateProtoBundleStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,56 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestCreateProtoBundleStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateProtoBundleStmt{Types: []*Ident{NewRawIdent("examples.music.SingerInfo")}}
+		expected := "PROTO BUNDLE"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestCreateProtoBundleStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateProtoBundleStmt{
+			Comment: "test comment content",
+			Types:   []*Ident{NewRawIdent("examples.music.SingerInfo"), NewRawIdent("`examples.music.Genre`")},
+		}
+		expected := "-- test comment content\nCREATE PROTO BUNDLE (\n    examples.music.SingerInfo,\n    `examples.music.Genre`\n);\n"
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
+
+func TestCreateProtoBundleStmt_StringForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateProtoBundleStmt{
+			Types: []*Ident{NewRawIdent("examples.music.SingerInfo"), NewRawIdent("`examples.music.Genre`")},
+		}
+		expected := "CREATE PROTO BUNDLE (examples.music.Genre, examples.music.SingerInfo)"
+		actual := stmt.StringForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
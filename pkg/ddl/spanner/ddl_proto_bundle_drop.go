@@ -0,0 +1,35 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#drop-proto-bundle
+
+var _ Stmt = (*DropProtoBundleStmt)(nil)
+
+type DropProtoBundleStmt struct {
+	Comment string
+}
+
+func (*DropProtoBundleStmt) GetNameForDiff() string {
+	return ProtoBundleNameForDiff
+}
+
+func (s *DropProtoBundleStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	return str + "DROP PROTO BUNDLE;\n"
+}
+
+func (*DropProtoBundleStmt) isStmt()            {}
+func (s *DropProtoBundleStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,110 @@
+package spanner
+
+import (
+	"sort"
+	"strings"
+
+	stringz "github.com/kunitsucom/util.go/strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#create-search-index
+
+var _ Stmt = (*CreateSearchIndexStmt)(nil)
+
+// CreateSearchIndexStmt represents CREATE SEARCH INDEX index_name ON table_name (tokenlist_column_name, ...) ....
+type CreateSearchIndexStmt struct {
+	Comment      string
+	Name         *ObjectName
+	TableName    *ObjectName
+	Columns      []*Ident
+	Storing      []*Ident
+	PartitionBy  []*Ident
+	OrderBy      []*ColumnIdent
+	InterleaveIn *ObjectName
+	Options      *Expr
+}
+
+func (s *CreateSearchIndexStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *CreateSearchIndexStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "CREATE SEARCH INDEX " + s.Name.String() + " ON " + s.TableName.String()
+	str += " (" + stringz.JoinStringers(", ", s.Columns...) + ")"
+	if len(s.Storing) > 0 {
+		str += " STORING (" + stringz.JoinStringers(", ", s.Storing...) + ")"
+	}
+	if len(s.PartitionBy) > 0 {
+		str += " PARTITION BY " + stringz.JoinStringers(", ", s.PartitionBy...)
+	}
+	if len(s.OrderBy) > 0 {
+		str += " ORDER BY " + stringz.JoinStringers(", ", s.OrderBy...)
+	}
+	if s.InterleaveIn != nil {
+		str += ", INTERLEAVE IN " + s.InterleaveIn.String()
+	}
+	if o := s.Options.String(); o != "" {
+		str += " OPTIONS " + o
+	}
+	str += ";\n"
+	return str
+}
+
+func (s *CreateSearchIndexStmt) StringForDiff() string {
+	str := "CREATE SEARCH INDEX " + s.Name.StringForDiff() + " ON " + s.TableName.StringForDiff() + " ("
+	for i, c := range s.Columns {
+		if i > 0 {
+			str += ", "
+		}
+		str += c.StringForDiff()
+	}
+	str += ")"
+	if len(s.Storing) > 0 {
+		// MEMO: The order of the stored columns does not matter.
+		storing := make([]string, 0, len(s.Storing))
+		for _, c := range s.Storing {
+			storing = append(storing, c.StringForDiff())
+		}
+		sort.Strings(storing)
+		str += " STORING (" + strings.Join(storing, ", ") + ")"
+	}
+	if len(s.PartitionBy) > 0 {
+		str += " PARTITION BY "
+		for i, c := range s.PartitionBy {
+			if i > 0 {
+				str += ", "
+			}
+			str += c.StringForDiff()
+		}
+	}
+	if len(s.OrderBy) > 0 {
+		str += " ORDER BY "
+		for i, c := range s.OrderBy {
+			if i > 0 {
+				str += ", "
+			}
+			str += c.StringForDiff()
+		}
+	}
+	if s.InterleaveIn != nil {
+		str += ", INTERLEAVE IN " + s.InterleaveIn.StringForDiff()
+	}
+	if len(optionsForDiff(s.Options)) > 0 {
+		str += " " + optionsStringForDiff(s.Options)
+	}
+	return str
+}
+
+func (*CreateSearchIndexStmt) isStmt()            {}
+func (s *CreateSearchIndexStmt) GoString() string { return internal.GoString(*s) }
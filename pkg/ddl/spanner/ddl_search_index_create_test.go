@@ -0,0 +1,48 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestCreateSearchIndexStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateSearchIndexStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestCreateSearchIndexStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateSearchIndexStmt{
+			Comment:      "test comment content",
+			Name:         NewObjectName("AlbumsIndex"),
+			TableName:    NewObjectName("Albums"),
+			Columns:      []*Ident{NewRawIdent("AlbumTitle_Tokens"), NewRawIdent("Rating_Tokens")},
+			Storing:      []*Ident{NewRawIdent("Genre"), NewRawIdent("Cover")},
+			PartitionBy:  []*Ident{NewRawIdent("SingerId")},
+			OrderBy:      []*ColumnIdent{{Ident: NewRawIdent("ReleaseTimestamp"), Order: &Order{Desc: true}}},
+			InterleaveIn: NewObjectName("Singers"),
+			Options:      &Expr{Idents: []*Ident{NewRawIdent("("), NewRawIdent("sort_order_sharding"), NewRawIdent("="), NewRawIdent("TRUE"), NewRawIdent(")")}},
+		}
+		expected := "-- test comment content\nCREATE SEARCH INDEX AlbumsIndex ON Albums (AlbumTitle_Tokens, Rating_Tokens) STORING (Genre, Cover) PARTITION BY SingerId ORDER BY ReleaseTimestamp DESC, INTERLEAVE IN Singers OPTIONS (sort_order_sharding = TRUE);\n"
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+		require.Equal(t, "CREATE SEARCH INDEX AlbumsIndex ON Albums (AlbumTitle_Tokens, Rating_Tokens) STORING (Cover, Genre) PARTITION BY SingerId ORDER BY ReleaseTimestamp DESC, INTERLEAVE IN Singers OPTIONS (sort_order_sharding = TRUE)", stmt.StringForDiff())
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
@@ -0,0 +1,37 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#drop-search-index
+
+var _ Stmt = (*DropSearchIndexStmt)(nil)
+
+type DropSearchIndexStmt struct {
+	Comment string
+	Name    *ObjectName
+}
+
+func (s *DropSearchIndexStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *DropSearchIndexStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "DROP SEARCH INDEX " + s.Name.String() + ";\n"
+	return str
+}
+
+func (*DropSearchIndexStmt) isStmt()            {}
+func (s *DropSearchIndexStmt) GoString() string { return internal.GoString(*s) }
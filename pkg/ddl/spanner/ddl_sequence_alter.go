@@ -0,0 +1,39 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#alter-sequence
+
+var _ Stmt = (*AlterSequenceStmt)(nil)
+
+// AlterSequenceStmt represents ALTER SEQUENCE sequence_name SET OPTIONS (...).
+type AlterSequenceStmt struct {
+	Comment string
+	Name    *ObjectName
+	Options *Expr
+}
+
+func (s *AlterSequenceStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *AlterSequenceStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "ALTER SEQUENCE " + s.Name.String() + " SET OPTIONS " + s.Options.String() + ";\n"
+	return str
+}
+
+func (*AlterSequenceStmt) isStmt()            {}
+func (s *AlterSequenceStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,53 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#create-sequence
+
+var _ Stmt = (*CreateSequenceStmt)(nil)
+
+// CreateSequenceStmt represents CREATE SEQUENCE sequence_name OPTIONS (...).
+type CreateSequenceStmt struct {
+	Comment     string
+	IfNotExists bool
+	Name        *ObjectName
+	Options     *Expr
+}
+
+func (s *CreateSequenceStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *CreateSequenceStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "CREATE SEQUENCE "
+	if s.IfNotExists {
+		str += "IF NOT EXISTS "
+	}
+	str += s.Name.String()
+	if o := s.Options.String(); o != "" {
+		str += " OPTIONS " + o
+	}
+	str += ";\n"
+	return str
+}
+
+// StringForDiff returns the statement with the options sorted by name.
+func (s *CreateSequenceStmt) StringForDiff() string {
+	return "CREATE SEQUENCE " + s.Name.StringForDiff() + " " + optionsStringForDiff(s.Options)
+}
+
+func (*CreateSequenceStmt) isStmt()            {}
+func (s *CreateSequenceStmt) GoString() string { return internal.GoString(*s) }
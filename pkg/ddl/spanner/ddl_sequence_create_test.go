@@ -0,0 +1,66 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestCreateSequenceStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateSequenceStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestCreateSequenceStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateSequenceStmt{
+			Comment:     "test comment content",
+			IfNotExists: true,
+			Name:        &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Options:     &Expr{Idents: []*Ident{NewRawIdent("("), NewRawIdent("sequence_kind"), NewRawIdent("="), NewRawIdent("'bit_reversed_positive'"), NewRawIdent(")")}},
+		}
+		expected := `-- test comment content
+CREATE SEQUENCE IF NOT EXISTS "test" OPTIONS (sequence_kind = 'bit_reversed_positive');
+`
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
+
+func TestCreateSequenceStmt_StringForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateSequenceStmt{
+			Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Options: &Expr{Idents: []*Ident{
+				NewRawIdent("("),
+				NewRawIdent("SKIP_RANGE_MIN"), NewRawIdent("="), NewRawIdent("1"), NewRawIdent(","),
+				NewRawIdent("sequence_kind"), NewRawIdent("="), NewRawIdent("'bit_reversed_positive'"),
+				NewRawIdent(")"),
+			}},
+		}
+		expected := `CREATE SEQUENCE test OPTIONS (sequence_kind = bit_reversed_positive, skip_range_min = 1)`
+		actual := stmt.StringForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
@@ -169,11 +169,14 @@ func (t *ObjectName) StringForDiff() string {
 }
 
 type Column struct {
-	Name     *Ident
-	DataType *DataType
-	Default  *Default
-	NotNull  bool
-	Options  *Expr
+	Name      *Ident
+	DataType  *DataType
+	Default   *Default
+	NotNull   bool
+	Generated *Expr // e.g. (TOKENIZE_FULLTEXT(Name)) of AS (TOKENIZE_FULLTEXT(Name)) STORED
+	Stored    bool
+	Hidden    bool
+	Options   *Expr
 }
 
 type Default struct {
@@ -278,12 +281,37 @@ func (c *Column) String() string {
 	if s := c.Default.String(); s != "" { //diff:ignore-line-postgres-cockroach
 		str += " " + s //diff:ignore-line-postgres-cockroach
 	}
+	if s := c.Generated.String(); s != "" {
+		str += " AS " + s
+		if c.Stored {
+			str += " STORED"
+		}
+	}
+	if c.Hidden {
+		str += " HIDDEN"
+	}
 	if s := c.Options.String(); s != "" { //diff:ignore-line-postgres-cockroach
 		str += " OPTIONS " + s //diff:ignore-line-postgres-cockroach
 	}
 	return str
 }
 
+// generatedForDiff returns the generation of the column such as AS (expr) STORED HIDDEN,
+// which cannot be altered by ALTER TABLE ... ALTER COLUMN.
+func (c *Column) generatedForDiff() string {
+	var str string
+	if s := c.Generated.StringForDiff(); s != "" {
+		str += "AS " + s
+		if c.Stored {
+			str += " STORED"
+		}
+	}
+	if c.Hidden {
+		str += " HIDDEN"
+	}
+	return str
+}
+
 func (c *Column) GoString() string { return internal.GoString(*c) }
 
 type Option struct {
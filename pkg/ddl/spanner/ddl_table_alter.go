@@ -147,9 +147,10 @@ func (s *DropColumn) GoString() string { return internal.GoString(*s) }
 
 // AlterColumnDataType represents ALTER TABLE table_name ALTER COLUMN column_name data_type NOT NULL.
 type AlterColumnDataType struct {
-	Name     *Ident
-	DataType *DataType
-	NotNull  bool
+	Name        *Ident
+	DataType    *DataType
+	OldDataType *DataType // MEMO: not printed, but used to detect a narrowing change.
+	NotNull     bool
 }
 
 func (*AlterColumnDataType) isAlterTableAction() {}
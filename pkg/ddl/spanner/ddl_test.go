@@ -14,6 +14,19 @@ func Test_isStmt(t *testing.T) {
 	(&AlterTableStmt{}).isStmt()
 	(&CreateIndexStmt{}).isStmt()
 	(&DropIndexStmt{}).isStmt()
+	(&CreateProtoBundleStmt{}).isStmt()
+	(&AlterProtoBundleStmt{}).isStmt()
+	(&DropProtoBundleStmt{}).isStmt()
+	(&CreateChangeStreamStmt{}).isStmt()
+	(&AlterChangeStreamStmt{}).isStmt()
+	(&DropChangeStreamStmt{}).isStmt()
+	(&CreateViewStmt{}).isStmt()
+	(&DropViewStmt{}).isStmt()
+	(&CreateSearchIndexStmt{}).isStmt()
+	(&DropSearchIndexStmt{}).isStmt()
+	(&CreateModelStmt{}).isStmt()
+	(&AlterModelStmt{}).isStmt()
+	(&DropModelStmt{}).isStmt()
 }
 
 func TestIdent_String(t *testing.T) {
@@ -105,3 +118,59 @@ func TestDataType_StringForDiff(t *testing.T) {
 		require.Equal(t, expected, actual)
 	})
 }
+
+func TestDataType_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,ARRAY", func(t *testing.T) {
+		t.Parallel()
+		dataType := &DataType{
+			Name: "ARRAY",
+			Type: TOKEN_ARRAY,
+			Elem: &DataType{Name: "FLOAT32", Type: TOKEN_FLOAT32},
+			Args: []*TypeArg{{Name: NewRawIdent("vector_length"), Value: NewRawIdent("768")}},
+		}
+		expected := "ARRAY<FLOAT32>(vector_length=>768)"
+		actual := dataType.String()
+
+		require.Equal(t, expected, actual)
+		require.Equal(t, "ARRAY<FLOAT32>(vector_length=>768)", dataType.StringForDiff())
+	})
+
+	t.Run("success,STRUCT", func(t *testing.T) {
+		t.Parallel()
+		dataType := &DataType{
+			Name: "STRUCT",
+			Type: TOKEN_STRUCT,
+			Fields: []*StructField{
+				{Name: NewRawIdent("Name"), DataType: &DataType{Name: "STRING", Type: TOKEN_STRING, Expr: &Expr{Idents: []*Ident{NewRawIdent("MAX")}}}},
+				{DataType: &DataType{Name: "INT64", Type: TOKEN_INT64}},
+			},
+		}
+		expected := "STRUCT<Name STRING(MAX), INT64>"
+		actual := dataType.String()
+
+		require.Equal(t, expected, actual)
+		require.Equal(t, "STRUCT<Name STRING(MAX),INT64>", dataType.StringForDiff())
+	})
+
+	t.Run("success,PROTO", func(t *testing.T) {
+		t.Parallel()
+		dataType := &DataType{Name: "PROTO", Type: TOKEN_IDENT, TypeName: NewRawIdent("examples.music.SingerInfo")}
+		expected := "PROTO<examples.music.SingerInfo>"
+		actual := dataType.String()
+
+		require.Equal(t, expected, actual)
+		require.Equal(t, "examples.music.SingerInfo", dataType.StringForDiff())
+	})
+
+	t.Run("success,proto_type_name", func(t *testing.T) {
+		t.Parallel()
+		dataType := &DataType{Type: TOKEN_IDENT, TypeName: NewRawIdent("`examples.music.SingerInfo`")}
+		expected := "`examples.music.SingerInfo`"
+		actual := dataType.String()
+
+		require.Equal(t, expected, actual)
+		require.Equal(t, "examples.music.SingerInfo", dataType.StringForDiff())
+	})
+}
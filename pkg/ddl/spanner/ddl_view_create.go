@@ -0,0 +1,53 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#create-view
+
+var _ Stmt = (*CreateViewStmt)(nil)
+
+// CreateViewStmt represents CREATE [OR REPLACE] VIEW view_name SQL SECURITY {INVOKER | DEFINER} AS query.
+type CreateViewStmt struct {
+	Comment     string
+	OrReplace   bool
+	Name        *ObjectName
+	SQLSecurity string // INVOKER or DEFINER
+	Query       *Expr
+}
+
+func (s *CreateViewStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *CreateViewStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "CREATE "
+	if s.OrReplace {
+		str += "OR REPLACE "
+	}
+	str += "VIEW " + s.Name.String()
+	if s.SQLSecurity != "" {
+		str += " SQL SECURITY " + s.SQLSecurity
+	}
+	str += " AS " + s.Query.String() + ";\n"
+	return str
+}
+
+func (s *CreateViewStmt) StringForDiff() string {
+	return "CREATE VIEW " + s.Name.StringForDiff() + " SQL SECURITY " + strings.ToUpper(s.SQLSecurity) + " AS " + s.Query.StringForDiff()
+}
+
+func (*CreateViewStmt) isStmt()            {}
+func (s *CreateViewStmt) GoString() string { return internal.GoString(*s) }
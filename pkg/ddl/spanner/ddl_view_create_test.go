@@ -0,0 +1,44 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestCreateViewStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateViewStmt{Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}}}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
+
+func TestCreateViewStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateViewStmt{
+			Comment:     "test comment content",
+			OrReplace:   true,
+			Name:        NewObjectName("SingerNames"),
+			SQLSecurity: "INVOKER",
+			Query:       &Expr{Idents: []*Ident{NewRawIdent("SELECT"), NewRawIdent("Name"), NewRawIdent("FROM"), NewRawIdent("Singers")}},
+		}
+		expected := "-- test comment content\nCREATE OR REPLACE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Name FROM Singers;\n"
+		actual := stmt.String()
+
+		require.Equal(t, expected, actual)
+		require.Equal(t, "CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Name FROM Singers", stmt.StringForDiff())
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
@@ -0,0 +1,37 @@
+package spanner
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#drop-view
+
+var _ Stmt = (*DropViewStmt)(nil)
+
+type DropViewStmt struct {
+	Comment string
+	Name    *ObjectName
+}
+
+func (s *DropViewStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *DropViewStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "DROP VIEW " + s.Name.String() + ";\n"
+	return str
+}
+
+func (*DropViewStmt) isStmt()            {}
+func (s *DropViewStmt) GoString() string { return internal.GoString(*s) }
@@ -4,76 +4,235 @@ import (
 	"reflect"
 
 	errorz "github.com/kunitsucom/util.go/errors"
-	"github.com/kunitsucom/util.go/exp/diff/simplediff"
 
 	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
 
 	"github.com/kunitsucom/ddlctl/pkg/ddl"
 )
 
-//nolint:funlen,cyclop,gocognit
-func Diff(before, after *DDL) (*DDL, error) {
+type DiffConfig struct {
+	Renames ddl.Renames
+}
+
+type DiffOption interface {
+	apply(c *DiffConfig)
+}
+
+// DiffRenames declares the tables renamed from before to after,
+// so that they are renamed by ALTER TABLE instead of being dropped and created.
+//
+// MEMO: Spanner does not support ALTER TABLE ... RENAME COLUMN, so the renamed columns are not supported.
+// ref. https://cloud.google.com/spanner/docs/reference/standard-sql/data-definition-language#alter_table
+func DiffRenames(renames ddl.Renames) DiffOption { //nolint:ireturn
+	return &diffConfigRenames{
+		renames: renames,
+	}
+}
+
+type diffConfigRenames struct {
+	renames ddl.Renames
+}
+
+func (o *diffConfigRenames) apply(c *DiffConfig) {
+	c.Renames = append(c.Renames, o.renames...)
+}
+
+//nolint:funlen,cyclop,gocognit,gocyclo
+func Diff(before, after *DDL, opts ...DiffOption) (*DDL, error) {
+	config := &DiffConfig{}
+
+	for _, opt := range opts {
+		opt.apply(config)
+	}
+
 	result := &DDL{}
 
 	switch {
 	case before == nil && after != nil:
-		result.Stmts = append(result.Stmts, after.Stmts...)
+		// MEMO: The proto bundle and sequences must be created before the tables that use them.
+		if s := findProtoBundle(after); s != nil {
+			result.Stmts = append(result.Stmts, s)
+		}
+		for _, stmt := range after.Stmts {
+			if _, ok := stmt.(*CreateSequenceStmt); ok {
+				result.Stmts = append(result.Stmts, stmt)
+			}
+		}
+		// MEMO: Tables are created in the order of their interleave and foreign key dependencies.
+		createTableStmts := make([]*CreateTableStmt, 0)
+		for _, stmt := range after.Stmts {
+			if s, ok := stmt.(*CreateTableStmt); ok {
+				createTableStmts = append(createTableStmts, s)
+			}
+		}
+		sortedCreateTableStmts, addConstraintStmts := sortCreateTableStmts(createTableStmts)
+		result.Stmts = append(result.Stmts, sortedCreateTableStmts...)
+		for _, stmt := range after.Stmts {
+			switch stmt.(type) {
+			case *CreateProtoBundleStmt, *CreateSequenceStmt, *CreateTableStmt:
+				// do nothing
+			default:
+				result.Stmts = append(result.Stmts, stmt)
+			}
+		}
+		result.Stmts = append(result.Stmts, addConstraintStmts...)
 		return result, nil
 	case before != nil && after == nil:
+		dropTableStmts := make([]*CreateTableStmt, 0)
 		for _, stmt := range before.Stmts {
 			switch s := stmt.(type) {
+			case *CreateProtoBundleStmt, *CreateSequenceStmt:
+				// do nothing
 			case *CreateTableStmt:
-				result.Stmts = append(result.Stmts, &DropTableStmt{
-					Name: s.Name,
-				})
+				dropTableStmts = append(dropTableStmts, s)
 			case *CreateIndexStmt:
 				result.Stmts = append(result.Stmts, &DropIndexStmt{
 					Name: s.Name,
 				})
+			case *CreateSearchIndexStmt:
+				result.Stmts = append(result.Stmts, &DropSearchIndexStmt{Name: s.Name})
+			case *CreateViewStmt:
+				result.Stmts = append(result.Stmts, &DropViewStmt{Name: s.Name})
+			case *CreateChangeStreamStmt:
+				result.Stmts = append(result.Stmts, &DropChangeStreamStmt{Name: s.Name})
+			case *CreateModelStmt:
+				result.Stmts = append(result.Stmts, &DropModelStmt{Name: s.Name})
 			default:
 				return nil, apperr.Errorf("%s: %T: %w", s.GetNameForDiff(), s, ddl.ErrNotSupported)
 			}
 		}
+		// MEMO: Indexes, views and change streams must be dropped before the tables, and tables are dropped in the reverse order of their dependencies.
+		result.Stmts = append(result.Stmts, sortDropTableStmts(dropTableStmts)...)
+		// MEMO: Sequences are dropped after the tables that use them.
+		for _, stmt := range before.Stmts {
+			if s, ok := stmt.(*CreateSequenceStmt); ok {
+				result.Stmts = append(result.Stmts, &DropSequenceStmt{
+					Name: s.Name,
+				})
+			}
+		}
+		// MEMO: The proto bundle is dropped after the tables that use it.
+		if findProtoBundle(before) != nil {
+			result.Stmts = append(result.Stmts, &DropProtoBundleStmt{})
+		}
 		return result, nil
 	case (before == nil && after == nil) || reflect.DeepEqual(before, after) || before.String() == after.String():
 		return nil, ddl.ErrNoDifference
 	}
 
+	// CREATE PROTO BUNDLE (...); ALTER PROTO BUNDLE INSERT (...);
+	// ALTER PROTO BUNDLE DELETE (...); DROP PROTO BUNDLE;
+	// MEMO: The types must be inserted before the tables that use them are created or altered,
+	// and deleted after the tables that use them are dropped or altered.
+	protoBundleStmts, protoBundleDeleteStmts := make([]Stmt, 0), make([]Stmt, 0)
+	protoBundleDDL, err := DiffCreateProtoBundle(findProtoBundle(before), findProtoBundle(after))
+	if err == nil {
+		for _, stmt := range protoBundleDDL.Stmts {
+			switch s := stmt.(type) {
+			case *DropProtoBundleStmt:
+				protoBundleDeleteStmts = append(protoBundleDeleteStmts, s)
+			case *AlterProtoBundleStmt:
+				if len(s.Delete) > 0 {
+					protoBundleDeleteStmts = append(protoBundleDeleteStmts, s)
+					continue
+				}
+				protoBundleStmts = append(protoBundleStmts, s)
+			default:
+				protoBundleStmts = append(protoBundleStmts, s)
+			}
+		}
+	}
+	errorz.PanicOrIgnore(err, ddl.ErrNoDifference) // MEMO: DiffCreateProtoBundle does not return error except ddl.ErrNoDifference.
+
 	// DROP TABLE table_name;
+	// MEMO: Indexes, views and change streams must be dropped before the tables, and tables are dropped in the reverse order of their dependencies.
+	dropTableStmts := make([]*CreateTableStmt, 0)
 	for _, stmt := range onlyLeftStmt(before, after) {
 		switch beforeStmt := stmt.(type) {
+		case *CreateProtoBundleStmt, *CreateSequenceStmt:
+			// do nothing
 		case *CreateTableStmt:
-			result.Stmts = append(result.Stmts, &DropTableStmt{
-				Name: beforeStmt.Name,
-			})
+			if config.findRenamedTable(beforeStmt, before, after) != nil {
+				// MEMO: renamed by ALTER TABLE
+				continue
+			}
+			dropTableStmts = append(dropTableStmts, beforeStmt)
 		case *CreateIndexStmt:
 			result.Stmts = append(result.Stmts, &DropIndexStmt{
 				Name: beforeStmt.Name,
 			})
+		case *CreateSearchIndexStmt:
+			result.Stmts = append(result.Stmts, &DropSearchIndexStmt{Name: beforeStmt.Name})
+		case *CreateViewStmt:
+			result.Stmts = append(result.Stmts, &DropViewStmt{Name: beforeStmt.Name})
+		case *CreateChangeStreamStmt:
+			result.Stmts = append(result.Stmts, &DropChangeStreamStmt{Name: beforeStmt.Name})
+		case *CreateModelStmt:
+			result.Stmts = append(result.Stmts, &DropModelStmt{Name: beforeStmt.Name})
 		default:
 			return nil, apperr.Errorf("%s: %T: %w", beforeStmt.GetNameForDiff(), beforeStmt, ddl.ErrNotSupported)
 		}
 	}
+	result.Stmts = append(result.Stmts, sortDropTableStmts(dropTableStmts)...)
+
+	result.Stmts = append(result.Stmts, protoBundleStmts...)
+
+	// CREATE SEQUENCE sequence_name OPTIONS (...);
+	// ALTER SEQUENCE sequence_name SET OPTIONS (...);
+	// MEMO: Sequences must be created before the tables that use them.
+	for _, stmt := range after.Stmts {
+		if afterStmt, ok := stmt.(*CreateSequenceStmt); ok {
+			beforeStmt, _ := findStmtByTypeAndName(afterStmt, before.Stmts).(*CreateSequenceStmt)
+			alterStmt, err := DiffCreateSequence(beforeStmt, afterStmt)
+			if err == nil {
+				result.Stmts = append(result.Stmts, alterStmt.Stmts...)
+			}
+			errorz.PanicOrIgnore(err, ddl.ErrNoDifference) // MEMO: DiffCreateSequence does not return error except ddl.ErrNoDifference.
+		}
+	}
 
 	// CREATE TABLE table_name
+	// MEMO: Tables are created in the order of their interleave and foreign key dependencies, and then the indexes are created.
+	createTableStmts := make([]*CreateTableStmt, 0)
+	createIndexStmts := make([]Stmt, 0)
+	createOtherStmts := make([]Stmt, 0)
 	for _, stmt := range onlyLeftStmt(after, before) {
 		switch afterStmt := stmt.(type) {
+		case *CreateProtoBundleStmt, *CreateSequenceStmt:
+			// do nothing
 		case *CreateTableStmt:
-			result.Stmts = append(result.Stmts, afterStmt)
+			if config.isRenamedTable(afterStmt, before, after) {
+				// MEMO: renamed by ALTER TABLE
+				continue
+			}
+			createTableStmts = append(createTableStmts, afterStmt)
 		case *CreateIndexStmt:
-			result.Stmts = append(result.Stmts, afterStmt)
+			createIndexStmts = append(createIndexStmts, afterStmt)
+		case *CreateSearchIndexStmt, *CreateViewStmt, *CreateChangeStreamStmt, *CreateModelStmt:
+			createOtherStmts = append(createOtherStmts, afterStmt)
 		default:
 			return nil, apperr.Errorf("%s: %T: %w", afterStmt.GetNameForDiff(), afterStmt, ddl.ErrNotSupported)
 		}
 	}
+	sortedCreateTableStmts, addConstraintStmts := sortCreateTableStmts(createTableStmts)
+	result.Stmts = append(result.Stmts, sortedCreateTableStmts...)
+	result.Stmts = append(result.Stmts, createIndexStmts...)
+	result.Stmts = append(result.Stmts, addConstraintStmts...)
 
 	// ALTER TABLE table_name ...
 	// DROP INDEX index_name; CREATE INDEX index_name ...
+	// ALTER INDEX index_name ADD STORED COLUMN column_name;
+	// CREATE OR REPLACE VIEW view_name ...
+	// ALTER CHANGE STREAM change_stream_name ...
+	// ALTER MODEL model_name SET OPTIONS (...);
 	for _, beforeStmt := range before.Stmts {
 		switch beforeStmt := beforeStmt.(type) { //nolint:gocritic
 		case *CreateTableStmt:
-			if afterStmt := findStmtByTypeAndName(beforeStmt, after.Stmts); afterStmt != nil {
-				afterStmt := afterStmt.(*CreateTableStmt) //nolint:forcetypeassert
+			afterStmt, _ := findStmtByTypeAndName(beforeStmt, after.Stmts).(*CreateTableStmt)
+			if afterStmt == nil {
+				afterStmt = config.findRenamedTable(beforeStmt, before, after)
+			}
+			if afterStmt != nil {
 				alterStmt, err := DiffCreateTable(beforeStmt, afterStmt)
 				if err == nil {
 					result.Stmts = append(result.Stmts, alterStmt.Stmts...)
@@ -83,20 +242,63 @@ func Diff(before, after *DDL) (*DDL, error) {
 			}
 		case *CreateIndexStmt:
 			if afterStmt := findStmtByTypeAndName(beforeStmt, after.Stmts); afterStmt != nil {
-				afterStmt := afterStmt.(*CreateIndexStmt) //nolint:forcetypeassert
-				if beforeStmt.StringForDiff() != afterStmt.StringForDiff() {
-					result.Stmts = append(result.Stmts,
-						&DropIndexStmt{
-							Comment: simplediff.Diff(beforeStmt.StringForDiff(), afterStmt.StringForDiff()).String(),
-							Name:    beforeStmt.Name,
-						},
-						afterStmt,
-					)
+				alterStmt, err := DiffCreateIndex(beforeStmt, afterStmt.(*CreateIndexStmt)) //nolint:forcetypeassert
+				if err == nil {
+					result.Stmts = append(result.Stmts, alterStmt.Stmts...)
+				}
+				errorz.PanicOrIgnore(err, ddl.ErrNoDifference) // MEMO: DiffCreateIndex does not return error except ddl.ErrNoDifference.
+			}
+		case *CreateSearchIndexStmt:
+			if afterStmt, ok := findStmtByTypeAndName(beforeStmt, after.Stmts).(*CreateSearchIndexStmt); ok {
+				alterStmt, err := DiffCreateSearchIndex(beforeStmt, afterStmt)
+				if err == nil {
+					result.Stmts = append(result.Stmts, alterStmt.Stmts...)
+				}
+				errorz.PanicOrIgnore(err, ddl.ErrNoDifference) // MEMO: DiffCreateSearchIndex does not return error except ddl.ErrNoDifference.
+			}
+		case *CreateViewStmt:
+			if afterStmt, ok := findStmtByTypeAndName(beforeStmt, after.Stmts).(*CreateViewStmt); ok {
+				alterStmt, err := DiffCreateView(beforeStmt, afterStmt)
+				if err == nil {
+					result.Stmts = append(result.Stmts, alterStmt.Stmts...)
+				}
+				errorz.PanicOrIgnore(err, ddl.ErrNoDifference) // MEMO: DiffCreateView does not return error except ddl.ErrNoDifference.
+			}
+		case *CreateChangeStreamStmt:
+			if afterStmt, ok := findStmtByTypeAndName(beforeStmt, after.Stmts).(*CreateChangeStreamStmt); ok {
+				alterStmt, err := DiffCreateChangeStream(beforeStmt, afterStmt)
+				if err == nil {
+					result.Stmts = append(result.Stmts, alterStmt.Stmts...)
 				}
+				errorz.PanicOrIgnore(err, ddl.ErrNoDifference) // MEMO: DiffCreateChangeStream does not return error except ddl.ErrNoDifference.
+			}
+		case *CreateModelStmt:
+			if afterStmt, ok := findStmtByTypeAndName(beforeStmt, after.Stmts).(*CreateModelStmt); ok {
+				alterStmt, err := DiffCreateModel(beforeStmt, afterStmt)
+				if err == nil {
+					result.Stmts = append(result.Stmts, alterStmt.Stmts...)
+				}
+				errorz.PanicOrIgnore(err, ddl.ErrNoDifference) // MEMO: DiffCreateModel does not return error except ddl.ErrNoDifference.
 			}
 		}
 	}
 
+	// CREATE SEARCH INDEX, CREATE VIEW, CREATE CHANGE STREAM, CREATE MODEL
+	// MEMO: They are created after the tables are altered, because they may use the added columns.
+	result.Stmts = append(result.Stmts, createOtherStmts...)
+
+	// DROP SEQUENCE sequence_name;
+	// MEMO: Sequences are dropped after the tables that use them are dropped or altered.
+	for _, stmt := range onlyLeftStmt(before, after) {
+		if beforeStmt, ok := stmt.(*CreateSequenceStmt); ok {
+			result.Stmts = append(result.Stmts, &DropSequenceStmt{
+				Name: beforeStmt.Name,
+			})
+		}
+	}
+
+	result.Stmts = append(result.Stmts, protoBundleDeleteStmts...)
+
 	if len(result.Stmts) == 0 {
 		return nil, ddl.ErrNoDifference
 	}
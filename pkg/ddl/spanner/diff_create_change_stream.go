@@ -0,0 +1,48 @@
+package spanner
+
+import (
+	"github.com/kunitsucom/util.go/exp/diff/simplediff"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+// DiffCreateChangeStream returns the statements to change the change stream from before to after.
+// The watched tables and columns are changed by SET FOR or DROP FOR ALL, and the options are changed by SET OPTIONS.
+func DiffCreateChangeStream(before, after *CreateChangeStreamStmt) (*DDL, error) {
+	if before.StringForDiff() == after.StringForDiff() {
+		return nil, ddl.ErrNoDifference
+	}
+
+	result := &DDL{}
+	comment := simplediff.Diff(before.StringForDiff(), after.StringForDiff()).String()
+
+	if changeStreamForStringForDiff(before.ForAll, before.For) != changeStreamForStringForDiff(after.ForAll, after.For) {
+		if after.ForAll || len(after.For) > 0 {
+			// ALTER CHANGE STREAM change_stream_name SET FOR ...;
+			result.Stmts = append(result.Stmts, &AlterChangeStreamStmt{
+				Comment: comment,
+				Name:    after.Name,
+				Action:  &SetChangeStreamFor{ForAll: after.ForAll, For: after.For},
+			})
+		} else {
+			// ALTER CHANGE STREAM change_stream_name DROP FOR ALL;
+			result.Stmts = append(result.Stmts, &AlterChangeStreamStmt{
+				Comment: comment,
+				Name:    after.Name,
+				Action:  &DropChangeStreamForAll{},
+			})
+		}
+		comment = ""
+	}
+
+	if optionsStringForDiff(before.Options) != optionsStringForDiff(after.Options) {
+		// ALTER CHANGE STREAM change_stream_name SET OPTIONS (...);
+		result.Stmts = append(result.Stmts, &AlterChangeStreamStmt{
+			Comment: comment,
+			Name:    after.Name,
+			Action:  &SetChangeStreamOptions{Options: diffOptions(before.Options, after.Options)},
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,54 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestDiffCreateChangeStream(t *testing.T) {
+	t.Parallel()
+
+	parse := func(t *testing.T, s string) *CreateChangeStreamStmt {
+		t.Helper()
+		d, err := NewParser(NewLexer(s)).Parse()
+		require.NoError(t, err)
+		return d.Stmts[0].(*CreateChangeStreamStmt) //nolint:forcetypeassert
+	}
+
+	t.Run("success,no_difference", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE CHANGE STREAM SingerStream FOR Singers(Name, Age), Albums;`)
+		after := parse(t, `CREATE CHANGE STREAM SingerStream FOR Albums, Singers(Age, Name);`)
+		_, err := DiffCreateChangeStream(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,SET_FOR_and_SET_OPTIONS", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE CHANGE STREAM SingerStream FOR Singers OPTIONS (retention_period = '36h');`)
+		after := parse(t, `CREATE CHANGE STREAM SingerStream FOR Singers, Albums(Title) OPTIONS (value_capture_type = 'NEW_VALUES');`)
+		actual, err := DiffCreateChangeStream(before, after)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(actual.Stmts))
+		assert.Equal(t, &SetChangeStreamFor{For: after.For}, actual.Stmts[0].(*AlterChangeStreamStmt).Action)                                                                              //nolint:forcetypeassert
+		assert.Equal(t, "ALTER CHANGE STREAM SingerStream SET OPTIONS (value_capture_type = 'NEW_VALUES', retention_period = NULL);\n", actual.Stmts[1].(*AlterChangeStreamStmt).String()) //nolint:forcetypeassert
+	})
+
+	t.Run("success,DROP_FOR_ALL", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE CHANGE STREAM SingerStream FOR ALL;`)
+		after := parse(t, `CREATE CHANGE STREAM SingerStream;`)
+		actual, err := DiffCreateChangeStream(before, after)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(actual.Stmts))
+		assert.Equal(t, "SingerStream", actual.Stmts[0].GetNameForDiff())
+		assert.Equal(t, &DropChangeStreamForAll{}, actual.Stmts[0].(*AlterChangeStreamStmt).Action) //nolint:forcetypeassert
+	})
+}
@@ -0,0 +1,65 @@
+package spanner
+
+import (
+	"github.com/kunitsucom/util.go/exp/diff/simplediff"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+// DiffCreateIndex returns the statements to change the index from before to after.
+// If only the stored columns are changed, the index is altered instead of being recreated.
+func DiffCreateIndex(before, after *CreateIndexStmt) (*DDL, error) {
+	if before.StringForDiff() == after.StringForDiff() {
+		return nil, ddl.ErrNoDifference
+	}
+
+	result := &DDL{}
+
+	if withoutStoring(before).StringForDiff() != withoutStoring(after).StringForDiff() {
+		result.Stmts = append(result.Stmts,
+			&DropIndexStmt{
+				Comment: simplediff.Diff(before.StringForDiff(), after.StringForDiff()).String(),
+				Name:    before.Name,
+			},
+			after,
+		)
+		return result, nil
+	}
+
+	// ALTER INDEX index_name DROP STORED COLUMN column_name;
+	for _, c := range before.Storing {
+		if !containsIdent(after.Storing, c) {
+			result.Stmts = append(result.Stmts, &AlterIndexStmt{
+				Name:   after.Name,
+				Action: &DropStoredColumn{Name: c},
+			})
+		}
+	}
+
+	// ALTER INDEX index_name ADD STORED COLUMN column_name;
+	for _, c := range after.Storing {
+		if !containsIdent(before.Storing, c) {
+			result.Stmts = append(result.Stmts, &AlterIndexStmt{
+				Name:   after.Name,
+				Action: &AddStoredColumn{Name: c},
+			})
+		}
+	}
+
+	return result, nil
+}
+
+func withoutStoring(stmt *CreateIndexStmt) *CreateIndexStmt {
+	s := *stmt
+	s.Storing = nil
+	return &s
+}
+
+func containsIdent(idents []*Ident, ident *Ident) bool {
+	for _, i := range idents {
+		if i.StringForDiff() == ident.StringForDiff() {
+			return true
+		}
+	}
+	return false
+}
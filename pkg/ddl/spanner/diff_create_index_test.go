@@ -0,0 +1,54 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestDiffCreateIndex(t *testing.T) {
+	t.Parallel()
+
+	parse := func(t *testing.T, s string) *CreateIndexStmt {
+		t.Helper()
+		d, err := NewParser(NewLexer(s)).Parse()
+		require.NoError(t, err)
+		return d.Stmts[0].(*CreateIndexStmt) //nolint:forcetypeassert
+	}
+
+	t.Run("success,STORING_order", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE INDEX SingersByName ON Singers (LastName) STORING (FirstName, Age);`)
+		after := parse(t, `CREATE INDEX SingersByName ON Singers (LastName) STORING (Age, FirstName);`)
+		_, err := DiffCreateIndex(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,ALTER_INDEX", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE INDEX SingersByName ON Singers (LastName) STORING (FirstName, Age);`)
+		after := parse(t, `CREATE INDEX SingersByName ON Singers (LastName) STORING (FirstName, Country);`)
+		actual, err := DiffCreateIndex(before, after)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER INDEX SingersByName DROP STORED COLUMN Age;
+ALTER INDEX SingersByName ADD STORED COLUMN Country;
+`, actual.String())
+	})
+
+	t.Run("success,DROP_CREATE", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE INDEX SingersByName ON Singers (LastName) STORING (FirstName);`)
+		after := parse(t, `CREATE NULL_FILTERED INDEX SingersByName ON Singers (LastName) STORING (FirstName), INTERLEAVE IN Singers;`)
+		actual, err := DiffCreateIndex(before, after)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(actual.Stmts))
+		assert.Equal(t, "SingersByName", actual.Stmts[0].(*DropIndexStmt).Name.String()) //nolint:forcetypeassert
+		assert.Equal(t, after, actual.Stmts[1])
+	})
+}
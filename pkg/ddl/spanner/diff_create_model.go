@@ -0,0 +1,35 @@
+package spanner
+
+import (
+	"github.com/kunitsucom/util.go/exp/diff/simplediff"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+// DiffCreateModel returns the statements to change the model from before to after.
+// If only the options are changed, the model is altered by ALTER MODEL ... SET OPTIONS,
+// otherwise it is replaced by CREATE OR REPLACE MODEL.
+func DiffCreateModel(before, after *CreateModelStmt) (*DDL, error) {
+	beforeOptions, afterOptions := optionsStringForDiff(before.Options), optionsStringForDiff(after.Options)
+
+	switch {
+	case before.StringForDiff() != after.StringForDiff():
+		replace := *after
+		replace.Comment = simplediff.Diff(before.StringForDiff()+" "+beforeOptions, after.StringForDiff()+" "+afterOptions).String()
+		replace.OrReplace = true
+		replace.IfNotExists = false
+		return &DDL{Stmts: []Stmt{&replace}}, nil
+	case beforeOptions != afterOptions:
+		return &DDL{
+			Stmts: []Stmt{
+				&AlterModelStmt{
+					Comment: simplediff.Diff(beforeOptions, afterOptions).String(),
+					Name:    after.Name,
+					Options: diffOptions(before.Options, after.Options),
+				},
+			},
+		}, nil
+	default:
+		return nil, ddl.ErrNoDifference
+	}
+}
@@ -0,0 +1,55 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestDiffCreateModel(t *testing.T) {
+	t.Parallel()
+
+	parse := func(t *testing.T, s string) *CreateModelStmt {
+		t.Helper()
+		d, err := NewParser(NewLexer(s)).Parse()
+		require.NoError(t, err)
+		return d.Stmts[0].(*CreateModelStmt) //nolint:forcetypeassert
+	}
+
+	t.Run("success,no_difference", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE MODEL M INPUT (content STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE OPTIONS (endpoint = '//x');`)
+		after := parse(t, `CREATE MODEL IF NOT EXISTS M INPUT (content STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE OPTIONS (endpoint = '//x');`)
+		_, err := DiffCreateModel(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,ALTER_MODEL", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE MODEL M INPUT (content STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE OPTIONS (endpoint = '//x');`)
+		after := parse(t, `CREATE MODEL M INPUT (content STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE OPTIONS (endpoint = '//y');`)
+		actual, err := DiffCreateModel(before, after)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(actual.Stmts))
+		assert.Equal(t, "(endpoint = '//y')", actual.Stmts[0].(*AlterModelStmt).Options.String()) //nolint:forcetypeassert
+	})
+
+	t.Run("success,CREATE_OR_REPLACE", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE MODEL M INPUT (content STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE OPTIONS (endpoint = '//x');`)
+		after := parse(t, `CREATE MODEL IF NOT EXISTS M INPUT (content STRING(MAX), title STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE OPTIONS (endpoint = '//x');`)
+		actual, err := DiffCreateModel(before, after)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(actual.Stmts))
+		replace := actual.Stmts[0].(*CreateModelStmt) //nolint:forcetypeassert
+		assert.Equal(t, true, replace.OrReplace)
+		assert.Equal(t, false, replace.IfNotExists)
+		assert.Equal(t, after.StringForDiff(), replace.StringForDiff())
+	})
+}
@@ -0,0 +1,67 @@
+package spanner
+
+import (
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+// DiffCreateProtoBundle returns the DDL to migrate the proto bundle from before to after.
+//
+// The added types are inserted by ALTER PROTO BUNDLE INSERT, and the removed types are deleted by ALTER PROTO BUNDLE DELETE.
+// MEMO: They are returned as the separate statements, because the types must be inserted before the columns that use them are added,
+// and deleted after the columns that use them are dropped.
+func DiffCreateProtoBundle(before, after *CreateProtoBundleStmt) (*DDL, error) {
+	result := &DDL{}
+
+	switch {
+	case before == nil && after != nil:
+		// CREATE PROTO BUNDLE (...);
+		result.Stmts = append(result.Stmts, after)
+		return result, nil
+	case before != nil && after == nil:
+		// DROP PROTO BUNDLE;
+		result.Stmts = append(result.Stmts, &DropProtoBundleStmt{})
+		return result, nil
+	case (before == nil && after == nil) || before.StringForDiff() == after.StringForDiff():
+		return nil, ddl.ErrNoDifference
+	}
+
+	// ALTER PROTO BUNDLE INSERT (...);
+	if inserted := onlyLeftProtoTypes(after, before); len(inserted) > 0 {
+		result.Stmts = append(result.Stmts, &AlterProtoBundleStmt{Insert: inserted})
+	}
+
+	// ALTER PROTO BUNDLE DELETE (...);
+	if deleted := onlyLeftProtoTypes(before, after); len(deleted) > 0 {
+		result.Stmts = append(result.Stmts, &AlterProtoBundleStmt{Delete: deleted})
+	}
+
+	return result, nil
+}
+
+func onlyLeftProtoTypes(left, right *CreateProtoBundleStmt) []*Ident {
+	result := make([]*Ident, 0)
+
+LabelLeft:
+	for _, l := range left.Types {
+		for _, r := range right.Types {
+			if l.StringForDiff() == r.StringForDiff() {
+				continue LabelLeft
+			}
+		}
+		result = append(result, l)
+	}
+
+	return result
+}
+
+func findProtoBundle(d *DDL) *CreateProtoBundleStmt {
+	if d == nil {
+		return nil
+	}
+	for _, stmt := range d.Stmts {
+		if s, ok := stmt.(*CreateProtoBundleStmt); ok {
+			return s
+		}
+	}
+	return nil
+}
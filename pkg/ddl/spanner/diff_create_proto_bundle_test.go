@@ -0,0 +1,60 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestDiffCreateProtoBundle(t *testing.T) {
+	t.Parallel()
+
+	parse := func(t *testing.T, s string) *CreateProtoBundleStmt {
+		t.Helper()
+		d, err := NewParser(NewLexer(s)).Parse()
+		require.NoError(t, err)
+		return d.Stmts[0].(*CreateProtoBundleStmt) //nolint:forcetypeassert
+	}
+
+	t.Run("success,CREATE", func(t *testing.T) {
+		t.Parallel()
+
+		after := parse(t, `CREATE PROTO BUNDLE (examples.music.SingerInfo);`)
+		actual, err := DiffCreateProtoBundle(nil, after)
+		require.NoError(t, err)
+		assert.Equal(t, after.String(), actual.String())
+	})
+
+	t.Run("success,DROP", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE PROTO BUNDLE (examples.music.SingerInfo);`)
+		actual, err := DiffCreateProtoBundle(before, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "DROP PROTO BUNDLE;\n", actual.String())
+	})
+
+	t.Run("success,order", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE PROTO BUNDLE (examples.music.SingerInfo, examples.music.Genre);`)
+		after := parse(t, "CREATE PROTO BUNDLE (`examples.music.Genre`, examples.music.SingerInfo);")
+		_, err := DiffCreateProtoBundle(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,ALTER", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE PROTO BUNDLE (examples.music.SingerInfo, examples.music.Genre);`)
+		after := parse(t, `CREATE PROTO BUNDLE (examples.music.SingerInfo, examples.music.Album, examples.music.Song);`)
+		actual, err := DiffCreateProtoBundle(before, after)
+		require.NoError(t, err)
+		assert.Equal(t, `ALTER PROTO BUNDLE INSERT (examples.music.Album, examples.music.Song);
+ALTER PROTO BUNDLE DELETE (examples.music.Genre);
+`, actual.String())
+	})
+}
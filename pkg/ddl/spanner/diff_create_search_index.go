@@ -0,0 +1,25 @@
+package spanner
+
+import (
+	"github.com/kunitsucom/util.go/exp/diff/simplediff"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+// DiffCreateSearchIndex returns the statements to change the search index from before to after.
+// The search index is dropped and created, because only its stored columns can be altered.
+func DiffCreateSearchIndex(before, after *CreateSearchIndexStmt) (*DDL, error) {
+	if before.StringForDiff() == after.StringForDiff() {
+		return nil, ddl.ErrNoDifference
+	}
+
+	return &DDL{
+		Stmts: []Stmt{
+			&DropSearchIndexStmt{
+				Comment: simplediff.Diff(before.StringForDiff(), after.StringForDiff()).String(),
+				Name:    before.Name,
+			},
+			after,
+		},
+	}, nil
+}
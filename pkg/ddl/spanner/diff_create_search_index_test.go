@@ -0,0 +1,42 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestDiffCreateSearchIndex(t *testing.T) {
+	t.Parallel()
+
+	parse := func(t *testing.T, s string) *CreateSearchIndexStmt {
+		t.Helper()
+		d, err := NewParser(NewLexer(s)).Parse()
+		require.NoError(t, err)
+		return d.Stmts[0].(*CreateSearchIndexStmt) //nolint:forcetypeassert
+	}
+
+	t.Run("success,no_difference", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE SEARCH INDEX AlbumsIndex ON Albums (AlbumTitle_Tokens) STORING (Genre, Cover);`)
+		after := parse(t, `CREATE SEARCH INDEX AlbumsIndex ON Albums (AlbumTitle_Tokens) STORING (Cover, Genre);`)
+		_, err := DiffCreateSearchIndex(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,DROP_CREATE", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE SEARCH INDEX AlbumsIndex ON Albums (AlbumTitle_Tokens);`)
+		after := parse(t, `CREATE SEARCH INDEX AlbumsIndex ON Albums (AlbumTitle_Tokens, Rating_Tokens);`)
+		actual, err := DiffCreateSearchIndex(before, after)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(actual.Stmts))
+		assert.Equal(t, "AlbumsIndex", actual.Stmts[0].(*DropSearchIndexStmt).Name.String()) //nolint:forcetypeassert
+		assert.Equal(t, after, actual.Stmts[1])
+	})
+}
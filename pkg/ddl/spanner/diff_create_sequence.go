@@ -0,0 +1,66 @@
+package spanner
+
+import (
+	"github.com/kunitsucom/util.go/exp/diff/simplediff"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+// DiffCreateSequence returns the DDL to migrate the sequence from before to after.
+//
+// The changed options are applied by ALTER SEQUENCE ... SET OPTIONS,
+// and the options removed from after are reset to NULL.
+func DiffCreateSequence(before, after *CreateSequenceStmt) (*DDL, error) {
+	result := &DDL{}
+
+	switch {
+	case before == nil && after != nil:
+		// CREATE SEQUENCE sequence_name OPTIONS (...);
+		result.Stmts = append(result.Stmts, after)
+		return result, nil
+	case before != nil && after == nil:
+		// DROP SEQUENCE sequence_name;
+		result.Stmts = append(result.Stmts, &DropSequenceStmt{
+			Name: before.Name,
+		})
+		return result, nil
+	case (before == nil && after == nil) || before.StringForDiff() == after.StringForDiff():
+		return nil, ddl.ErrNoDifference
+	}
+
+	// ALTER SEQUENCE sequence_name SET OPTIONS (...);
+	result.Stmts = append(result.Stmts, &AlterSequenceStmt{
+		Comment: simplediff.Diff(before.StringForDiff(), after.StringForDiff()).String(),
+		Name:    after.Name,
+		Options: diffOptions(before.Options, after.Options),
+	})
+
+	return result, nil
+}
+
+// diffOptions returns the options for SET OPTIONS to migrate the options from before to after.
+// The options removed from after are reset to NULL.
+func diffOptions(before, after *Expr) *Expr {
+	options := &Expr{}
+	afterOptions := optionsForDiff(after)
+	if after != nil && len(after.Idents) > 0 {
+		options = options.Append(after.Idents[:len(after.Idents)-1]...) // MEMO: without the last ")"
+	} else {
+		options = options.Append(NewRawIdent("("))
+	}
+LabelRemovedOptions:
+	for _, beforeOption := range optionsForDiff(before) {
+		for _, afterOption := range afterOptions {
+			if beforeOption.Name == afterOption.Name {
+				continue LabelRemovedOptions
+			}
+		}
+		if len(options.Idents) > 1 {
+			options = options.Append(NewRawIdent(","))
+		}
+		options = options.Append(NewRawIdent(beforeOption.Name), NewRawIdent("="), NewRawIdent(string(TOKEN_NULL)))
+	}
+	options = options.Append(NewRawIdent(")"))
+
+	return options
+}
@@ -0,0 +1,56 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestDiffCreateSequence(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,before,nil", func(t *testing.T) {
+		t.Parallel()
+
+		after := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("MySequence")}}
+		actual, err := DiffCreateSequence(nil, after)
+		require.NoError(t, err)
+		assert.Equal(t, "CREATE SEQUENCE MySequence;\n", actual.String())
+	})
+
+	t.Run("success,after,nil", func(t *testing.T) {
+		t.Parallel()
+
+		before := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("MySequence")}}
+		actual, err := DiffCreateSequence(before, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "DROP SEQUENCE MySequence;\n", actual.String())
+	})
+
+	t.Run("success,RemoveAllOptions", func(t *testing.T) {
+		t.Parallel()
+
+		before := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("MySequence")}, Options: &Expr{Idents: []*Ident{
+			NewRawIdent("("), NewRawIdent("skip_range_min"), NewRawIdent("="), NewRawIdent("1"), NewRawIdent(")"),
+		}}}
+		after := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("MySequence")}}
+		actual, err := DiffCreateSequence(before, after)
+		require.NoError(t, err)
+		assert.Equal(t, `-- -CREATE SEQUENCE MySequence OPTIONS (skip_range_min = 1)
+-- +CREATE SEQUENCE MySequence OPTIONS ()
+ALTER SEQUENCE MySequence SET OPTIONS (skip_range_min = NULL);
+`, actual.String())
+	})
+
+	t.Run("failure,ddl.ErrNoDifference", func(t *testing.T) {
+		t.Parallel()
+
+		before := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("MySequence")}}
+		after := &CreateSequenceStmt{Name: &ObjectName{Name: NewRawIdent("MySequence")}}
+		_, err := DiffCreateSequence(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+}
@@ -191,6 +191,25 @@ func (config *DiffCreateTableConfig) diffCreateTableColumn(ddls *DDL, before, af
 			continue
 		}
 
+		if beforeColumn.generatedForDiff() != afterColumn.generatedForDiff() {
+			// MEMO: The generated column cannot be altered, so it is dropped and added.
+			// ALTER TABLE table_name DROP COLUMN column_name;
+			// ALTER TABLE table_name ADD COLUMN column_name data_type AS (expr);
+			ddls.Stmts = append(ddls.Stmts, &AlterTableStmt{
+				Comment: simplediff.Diff(beforeColumn.String(), afterColumn.String()).String(),
+				Name:    after.Name,
+				Action: &DropColumn{
+					Name: beforeColumn.Name,
+				},
+			}, &AlterTableStmt{
+				Name: after.Name,
+				Action: &AddColumn{
+					Column: afterColumn,
+				},
+			})
+			continue
+		}
+
 		if beforeColumn.DataType.StringForDiff() != afterColumn.DataType.StringForDiff() ||
 			beforeColumn.NotNull != afterColumn.NotNull {
 			// ALTER TABLE table_name ALTER COLUMN column_name data_type NOT NULL;
@@ -198,9 +217,10 @@ func (config *DiffCreateTableConfig) diffCreateTableColumn(ddls *DDL, before, af
 				Comment: simplediff.Diff(beforeColumn.String(), afterColumn.String()).String(),
 				Name:    after.Name,
 				Action: &AlterColumnDataType{
-					Name:     afterColumn.Name,
-					DataType: afterColumn.DataType,
-					NotNull:  afterColumn.NotNull,
+					Name:        afterColumn.Name,
+					DataType:    afterColumn.DataType,
+					OldDataType: beforeColumn.DataType,
+					NotNull:     afterColumn.NotNull,
 				},
 			})
 		}
@@ -0,0 +1,20 @@
+package spanner
+
+import (
+	"github.com/kunitsucom/util.go/exp/diff/simplediff"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+// DiffCreateView returns the statements to change the view from before to after by CREATE OR REPLACE VIEW.
+func DiffCreateView(before, after *CreateViewStmt) (*DDL, error) {
+	if before.StringForDiff() == after.StringForDiff() {
+		return nil, ddl.ErrNoDifference
+	}
+
+	replace := *after
+	replace.Comment = simplediff.Diff(before.StringForDiff(), after.StringForDiff()).String()
+	replace.OrReplace = true
+
+	return &DDL{Stmts: []Stmt{&replace}}, nil
+}
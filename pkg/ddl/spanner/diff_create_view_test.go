@@ -0,0 +1,43 @@
+package spanner
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestDiffCreateView(t *testing.T) {
+	t.Parallel()
+
+	parse := func(t *testing.T, s string) *CreateViewStmt {
+		t.Helper()
+		d, err := NewParser(NewLexer(s)).Parse()
+		require.NoError(t, err)
+		return d.Stmts[0].(*CreateViewStmt) //nolint:forcetypeassert
+	}
+
+	t.Run("success,no_difference", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Name FROM Singers;`)
+		after := parse(t, `CREATE OR REPLACE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Name FROM Singers;`)
+		_, err := DiffCreateView(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,CREATE_OR_REPLACE", func(t *testing.T) {
+		t.Parallel()
+
+		before := parse(t, `CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Name FROM Singers;`)
+		after := parse(t, `CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Name, Age FROM Singers;`)
+		actual, err := DiffCreateView(before, after)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(actual.Stmts))
+		replace := actual.Stmts[0].(*CreateViewStmt) //nolint:forcetypeassert
+		assert.Equal(t, true, replace.OrReplace)
+		assert.Equal(t, after.StringForDiff(), replace.StringForDiff())
+	})
+}
@@ -0,0 +1,31 @@
+package spanner
+
+// findRenamedTable returns the table in after that the rename hints declare stmt is renamed to.
+func (config *DiffConfig) findRenamedTable(stmt *CreateTableStmt, before, after *DDL) *CreateTableStmt {
+	if len(config.Renames) == 0 || findStmtByTypeAndName(stmt, after.Stmts) != nil {
+		return nil
+	}
+	for _, s := range onlyLeftStmt(after, before) {
+		if afterStmt, ok := s.(*CreateTableStmt); ok && config.Renames.IsRenamed(objectNamePath(stmt.Name), objectNamePath(afterStmt.Name)) {
+			return afterStmt
+		}
+	}
+	return nil
+}
+
+// isRenamedTable reports whether the rename hints declare that a table in before is renamed to stmt.
+func (config *DiffConfig) isRenamedTable(stmt *CreateTableStmt, before, after *DDL) bool {
+	for _, s := range onlyLeftStmt(before, after) {
+		if beforeStmt, ok := s.(*CreateTableStmt); ok && config.findRenamedTable(beforeStmt, before, after) == stmt {
+			return true
+		}
+	}
+	return false
+}
+
+func objectNamePath(name *ObjectName) []string {
+	if name.Schema != nil {
+		return []string{name.Schema.Name, name.Name.Name}
+	}
+	return []string{name.Name.Name}
+}
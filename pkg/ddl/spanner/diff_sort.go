@@ -0,0 +1,113 @@
+package spanner
+
+import (
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// sortCreateTableStmts sorts the tables so that the parent tables of INTERLEAVE IN PARENT and
+// the tables referenced by foreign keys are created first.
+//
+// If the tables reference each other, the foreign keys that cannot be satisfied yet are removed from
+// CREATE TABLE and returned as ALTER TABLE ... ADD CONSTRAINT to be executed after all the tables are created.
+func sortCreateTableStmts(stmts []*CreateTableStmt) (createTableStmts []Stmt, addConstraintStmts []Stmt) {
+	order, broken := internal.TopologicalSort(len(stmts), func(i, j int) bool {
+		return dependsOn(stmts[i], stmts[j])
+	})
+
+	deferred := make(map[*ForeignKeyConstraint]bool)
+	for _, b := range broken {
+		for _, fk := range foreignKeysReferencing(stmts[b[0]], stmts[b[1]].Name) {
+			deferred[fk] = true
+		}
+	}
+
+	for _, i := range order {
+		stmt := stmts[i]
+		if len(deferred) > 0 {
+			createTableStmt := *stmt
+			createTableStmt.Constraints = make(Constraints, 0, len(stmt.Constraints))
+			for _, c := range stmt.Constraints {
+				if fk, ok := c.(*ForeignKeyConstraint); ok && deferred[fk] {
+					addConstraintStmts = append(addConstraintStmts, &AlterTableStmt{
+						Name:   stmt.Name,
+						Action: &AddConstraint{Constraint: fk},
+					})
+					continue
+				}
+				createTableStmt.Constraints = append(createTableStmt.Constraints, c)
+			}
+			stmt = &createTableStmt
+		}
+		createTableStmts = append(createTableStmts, stmt)
+	}
+
+	return createTableStmts, addConstraintStmts
+}
+
+// sortDropTableStmts returns DROP TABLE in the reverse order of sortCreateTableStmts,
+// so that the interleaved tables and the tables referencing others are dropped first.
+//
+// If the tables reference each other, the foreign keys are dropped by ALTER TABLE ... DROP CONSTRAINT beforehand.
+func sortDropTableStmts(stmts []*CreateTableStmt) []Stmt {
+	order, broken := internal.TopologicalSort(len(stmts), func(i, j int) bool {
+		return dependsOn(stmts[i], stmts[j])
+	})
+
+	dropStmts := make([]Stmt, 0, len(stmts))
+	for _, b := range broken {
+		for _, fk := range foreignKeysReferencing(stmts[b[0]], stmts[b[1]].Name) {
+			dropStmts = append(dropStmts, &AlterTableStmt{
+				Name:   stmts[b[0]].Name,
+				Action: &DropConstraint{Name: fk.Name},
+			})
+		}
+	}
+	for i := len(order) - 1; i >= 0; i-- {
+		dropStmts = append(dropStmts, &DropTableStmt{
+			Name: stmts[order[i]].Name,
+		})
+	}
+
+	return dropStmts
+}
+
+// dependsOn reports whether stmt is interleaved in or references the table of dependency.
+//
+// MEMO: INTERLEAVE IN PARENT cannot form a cycle, so only foreign keys are broken when the tables reference each other.
+func dependsOn(stmt, dependency *CreateTableStmt) bool {
+	if parent := interleaveParent(stmt); parent != nil && isReferenceTo(parent, dependency.Name) {
+		return true
+	}
+	return len(foreignKeysReferencing(stmt, dependency.Name)) > 0
+}
+
+// interleaveParent returns the parent table of INTERLEAVE IN PARENT, or nil if stmt is not interleaved.
+func interleaveParent(stmt *CreateTableStmt) *Ident {
+	for _, opt := range stmt.Options {
+		if opt.Name == "INTERLEAVE IN PARENT" && opt.Value != nil && len(opt.Value.Idents) > 0 {
+			return opt.Value.Idents[0]
+		}
+	}
+	return nil
+}
+
+// foreignKeysReferencing returns the foreign keys of stmt that reference the table.
+func foreignKeysReferencing(stmt *CreateTableStmt, table *ObjectName) []*ForeignKeyConstraint {
+	fks := make([]*ForeignKeyConstraint, 0)
+	for _, c := range stmt.Constraints {
+		if fk, ok := c.(*ForeignKeyConstraint); ok && isReferenceTo(fk.Ref, table) {
+			fks = append(fks, fk)
+		}
+	}
+	return fks
+}
+
+// isReferenceTo reports whether ref refers to the table.
+// If either of them is not qualified by a schema, only the table names are compared.
+func isReferenceTo(ref *Ident, table *ObjectName) bool {
+	refName := NewObjectName(ref.Raw)
+	if refName.Schema == nil || table.Schema == nil {
+		return refName.Name.StringForDiff() == table.Name.StringForDiff()
+	}
+	return refName.StringForDiff() == table.StringForDiff()
+}
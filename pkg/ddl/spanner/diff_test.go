@@ -446,4 +446,268 @@ ALTER TABLE public.users ALTER COLUMN username STRING(11) NOT NULL;
 			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
 		}
 	})
+
+	t.Run("success,before,nil,Sequence", func(t *testing.T) {
+		t.Parallel()
+
+		after, err := NewParser(NewLexer(`CREATE TABLE Users (Id INT64 NOT NULL DEFAULT (GET_NEXT_SEQUENCE_VALUE(SEQUENCE UserIdSequence))) PRIMARY KEY (Id); CREATE SEQUENCE UserIdSequence OPTIONS (sequence_kind = 'bit_reversed_positive');`)).Parse()
+		require.NoError(t, err)
+
+		expected := `CREATE SEQUENCE UserIdSequence OPTIONS (sequence_kind = 'bit_reversed_positive');
+`
+		actual, err := Diff(nil, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.Stmts[0].String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,after,nil,Sequence", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE SEQUENCE UserIdSequence OPTIONS (sequence_kind = 'bit_reversed_positive'); CREATE TABLE Users (Id INT64 NOT NULL) PRIMARY KEY (Id);`)).Parse()
+		require.NoError(t, err)
+
+		expected := `DROP TABLE Users;
+DROP SEQUENCE UserIdSequence;
+`
+		actual, err := Diff(before, nil)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,Sequence", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE SEQUENCE UserIdSequence OPTIONS (sequence_kind = 'bit_reversed_positive', skip_range_min = 1, skip_range_max = 1000); CREATE SEQUENCE OldSequence OPTIONS (sequence_kind = 'bit_reversed_positive');`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE SEQUENCE UserIdSequence OPTIONS (start_with_counter = 100, sequence_kind = 'bit_reversed_positive'); CREATE SEQUENCE NewSequence OPTIONS (sequence_kind = 'bit_reversed_positive');`)).Parse()
+		require.NoError(t, err)
+
+		expected := `-- -CREATE SEQUENCE UserIdSequence OPTIONS (sequence_kind = bit_reversed_positive, skip_range_max = 1000, skip_range_min = 1)
+-- +CREATE SEQUENCE UserIdSequence OPTIONS (sequence_kind = bit_reversed_positive, start_with_counter = 100)
+ALTER SEQUENCE UserIdSequence SET OPTIONS (start_with_counter = 100, sequence_kind = 'bit_reversed_positive', skip_range_max = NULL, skip_range_min = NULL);
+CREATE SEQUENCE NewSequence OPTIONS (sequence_kind = 'bit_reversed_positive');
+DROP SEQUENCE OldSequence;
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,Sequence,NoDifference", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE SEQUENCE UserIdSequence OPTIONS (sequence_kind = 'bit_reversed_positive', skip_range_min = 1, skip_range_max = 1000);`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE SEQUENCE UserIdSequence OPTIONS (skip_range_max = 1000, skip_range_min = 1, sequence_kind = "bit_reversed_positive");`)).Parse()
+		require.NoError(t, err)
+
+		_, err = Diff(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,before,nil,ProtoBundle", func(t *testing.T) {
+		t.Parallel()
+
+		after, err := NewParser(NewLexer(`CREATE SEQUENCE UserIdSequence; CREATE TABLE Singers (SingerId INT64 NOT NULL, SingerInfo examples.music.SingerInfo) PRIMARY KEY (SingerId); CREATE PROTO BUNDLE (examples.music.SingerInfo);`)).Parse()
+		require.NoError(t, err)
+
+		expected := `CREATE PROTO BUNDLE (
+    examples.music.SingerInfo
+);
+CREATE SEQUENCE UserIdSequence;
+CREATE TABLE Singers (
+    SingerId INT64 NOT NULL,
+    SingerInfo examples.music.SingerInfo
+) PRIMARY KEY (SingerId);
+`
+		actual, err := Diff(nil, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+
+		expected = `DROP TABLE Singers;
+DROP SEQUENCE UserIdSequence;
+DROP PROTO BUNDLE;
+`
+		actual, err = Diff(after, nil)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,ProtoBundle", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE PROTO BUNDLE (examples.music.SingerInfo, examples.music.Genre); CREATE TABLE Singers (SingerId INT64 NOT NULL, SingerGenre ENUM<examples.music.Genre>) PRIMARY KEY (SingerId);`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE PROTO BUNDLE (examples.music.SingerInfo, examples.music.Album); CREATE TABLE Singers (SingerId INT64 NOT NULL, Albums ARRAY<examples.music.Album>) PRIMARY KEY (SingerId);`)).Parse()
+		require.NoError(t, err)
+
+		expected := `ALTER PROTO BUNDLE INSERT (examples.music.Album);
+-- -SingerGenre ENUM<examples.music.Genre>
+-- +
+ALTER TABLE Singers DROP COLUMN SingerGenre;
+-- -
+-- +Albums ARRAY<examples.music.Album>
+ALTER TABLE Singers ADD COLUMN Albums ARRAY<examples.music.Album>;
+ALTER PROTO BUNDLE DELETE (examples.music.Genre);
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,ArrayAndProto,NoDifference", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE Singers (SingerId INT64 NOT NULL, Tags ARRAY<STRING(MAX)>, Embedding ARRAY<FLOAT32>(vector_length=>768), SingerInfo PROTO<examples.music.SingerInfo>) PRIMARY KEY (SingerId);`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TABLE Singers (SingerId INT64 NOT NULL, Tags ARRAY<STRING(MAX)>, Embedding ARRAY<FLOAT32>(VECTOR_LENGTH=>768), SingerInfo ` + "`examples.music.SingerInfo`" + `) PRIMARY KEY (SingerId);`)).Parse()
+		require.NoError(t, err)
+
+		_, err = Diff(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,before,nil,Interleave,ForeignKey", func(t *testing.T) {
+		t.Parallel()
+
+		after, err := NewParser(NewLexer(`CREATE TABLE Albums (SingerId INT64 NOT NULL, AlbumId INT64 NOT NULL) PRIMARY KEY (SingerId, AlbumId), INTERLEAVE IN PARENT Singers ON DELETE CASCADE; CREATE TABLE Singers (SingerId INT64 NOT NULL, FavoriteLabelId INT64, CONSTRAINT FK_Singers_Labels FOREIGN KEY (FavoriteLabelId) REFERENCES Labels (LabelId)) PRIMARY KEY (SingerId); CREATE TABLE Labels (LabelId INT64 NOT NULL, OwnerId INT64, CONSTRAINT FK_Labels_Singers FOREIGN KEY (OwnerId) REFERENCES Singers (SingerId)) PRIMARY KEY (LabelId);`)).Parse()
+		require.NoError(t, err)
+
+		expected := `CREATE TABLE Singers (
+    SingerId INT64 NOT NULL,
+    FavoriteLabelId INT64
+) PRIMARY KEY (SingerId);
+CREATE TABLE Albums (
+    SingerId INT64 NOT NULL,
+    AlbumId INT64 NOT NULL
+) PRIMARY KEY (SingerId, AlbumId),
+INTERLEAVE IN PARENT Singers ON DELETE CASCADE;
+CREATE TABLE Labels (
+    LabelId INT64 NOT NULL,
+    OwnerId INT64,
+    CONSTRAINT FK_Labels_Singers FOREIGN KEY (OwnerId) REFERENCES Singers (SingerId)
+) PRIMARY KEY (LabelId);
+ALTER TABLE Singers ADD CONSTRAINT FK_Singers_Labels FOREIGN KEY (FavoriteLabelId) REFERENCES Labels (LabelId);
+`
+		actual, err := Diff(nil, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+
+		expected = `ALTER TABLE Singers DROP CONSTRAINT FK_Singers_Labels;
+DROP TABLE Labels;
+DROP TABLE Albums;
+DROP TABLE Singers;
+`
+		actual, err = Diff(after, nil)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,nil,ChangeStream,View,SearchIndex,Model", func(t *testing.T) {
+		t.Parallel()
+
+		after, err := NewParser(NewLexer(`CREATE CHANGE STREAM SingerStream FOR Singers; CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Singers.Name FROM Singers; CREATE SEARCH INDEX SingersIndex ON Singers (Name_Tokens); CREATE TABLE Singers (SingerId INT64 NOT NULL, Name STRING(MAX), Name_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Name)) HIDDEN) PRIMARY KEY (SingerId); CREATE MODEL M INPUT (content STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE OPTIONS (endpoint = '//x');`)).Parse()
+		require.NoError(t, err)
+
+		expected := `CREATE TABLE Singers (
+    SingerId INT64 NOT NULL,
+    Name STRING(MAX),
+    Name_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Name)) HIDDEN
+) PRIMARY KEY (SingerId);
+CREATE CHANGE STREAM SingerStream FOR Singers;
+CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Singers.Name FROM Singers;
+CREATE SEARCH INDEX SingersIndex ON Singers (Name_Tokens);
+CREATE MODEL M INPUT (content STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE OPTIONS (endpoint = '//x');
+`
+		actual, err := Diff(nil, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+
+		expected = `DROP CHANGE STREAM SingerStream;
+DROP VIEW SingerNames;
+DROP SEARCH INDEX SingersIndex;
+DROP MODEL M;
+DROP TABLE Singers;
+`
+		actual, err = Diff(after, nil)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,before,after,ChangeStream,View,SearchIndex,Model", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE CHANGE STREAM SingerStream FOR Singers; CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Singers.Name FROM Singers; CREATE SEARCH INDEX SingersIndex ON Singers (Name_Tokens); CREATE TABLE Singers (SingerId INT64 NOT NULL, Name STRING(MAX), Name_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Name)) HIDDEN) PRIMARY KEY (SingerId); CREATE MODEL M INPUT (content STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE OPTIONS (endpoint = '//x');`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE CHANGE STREAM SingerStream FOR ALL; CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Singers.SingerId, Singers.Name FROM Singers; CREATE TABLE Singers (SingerId INT64 NOT NULL, Name STRING(MAX), Name_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Name)) HIDDEN) PRIMARY KEY (SingerId); CREATE MODEL M INPUT (content STRING(MAX)) OUTPUT (embeddings ARRAY<FLOAT64>) REMOTE OPTIONS (endpoint = '//y');`)).Parse()
+		require.NoError(t, err)
+
+		expected := `DROP SEARCH INDEX SingersIndex;
+-- -CREATE CHANGE STREAM SingerStream FOR Singers
+-- +CREATE CHANGE STREAM SingerStream FOR ALL
+ALTER CHANGE STREAM SingerStream SET FOR ALL;
+-- -CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Singers.Name FROM Singers
+-- +CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Singers.SingerId , Singers.Name FROM Singers
+CREATE OR REPLACE VIEW SingerNames SQL SECURITY INVOKER AS SELECT Singers.SingerId, Singers.Name FROM Singers;
+-- -OPTIONS (endpoint = //x)
+-- +OPTIONS (endpoint = //y)
+ALTER MODEL M SET OPTIONS (endpoint = '//y');
+`
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,DiffRenames", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE OldUsers (Id STRING(36) NOT NULL, Name STRING(255) NOT NULL) PRIMARY KEY (Id);`)).Parse()
+		require.NoError(t, err)
+
+		after, err := NewParser(NewLexer(`CREATE TABLE Users (Id STRING(36) NOT NULL, Name STRING(255) NOT NULL, Age INT64) PRIMARY KEY (Id);`)).Parse()
+		require.NoError(t, err)
+
+		renames, err := ddl.ParseRenames("OldUsers=Users")
+		require.NoError(t, err)
+
+		expected := `-- -OldUsers
+-- +Users
+ALTER TABLE OldUsers RENAME TO Users;
+-- -
+-- +Age INT64
+ALTER TABLE Users ADD COLUMN Age INT64;
+`
+		actual, err := Diff(before, after, DiffRenames(renames))
+		require.NoError(t, err)
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
 }
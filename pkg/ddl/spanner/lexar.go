@@ -73,10 +73,12 @@ const (
 	TOKEN_ON     TokenType = "ON"
 	TOKEN_TO     TokenType = "TO"
 	TOKEN_WITH   TokenType = "WITH"
+	TOKEN_AS     TokenType = "AS"
 
 	// DATA TYPE.
 	TOKEN_BOOL      TokenType = "BOOL"  //diff:ignore-line-postgres-cockroach
 	TOKEN_INT64     TokenType = "INT64" //diff:ignore-line-postgres-cockroach
+	TOKEN_FLOAT32   TokenType = "FLOAT32"
 	TOKEN_FLOAT64   TokenType = "FLOAT64"
 	TOKEN_NUMERIC   TokenType = "NUMERIC"
 	TOKEN_JSON      TokenType = "JSON"
@@ -84,6 +86,7 @@ const (
 	TOKEN_BYTES     TokenType = "BYTES"
 	TOKEN_TIMESTAMP TokenType = "TIMESTAMP"
 	TOKEN_DATE      TokenType = "DATE"
+	TOKEN_TOKENLIST TokenType = "TOKENLIST"
 	TOKEN_ARRAY     TokenType = "ARRAY"
 	TOKEN_STRUCT    TokenType = "STRUCT"
 
@@ -173,10 +176,14 @@ func lookupIdent(ident string) TokenType {
 		return TOKEN_TO
 	case "WITH":
 		return TOKEN_WITH
+	case "AS":
+		return TOKEN_AS
 	case "BOOL":
 		return TOKEN_BOOL
 	case "INT64":
 		return TOKEN_INT64
+	case "FLOAT32":
+		return TOKEN_FLOAT32
 	case "FLOAT64":
 		return TOKEN_FLOAT64
 	case "NUMERIC":
@@ -191,6 +198,8 @@ func lookupIdent(ident string) TokenType {
 		return TOKEN_TIMESTAMP
 	case "DATE":
 		return TOKEN_DATE
+	case "TOKENLIST":
+		return TOKEN_TOKENLIST
 	case "ARRAY":
 		return TOKEN_ARRAY
 	case "STRUCT":
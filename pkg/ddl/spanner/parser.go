@@ -117,6 +117,79 @@ func (p *Parser) parseCreateStatement() (Stmt, error) { //nolint:ireturn
 			return nil, apperr.Errorf("parseCreateIndexStmt: %w", err)
 		}
 		return stmt, nil
+	case TOKEN_VIEW:
+		stmt, err := p.parseCreateViewStmt()
+		if err != nil {
+			return nil, apperr.Errorf("parseCreateViewStmt: %w", err)
+		}
+		return stmt, nil
+	case TOKEN_IDENT:
+		// MEMO: SEQUENCE, PROTO, CHANGE, SEARCH and MODEL are not tokenized because they are commonly used as column names.
+		switch strings.ToUpper(p.currentToken.Literal.Str) {
+		case "NULL_FILTERED":
+			stmt, err := p.parseCreateIndexStmt()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateIndexStmt: %w", err)
+			}
+			return stmt, nil
+		case "SEQUENCE":
+			stmt, err := p.parseCreateSequenceStmt()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateSequenceStmt: %w", err)
+			}
+			return stmt, nil
+		case "PROTO":
+			stmt, err := p.parseCreateProtoBundleStmt()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateProtoBundleStmt: %w", err)
+			}
+			return stmt, nil
+		case "CHANGE":
+			stmt, err := p.parseCreateChangeStreamStmt()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateChangeStreamStmt: %w", err)
+			}
+			return stmt, nil
+		case "SEARCH":
+			stmt, err := p.parseCreateSearchIndexStmt()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateSearchIndexStmt: %w", err)
+			}
+			return stmt, nil
+		case "MODEL":
+			stmt, err := p.parseCreateModelStmt()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateModelStmt: %w", err)
+			}
+			return stmt, nil
+		case "OR":
+			// CREATE OR REPLACE VIEW or CREATE OR REPLACE MODEL
+			if !p.isPeekKeyword("REPLACE") {
+				return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedPeekToken)
+			}
+			p.nextToken() // current = REPLACE
+			p.nextToken() // current = VIEW or MODEL
+			switch {
+			case p.isCurrentToken(TOKEN_VIEW):
+				stmt, err := p.parseCreateViewStmt()
+				if err != nil {
+					return nil, apperr.Errorf("parseCreateViewStmt: %w", err)
+				}
+				stmt.OrReplace = true
+				return stmt, nil
+			case p.isCurrentKeyword("MODEL"):
+				stmt, err := p.parseCreateModelStmt()
+				if err != nil {
+					return nil, apperr.Errorf("parseCreateModelStmt: %w", err)
+				}
+				stmt.OrReplace = true
+				return stmt, nil
+			default:
+				return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+			}
+		default:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
 	default:
 		return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
 	}
@@ -335,15 +408,472 @@ LabelTableOptions:
 	return createTableStmt, nil
 }
 
+func (p *Parser) parseCreateSequenceStmt() (*CreateSequenceStmt, error) {
+	createSequenceStmt := &CreateSequenceStmt{}
+
+	if p.isPeekToken(TOKEN_IF) {
+		p.nextToken() // current = IF
+		if err := p.checkPeekToken(TOKEN_NOT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = NOT
+		if err := p.checkPeekToken(TOKEN_EXISTS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = EXISTS
+		createSequenceStmt.IfNotExists = true
+	}
+
+	p.nextToken() // current = sequence_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	createSequenceStmt.Name = NewObjectName(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("sequence_name=%s: ", createSequenceStmt.Name.StringForDiff())
+
+	p.nextToken() // current = OPTIONS or ;
+
+	if p.isCurrentToken(TOKEN_OPTIONS) {
+		p.nextToken() // current = (
+		idents, err := p.parseExpr()
+		if err != nil {
+			return nil, apperr.Errorf(errFmtPrefix+"parseExpr: %w", err)
+		}
+		createSequenceStmt.Options = createSequenceStmt.Options.Append(idents...)
+	}
+
+	if err := p.checkCurrentToken(TOKEN_SEMICOLON, TOKEN_EOF); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+	}
+
+	return createSequenceStmt, nil
+}
+
+func (p *Parser) parseCreateProtoBundleStmt() (*CreateProtoBundleStmt, error) {
+	createProtoBundleStmt := &CreateProtoBundleStmt{}
+
+	// MEMO: BUNDLE is not tokenized as well as PROTO.
+	if err := p.checkPeekToken(TOKEN_IDENT); err != nil || !strings.EqualFold(p.peekToken.Literal.Str, "BUNDLE") {
+		return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedPeekToken)
+	}
+	p.nextToken() // current = BUNDLE
+
+	if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil {
+		return nil, apperr.Errorf("checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = (
+
+LabelTypes:
+	for {
+		p.nextToken() // current = proto_type_name or , or )
+
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_IDENT:
+			createProtoBundleStmt.Types = append(createProtoBundleStmt.Types, NewRawIdent(p.currentToken.Literal.Str))
+		case TOKEN_COMMA:
+			// do nothing
+		case TOKEN_CLOSE_PAREN:
+			break LabelTypes
+		default:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+	}
+
+	p.nextToken() // current = ;
+	if err := p.checkCurrentToken(TOKEN_SEMICOLON, TOKEN_EOF); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	return createProtoBundleStmt, nil
+}
+
+//nolint:cyclop,funlen,gocognit
+func (p *Parser) parseCreateChangeStreamStmt() (*CreateChangeStreamStmt, error) {
+	createChangeStreamStmt := &CreateChangeStreamStmt{}
+
+	if !p.isPeekKeyword("STREAM") {
+		return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedPeekToken)
+	}
+	p.nextToken() // current = STREAM
+
+	p.nextToken() // current = change_stream_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+	createChangeStreamStmt.Name = NewObjectName(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("change_stream_name=%s: ", createChangeStreamStmt.Name.StringForDiff())
+
+	p.nextToken() // current = FOR or OPTIONS or ;
+
+	if p.isCurrentKeyword("FOR") {
+		if p.isPeekKeyword("ALL") {
+			p.nextToken() // current = ALL
+			createChangeStreamStmt.ForAll = true
+			p.nextToken() // current = OPTIONS or ;
+		} else {
+			watches, err := p.parseChangeStreamFor()
+			if err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"parseChangeStreamFor: %w", err)
+			}
+			createChangeStreamStmt.For = watches
+		}
+	}
+
+	if p.isCurrentToken(TOKEN_OPTIONS) {
+		p.nextToken() // current = (
+		idents, err := p.parseExpr()
+		if err != nil {
+			return nil, apperr.Errorf(errFmtPrefix+"parseExpr: %w", err)
+		}
+		createChangeStreamStmt.Options = createChangeStreamStmt.Options.Append(idents...)
+	}
+
+	if err := p.checkCurrentToken(TOKEN_SEMICOLON, TOKEN_EOF); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+	}
+
+	return createChangeStreamStmt, nil
+}
+
+// parseChangeStreamFor parses table_name[([column_name, ...])], ... of FOR clause.
+func (p *Parser) parseChangeStreamFor() ([]*ChangeStreamFor, error) {
+	watches := make([]*ChangeStreamFor, 0)
+
+	for {
+		p.nextToken() // current = table_name
+		if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+			return nil, apperr.Errorf("checkCurrentToken: %w", err)
+		}
+		watch := &ChangeStreamFor{Table: NewRawIdent(p.currentToken.Literal.Str), AllColumns: true}
+		if p.isPeekToken(TOKEN_OPEN_PAREN) {
+			p.nextToken() // current = (
+			watch.AllColumns = false
+			columns, err := p.parseIdentList() // current = , or OPTIONS or ;
+			if err != nil {
+				return nil, apperr.Errorf("parseIdentList: %w", err)
+			}
+			watch.Columns = columns
+		} else {
+			p.nextToken() // current = , or OPTIONS or ;
+		}
+		watches = append(watches, watch)
+
+		if !p.isCurrentToken(TOKEN_COMMA) {
+			break
+		}
+	}
+
+	return watches, nil
+}
+
+func (p *Parser) parseCreateViewStmt() (*CreateViewStmt, error) {
+	createViewStmt := &CreateViewStmt{}
+
+	p.nextToken() // current = view_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+	createViewStmt.Name = NewObjectName(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("view_name=%s: ", createViewStmt.Name.StringForDiff())
+
+	p.nextToken() // current = SQL
+	if !p.isCurrentKeyword("SQL") || !p.isPeekKeyword("SECURITY") {
+		return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+	}
+	p.nextToken() // current = SECURITY
+	if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = INVOKER or DEFINER
+	createViewStmt.SQLSecurity = strings.ToUpper(p.currentToken.Literal.Str)
+
+	if err := p.checkPeekToken(TOKEN_AS); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = AS
+	p.nextToken() // current = query
+
+	query, err := p.parseQuery()
+	if err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"parseQuery: %w", err)
+	}
+	createViewStmt.Query = query
+
+	return createViewStmt, nil
+}
+
+// parseQuery parses the tokens until the end of the statement as the query of the view.
+func (p *Parser) parseQuery() (*Expr, error) {
+	query := &Expr{}
+
+	for !p.isCurrentToken(TOKEN_SEMICOLON, TOKEN_EOF) {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_ILLEGAL:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		case TOKEN_EQUAL, TOKEN_GREATER, TOKEN_LESS:
+			value := p.currentToken.Literal.Str
+			switch p.peekToken.Type { //nolint:exhaustive
+			case TOKEN_EQUAL, TOKEN_GREATER, TOKEN_LESS:
+				value += p.peekToken.Literal.Str
+				p.nextToken()
+			}
+			query = query.Append(NewRawIdent(value))
+		default:
+			query = query.Append(NewRawIdent(p.currentToken.Literal.Str))
+		}
+		p.nextToken()
+	}
+
+	if len(query.Idents) == 0 {
+		return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+	}
+
+	return query, nil
+}
+
+//nolint:cyclop,funlen,gocognit,gocyclo
+func (p *Parser) parseCreateSearchIndexStmt() (*CreateSearchIndexStmt, error) {
+	createSearchIndexStmt := &CreateSearchIndexStmt{}
+
+	if err := p.checkPeekToken(TOKEN_INDEX); err != nil {
+		return nil, apperr.Errorf("checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = INDEX
+
+	p.nextToken() // current = index_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+	createSearchIndexStmt.Name = NewObjectName(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("index_name=%s: ", createSearchIndexStmt.Name.StringForDiff())
+
+	if err := p.checkPeekToken(TOKEN_ON); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = ON
+	if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = table_name
+	createSearchIndexStmt.TableName = NewObjectName(p.currentToken.Literal.Str)
+
+	if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+	}
+	p.nextToken() // current = (
+	columns, err := p.parseIdentList()
+	if err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"parseIdentList: %w", err)
+	}
+	createSearchIndexStmt.Columns = columns
+
+LabelSearchIndexOptions:
+	for {
+		switch {
+		case p.isCurrentToken(TOKEN_SEMICOLON, TOKEN_EOF):
+			break LabelSearchIndexOptions
+		case p.isCurrentKeyword("STORING"):
+			if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = (
+			storing, err := p.parseIdentList()
+			if err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"parseIdentList: %w", err)
+			}
+			createSearchIndexStmt.Storing = storing
+		case p.isCurrentKeyword("PARTITION"), p.isCurrentKeyword("ORDER"):
+			partition := p.isCurrentKeyword("PARTITION")
+			if !p.isPeekKeyword("BY") {
+				return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedPeekToken)
+			}
+			p.nextToken() // current = BY
+			for {
+				if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+					return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+				}
+				p.nextToken() // current = column_name
+				column := &ColumnIdent{Ident: NewRawIdent(p.currentToken.Literal.Str)}
+				switch p.peekToken.Type { //nolint:exhaustive
+				case TOKEN_ASC:
+					column.Order = &Order{Desc: false}
+					p.nextToken() // current = ASC
+				case TOKEN_DESC:
+					column.Order = &Order{Desc: true}
+					p.nextToken() // current = DESC
+				}
+				if partition {
+					createSearchIndexStmt.PartitionBy = append(createSearchIndexStmt.PartitionBy, column.Ident)
+				} else {
+					createSearchIndexStmt.OrderBy = append(createSearchIndexStmt.OrderBy, column)
+				}
+				if !p.isPeekToken(TOKEN_COMMA) {
+					p.nextToken() // current = STORING or PARTITION or ORDER or OPTIONS or ;
+					break
+				}
+				p.nextToken() // current = ,
+				if p.isPeekToken(TOKEN_INTERLEAVE) {
+					// MEMO: , INTERLEAVE IN is not a column.
+					break
+				}
+			}
+		case p.isCurrentToken(TOKEN_COMMA):
+			if err := p.checkPeekToken(TOKEN_INTERLEAVE); err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = INTERLEAVE
+			if err := p.checkPeekToken(TOKEN_IN); err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = IN
+			if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = table_name
+			createSearchIndexStmt.InterleaveIn = NewObjectName(p.currentToken.Literal.Str)
+			p.nextToken() // current = OPTIONS or ;
+		case p.isCurrentToken(TOKEN_OPTIONS):
+			p.nextToken() // current = (
+			idents, err := p.parseExpr()
+			if err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"parseExpr: %w", err)
+			}
+			createSearchIndexStmt.Options = createSearchIndexStmt.Options.Append(idents...)
+		default:
+			return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+	}
+
+	return createSearchIndexStmt, nil
+}
+
+// parseIdentList parses (ident, ...) and returns the idents. The current token is the next of ) after this.
+func (p *Parser) parseIdentList() ([]*Ident, error) {
+	idents := make([]*Ident, 0)
+
+LabelIdents:
+	for {
+		p.nextToken() // current = ident or , or )
+
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_IDENT:
+			idents = append(idents, NewRawIdent(p.currentToken.Literal.Str))
+		case TOKEN_COMMA:
+			// do nothing
+		case TOKEN_CLOSE_PAREN:
+			p.nextToken()
+			break LabelIdents
+		default:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+	}
+
+	return idents, nil
+}
+
+//nolint:cyclop,funlen
+func (p *Parser) parseCreateModelStmt() (*CreateModelStmt, error) {
+	createModelStmt := &CreateModelStmt{}
+
+	if p.isPeekToken(TOKEN_IF) {
+		p.nextToken() // current = IF
+		if err := p.checkPeekToken(TOKEN_NOT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = NOT
+		if err := p.checkPeekToken(TOKEN_EXISTS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = EXISTS
+		createModelStmt.IfNotExists = true
+	}
+
+	p.nextToken() // current = model_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+	createModelStmt.Name = NewObjectName(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("model_name=%s: ", createModelStmt.Name.StringForDiff())
+
+	p.nextToken() // current = INPUT or OUTPUT or REMOTE
+	for _, column := range []struct {
+		keyword string
+		fields  *[]*StructField
+	}{
+		{"INPUT", &createModelStmt.Input},
+		{"OUTPUT", &createModelStmt.Output},
+	} {
+		if !p.isCurrentKeyword(column.keyword) {
+			continue
+		}
+		if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil {
+			return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = (
+		for !p.isCurrentToken(TOKEN_CLOSE_PAREN) {
+			if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = column_name
+			field := &StructField{Name: NewRawIdent(p.currentToken.Literal.Str)}
+			p.nextToken() // current = data_type
+			if !isDataType(p.currentToken.Type) {
+				return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+			}
+			dataType, err := p.parseDataType()
+			if err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"parseDataType: %w", err)
+			}
+			field.DataType = dataType
+			*column.fields = append(*column.fields, field)
+			if err := p.checkPeekToken(TOKEN_COMMA, TOKEN_CLOSE_PAREN); err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = , or )
+		}
+		p.nextToken() // current = OUTPUT or REMOTE
+	}
+
+	if !p.isCurrentKeyword("REMOTE") {
+		return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+	}
+	p.nextToken() // current = OPTIONS or ;
+
+	if p.isCurrentToken(TOKEN_OPTIONS) {
+		p.nextToken() // current = (
+		idents, err := p.parseExpr()
+		if err != nil {
+			return nil, apperr.Errorf(errFmtPrefix+"parseExpr: %w", err)
+		}
+		createModelStmt.Options = createModelStmt.Options.Append(idents...)
+	}
+
+	if err := p.checkCurrentToken(TOKEN_SEMICOLON, TOKEN_EOF); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+	}
+
+	return createModelStmt, nil
+}
+
 //nolint:cyclop,funlen
 func (p *Parser) parseCreateIndexStmt() (*CreateIndexStmt, error) {
 	createIndexStmt := &CreateIndexStmt{}
 
 	if p.isCurrentToken(TOKEN_UNIQUE) {
 		createIndexStmt.Unique = true
+		p.nextToken() // current = NULL_FILTERED or INDEX
+	}
+
+	if p.isCurrentToken(TOKEN_IDENT) && strings.EqualFold(p.currentToken.Literal.Str, "NULL_FILTERED") {
+		createIndexStmt.NullFiltered = true
 		p.nextToken() // current = INDEX
 	}
 
+	if err := p.checkCurrentToken(TOKEN_INDEX); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
 	if p.isPeekToken(TOKEN_IF) {
 		p.nextToken() // current = IF
 		if err := p.checkPeekToken(TOKEN_NOT); err != nil {
@@ -398,6 +928,36 @@ func (p *Parser) parseCreateIndexStmt() (*CreateIndexStmt, error) {
 
 	createIndexStmt.Columns = idents
 
+	// STORING (column_name, ...)
+	if p.isCurrentToken(TOKEN_IDENT) && strings.EqualFold(p.currentToken.Literal.Str, "STORING") {
+		p.nextToken() // current = (
+		if err := p.checkCurrentToken(TOKEN_OPEN_PAREN); err != nil {
+			return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+		}
+		storing, err := p.parseColumnIdents()
+		if err != nil {
+			return nil, apperr.Errorf(errFmtPrefix+"parseColumnIdents: %w", err)
+		}
+		for _, c := range storing {
+			createIndexStmt.Storing = append(createIndexStmt.Storing, c.Ident)
+		}
+	}
+
+	// , INTERLEAVE IN table_name
+	if p.isCurrentToken(TOKEN_COMMA) && p.isPeekToken(TOKEN_INTERLEAVE) {
+		p.nextToken() // current = INTERLEAVE
+		p.nextToken() // current = IN
+		if err := p.checkCurrentToken(TOKEN_IN); err != nil {
+			return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+		}
+		p.nextToken() // current = table_name
+		if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+			return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+		}
+		createIndexStmt.InterleaveIn = NewObjectName(p.currentToken.Literal.Str)
+		p.nextToken() // current = ;
+	}
+
 	return createIndexStmt, nil
 }
 
@@ -443,6 +1003,24 @@ func (p *Parser) parseColumn(tableName *Ident) (*Column, []Constraint, error) {
 				}
 				column.Default = def
 				continue
+			case TOKEN_AS: // current = AS
+				p.nextToken() // current = (
+				idents, err := p.parseExpr()
+				if err != nil {
+					return nil, nil, apperr.Errorf(errFmtPrefix+"parseExpr: %w", err)
+				}
+				column.Generated = column.Generated.Append(idents...)
+				if p.isCurrentToken(TOKEN_IDENT) && strings.EqualFold(p.currentToken.Literal.Str, "STORED") {
+					column.Stored = true
+					p.nextToken()
+				}
+				continue
+			case TOKEN_IDENT:
+				// MEMO: HIDDEN is not tokenized because it is commonly used as a column name.
+				if !strings.EqualFold(p.currentToken.Literal.Str, "HIDDEN") {
+					break LabelDefaultNotNull
+				}
+				column.Hidden = true
 			default:
 				break LabelDefaultNotNull
 			}
@@ -695,17 +1273,75 @@ func (p *Parser) parseTableConstraint(tableName *Ident) (Constraint, error) { //
 	}
 }
 
-//nolint:cyclop,funlen
+//nolint:cyclop,funlen,gocognit
 func (p *Parser) parseDataType() (*DataType, error) {
 	dataType := &DataType{
 		Name: p.currentToken.Literal.String(),
 		Type: p.currentToken.Type,
 	}
 
-	// TODO: support ARRAY, STRUCT
+	switch p.currentToken.Type { //nolint:exhaustive
+	case TOKEN_ARRAY:
+		// ARRAY<data_type>
+		if err := p.checkPeekToken(TOKEN_LESS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = <
+		p.nextToken() // current = data_type
+		elem, err := p.parseDataType()
+		if err != nil {
+			return nil, apperr.Errorf("parseDataType: %w", err)
+		}
+		dataType.Elem = elem
+		if err := p.checkPeekToken(TOKEN_GREATER); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = >
+	case TOKEN_STRUCT:
+		// STRUCT<field_name data_type, ...>
+		if err := p.checkPeekToken(TOKEN_LESS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = <
+		fields, err := p.parseStructFields()
+		if err != nil {
+			return nil, apperr.Errorf("parseStructFields: %w", err)
+		}
+		dataType.Fields = fields
+	case TOKEN_IDENT:
+		// MEMO: PROTO and ENUM are not tokenized because they are commonly used as column names.
+		switch upper := strings.ToUpper(p.currentToken.Literal.Str); {
+		case (upper == "PROTO" || upper == "ENUM") && p.isPeekToken(TOKEN_LESS):
+			// PROTO<proto_type_name> or ENUM<enum_type_name>
+			dataType.Name = upper
+			p.nextToken() // current = <
+			if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+				return nil, apperr.Errorf("checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = type_name
+			dataType.TypeName = NewRawIdent(p.currentToken.Literal.Str)
+			if err := p.checkPeekToken(TOKEN_GREATER); err != nil {
+				return nil, apperr.Errorf("checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = >
+		default:
+			// proto_type_name or enum_type_name
+			dataType.Name = ""
+			dataType.TypeName = NewRawIdent(p.currentToken.Literal.Str)
+		}
+	}
 
 	if p.isPeekToken(TOKEN_OPEN_PAREN) {
 		p.nextToken() // current = (
+		if dataType.Type == TOKEN_ARRAY {
+			// e.g. ARRAY<FLOAT32>(vector_length=>768)
+			args, err := p.parseTypeArgs()
+			if err != nil {
+				return nil, apperr.Errorf("parseTypeArgs: %w", err)
+			}
+			dataType.Args = args
+			return dataType, nil
+		}
 		idents, err := p.parseIdents()
 		if err != nil {
 			return nil, apperr.Errorf("parseIdents: %w", err)
@@ -716,6 +1352,73 @@ func (p *Parser) parseDataType() (*DataType, error) {
 	return dataType, nil
 }
 
+func (p *Parser) parseStructFields() ([]*StructField, error) {
+	fields := make([]*StructField, 0)
+
+	p.nextToken() // current = field_name or data_type or >
+	for !p.isCurrentToken(TOKEN_GREATER) {
+		field := &StructField{}
+		if p.isCurrentToken(TOKEN_IDENT) && !p.isPeekToken(TOKEN_COMMA, TOKEN_GREATER) {
+			field.Name = NewRawIdent(p.currentToken.Literal.Str)
+			p.nextToken() // current = data_type
+		}
+		if !isDataType(p.currentToken.Type) {
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+		dataType, err := p.parseDataType()
+		if err != nil {
+			return nil, apperr.Errorf("parseDataType: %w", err)
+		}
+		field.DataType = dataType
+		fields = append(fields, field)
+
+		if err := p.checkPeekToken(TOKEN_COMMA, TOKEN_GREATER); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = , or >
+		if p.isCurrentToken(TOKEN_COMMA) {
+			p.nextToken() // current = field_name or data_type
+		}
+	}
+
+	return fields, nil
+}
+
+func (p *Parser) parseTypeArgs() ([]*TypeArg, error) {
+	args := make([]*TypeArg, 0)
+
+	for !p.isCurrentToken(TOKEN_CLOSE_PAREN) {
+		// current = ( or ,
+		if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = arg_name
+		arg := &TypeArg{Name: NewRawIdent(p.currentToken.Literal.Str)}
+		// MEMO: => is tokenized as = and >.
+		if err := p.checkPeekToken(TOKEN_EQUAL); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = =
+		if err := p.checkPeekToken(TOKEN_GREATER); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = >
+		if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = arg_value
+		arg.Value = NewRawIdent(p.currentToken.Literal.Str)
+		args = append(args, arg)
+
+		if err := p.checkPeekToken(TOKEN_COMMA, TOKEN_CLOSE_PAREN); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = , or )
+	}
+
+	return args, nil
+}
+
 func (p *Parser) parseColumnIdents() ([]*ColumnIdent, error) {
 	idents := make([]*ColumnIdent, 0)
 
@@ -798,10 +1501,17 @@ func isDataType(tokenType TokenType) bool {
 	case TOKEN_BOOL,
 		TOKEN_INT64,
 		TOKEN_NUMERIC,
+		TOKEN_FLOAT32,
 		TOKEN_FLOAT64,
 		TOKEN_JSON,
 		TOKEN_STRING,
-		TOKEN_TIMESTAMP:
+		TOKEN_BYTES,
+		TOKEN_TIMESTAMP,
+		TOKEN_DATE,
+		TOKEN_TOKENLIST,
+		TOKEN_ARRAY,
+		TOKEN_STRUCT,
+		TOKEN_IDENT: // MEMO: PROTO<...>, ENUM<...>, or the name of the proto message or enum.
 		return true
 	default:
 		return false
@@ -849,6 +1559,16 @@ func (p *Parser) isPeekToken(expectedTypes ...TokenType) bool {
 	return false
 }
 
+// isCurrentKeyword reports whether the current token is the keyword that is not tokenized, such as STORING.
+func (p *Parser) isCurrentKeyword(keyword string) bool {
+	return p.isCurrentToken(TOKEN_IDENT) && strings.EqualFold(p.currentToken.Literal.Str, keyword)
+}
+
+// isPeekKeyword reports whether the peek token is the keyword that is not tokenized, such as STORING.
+func (p *Parser) isPeekKeyword(keyword string) bool {
+	return p.isPeekToken(TOKEN_IDENT) && strings.EqualFold(p.peekToken.Literal.Str, keyword)
+}
+
 func (p *Parser) checkPeekToken(expectedTypes ...TokenType) error {
 	for _, expected := range expectedTypes {
 		if expected == p.peekToken.Type {
@@ -53,6 +53,27 @@ ROW DELETION POLICY (OLDER_THAN(ExpiredDate, INTERVAL 0 DAY));
 		t.Logf("✅: %s: actual: %%s: \n%s", t.Name(), actual)
 	})
 
+	t.Run("success,CREATE_INDEX_NULL_FILTERED_STORING_INTERLEAVE_IN", func(t *testing.T) {
+		// t.Parallel()
+
+		l := NewLexer(`CREATE UNIQUE NULL_FILTERED INDEX AlbumsByTitle ON Albums (SingerId, Title DESC) STORING (AlbumId, MarketingBudget), INTERLEAVE IN Singers; CREATE NULL_FILTERED INDEX SingersByName ON Singers (LastName); CREATE INDEX SingersByFirstName ON Singers (FirstName) STORING (LastName);`)
+		p := NewParser(l)
+		actual, err := p.Parse()
+		require.NoError(t, err)
+
+		const expected = `CREATE UNIQUE NULL_FILTERED INDEX AlbumsByTitle ON Albums (SingerId, Title DESC) STORING (AlbumId, MarketingBudget), INTERLEAVE IN Singers;
+CREATE NULL_FILTERED INDEX SingersByName ON Singers (LastName);
+CREATE INDEX SingersByFirstName ON Singers (FirstName) STORING (LastName);
+`
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Fail()
+		}
+
+		t.Logf("✅: %s: actual: %%#v: \n%#v", t.Name(), actual)
+		t.Logf("✅: %s: actual: %%s: \n%s", t.Name(), actual)
+	})
+
 	t.Run("success,complex_defaults", func(t *testing.T) {
 		// t.Parallel()
 
@@ -93,6 +114,113 @@ CREATE TABLE IF NOT EXISTS complex_defaults (
 		t.Logf("✅: %s: actual: %%s: \n%s", t.Name(), actual)
 	})
 
+	t.Run("success,CREATE_SEQUENCE", func(t *testing.T) {
+		t.Parallel()
+
+		input := `CREATE SEQUENCE IF NOT EXISTS MySequence OPTIONS (sequence_kind = 'bit_reversed_positive', skip_range_min = 1, skip_range_max = 1000);
+CREATE SEQUENCE OtherSequence
+`
+		expected := `CREATE SEQUENCE IF NOT EXISTS MySequence OPTIONS (sequence_kind = 'bit_reversed_positive', skip_range_min = 1, skip_range_max = 1000);
+CREATE SEQUENCE OtherSequence;
+`
+
+		actual, err := NewParser(NewLexer(input)).Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
+	t.Run("success,ARRAY_STRUCT_PROTO_ENUM", func(t *testing.T) {
+		t.Parallel()
+
+		input := `CREATE PROTO BUNDLE (examples.music.SingerInfo, ` + "`examples.music.Genre`" + `);
+CREATE TABLE Singers (
+    SingerId INT64 NOT NULL,
+    Tags ARRAY<STRING(MAX)>,
+    Embedding ARRAY<FLOAT32>(vector_length=>768),
+    Pairs ARRAY<STRUCT<Name STRING(MAX), Score FLOAT64>>,
+    SingerInfo examples.music.SingerInfo,
+    SingerGenre ENUM<examples.music.Genre>,
+    Infos ARRAY<PROTO<examples.music.SingerInfo>> NOT NULL
+) PRIMARY KEY (SingerId);
+`
+		expected := `CREATE PROTO BUNDLE (
+    examples.music.SingerInfo,
+    ` + "`examples.music.Genre`" + `
+);
+CREATE TABLE Singers (
+    SingerId INT64 NOT NULL,
+    Tags ARRAY<STRING(MAX)>,
+    Embedding ARRAY<FLOAT32>(vector_length=>768),
+    Pairs ARRAY<STRUCT<Name STRING(MAX), Score FLOAT64>>,
+    SingerInfo examples.music.SingerInfo,
+    SingerGenre ENUM<examples.music.Genre>,
+    Infos ARRAY<PROTO<examples.music.SingerInfo>> NOT NULL
+) PRIMARY KEY (SingerId);
+`
+
+		actual, err := NewParser(NewLexer(input)).Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+
+		columns := actual.Stmts[1].(*CreateTableStmt).Columns //nolint:forcetypeassert
+		assert.Equal(t, "STRING(MAX)", columns[1].DataType.Elem.String())
+		assert.Equal(t, "vector_length", columns[2].DataType.Args[0].Name.String())
+		assert.Equal(t, "768", columns[2].DataType.Args[0].Value.String())
+		assert.Equal(t, "Score", columns[3].DataType.Elem.Fields[1].Name.String())
+		assert.Equal(t, "examples.music.SingerInfo", columns[4].DataType.TypeName.String())
+		assert.Equal(t, columns[4].DataType.StringForDiff(), columns[6].DataType.Elem.StringForDiff())
+	})
+
+	t.Run("success,CHANGE_STREAM_VIEW_SEARCH_INDEX_MODEL", func(t *testing.T) {
+		t.Parallel()
+
+		input := `CREATE TABLE Singers (
+    SingerId INT64 NOT NULL,
+    Name STRING(MAX),
+    Name_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Name)) HIDDEN,
+    NameLength INT64 AS (CHAR_LENGTH(Name)) STORED
+) PRIMARY KEY (SingerId);
+CREATE CHANGE STREAM EverythingStream FOR ALL;
+CREATE CHANGE STREAM SingerStream FOR Singers(Name), Albums, Songs() OPTIONS (retention_period = '36h', value_capture_type = 'NEW_VALUES');
+CREATE CHANGE STREAM NothingStream;
+CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT s.SingerId, s.Name FROM Singers AS s WHERE s.SingerId >= 10 ORDER BY s.Name;
+CREATE OR REPLACE VIEW SingerCount SQL SECURITY DEFINER AS SELECT COUNT(*) AS c FROM Singers;
+CREATE SEARCH INDEX SingersIndex ON Singers (Name_Tokens) STORING (Name) PARTITION BY SingerId ORDER BY NameLength DESC, INTERLEAVE IN Labels OPTIONS (sort_order_sharding = true);
+CREATE SEARCH INDEX SimpleIndex ON Singers (Name_Tokens);
+CREATE MODEL IF NOT EXISTS Gemini INPUT (prompt STRING(MAX)) OUTPUT (content STRING(MAX)) REMOTE OPTIONS (endpoint = '//aiplatform.googleapis.com/projects/p/locations/us-central1/publishers/google/models/gemini-pro');
+CREATE OR REPLACE MODEL Embeddings REMOTE;
+`
+		expected := `CREATE TABLE Singers (
+    SingerId INT64 NOT NULL,
+    Name STRING(MAX),
+    Name_Tokens TOKENLIST AS (TOKENIZE_FULLTEXT(Name)) HIDDEN,
+    NameLength INT64 AS (CHAR_LENGTH(Name)) STORED
+) PRIMARY KEY (SingerId);
+CREATE CHANGE STREAM EverythingStream FOR ALL;
+CREATE CHANGE STREAM SingerStream FOR Singers(Name), Albums, Songs() OPTIONS (retention_period = '36h', value_capture_type = 'NEW_VALUES');
+CREATE CHANGE STREAM NothingStream;
+CREATE VIEW SingerNames SQL SECURITY INVOKER AS SELECT s.SingerId, s.Name FROM Singers AS s WHERE s.SingerId >= 10 ORDER BY s.Name;
+CREATE OR REPLACE VIEW SingerCount SQL SECURITY DEFINER AS SELECT COUNT(*) AS c FROM Singers;
+CREATE SEARCH INDEX SingersIndex ON Singers (Name_Tokens) STORING (Name) PARTITION BY SingerId ORDER BY NameLength DESC, INTERLEAVE IN Labels OPTIONS (sort_order_sharding = TRUE);
+CREATE SEARCH INDEX SimpleIndex ON Singers (Name_Tokens);
+CREATE MODEL IF NOT EXISTS Gemini INPUT (prompt STRING(MAX)) OUTPUT (content STRING(MAX)) REMOTE OPTIONS (endpoint = '//aiplatform.googleapis.com/projects/p/locations/us-central1/publishers/google/models/gemini-pro');
+CREATE OR REPLACE MODEL Embeddings REMOTE;
+`
+
+		actual, err := NewParser(NewLexer(input)).Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+	})
+
 	failureTests := []struct {
 		name    string
 		input   string
@@ -459,6 +587,131 @@ CREATE TABLE IF NOT EXISTS complex_defaults (
 			input:   `CREATE INDEX users_idx_username ON users USING btree (NOT)`,
 			wantErr: ddl.ErrUnexpectedCurrentToken,
 		},
+		{
+			name:    "failure,CREATE_NULL_FILTERED_INVALID",
+			input:   `CREATE NULL_FILTERED TABLE users`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_INDEX_index_name_ON_table_name_columns_STORING_INVALID",
+			input:   `CREATE INDEX users_idx_username ON users (username) STORING NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_INDEX_index_name_ON_table_name_columns_STORING_OPEN_INVALID",
+			input:   `CREATE INDEX users_idx_username ON users (username) STORING (NOT)`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_INDEX_index_name_ON_table_name_columns_INTERLEAVE_INVALID",
+			input:   `CREATE INDEX users_idx_username ON users (username), INTERLEAVE NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_INDEX_index_name_ON_table_name_columns_INTERLEAVE_IN_INVALID",
+			input:   `CREATE INDEX users_idx_username ON users (username), INTERLEAVE IN NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_INVALID",
+			input:   `CREATE SEQUENCE NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_IF_INVALID",
+			input:   `CREATE SEQUENCE IF;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_IF_NOT_INVALID",
+			input:   `CREATE SEQUENCE IF NOT;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_INVALID",
+			input:   `CREATE SEQUENCE MySequence NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_SEQUENCE_sequence_name_OPTIONS_INVALID",
+			input:   `CREATE SEQUENCE MySequence OPTIONS (sequence_kind = 'bit_reversed_positive'`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_PROTO_INVALID",
+			input:   `CREATE PROTO TABLE`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_PROTO_BUNDLE_INVALID",
+			input:   `CREATE PROTO BUNDLE examples.music.SingerInfo`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_PROTO_BUNDLE_OPEN_INVALID",
+			input:   `CREATE PROTO BUNDLE (NOT)`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_PROTO_BUNDLE_types_INVALID",
+			input:   `CREATE PROTO BUNDLE (examples.music.SingerInfo) NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_CHANGE_INVALID",
+			input:   `CREATE CHANGE NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_CHANGE_STREAM_FOR_INVALID",
+			input:   `CREATE CHANGE STREAM S FOR (NOT)`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_CHANGE_STREAM_FOR_table_INVALID",
+			input:   `CREATE CHANGE STREAM S FOR Singers(NOT)`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_INVALID",
+			input:   `CREATE VIEW V NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_VIEW_SQL_SECURITY_INVALID",
+			input:   `CREATE VIEW V SQL NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_OR_INVALID",
+			input:   `CREATE OR NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_SEARCH_INDEX_INVALID",
+			input:   `CREATE SEARCH INDEX I NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_SEARCH_INDEX_ON_table_INVALID",
+			input:   `CREATE SEARCH INDEX I ON T NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_MODEL_INPUT_INVALID",
+			input:   `CREATE MODEL M INPUT NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_MODEL_REMOTE_INVALID",
+			input:   `CREATE MODEL M INPUT (x INT64) OUTPUT (y INT64) NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_column_ARRAY_INVALID",
+			input:   `CREATE TABLE users (tags ARRAY<STRING(MAX)) PRIMARY KEY (id)`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
 	}
 
 	for _, tt := range failureTests {
@@ -560,4 +813,68 @@ func TestParser_parseDataType(t *testing.T) {
 		_, err := p.parseDataType()
 		require.ErrorIs(t, err, ddl.ErrUnexpectedCurrentToken)
 	})
+
+	failureTests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{
+			name:    "failure,ARRAY_without_LESS",
+			input:   `ARRAY STRING`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,ARRAY_without_GREATER",
+			input:   `ARRAY<STRING(MAX),`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,ARRAY_elem",
+			input:   `ARRAY<STRING(`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,ARRAY_args",
+			input:   `ARRAY<FLOAT32>(vector_length 768)`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,STRUCT_without_LESS",
+			input:   `STRUCT(`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,STRUCT_field",
+			input:   `STRUCT<a NOT>`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,STRUCT_without_GREATER",
+			input:   `STRUCT<a INT64;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,PROTO_type_name",
+			input:   `PROTO<>`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,PROTO_without_GREATER",
+			input:   `PROTO<a.B`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+	}
+
+	for _, tt := range failureTests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewParser(NewLexer(tt.input))
+			p.nextToken()
+			p.nextToken()
+			_, err := p.parseDataType()
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
 }
@@ -0,0 +1,25 @@
+package sqlite3
+
+import (
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+// ClassifyStmt returns the class of the impact of stmt on the running database.
+func ClassifyStmt(stmt Stmt) ddl.ChangeClass {
+	switch s := stmt.(type) {
+	case *DropTableStmt:
+		if s.Rebuild && len(s.DroppedColumns) == 0 {
+			return ddl.ChangeClassLocking // MEMO: The data is copied to the rebuilt table.
+		}
+		return ddl.ChangeClassDataLoss
+	case *InsertIntoSelectStmt:
+		return ddl.ChangeClassLocking // MEMO: The table is copied to rebuild it.
+	case *AlterTableStmt:
+		if _, ok := s.Action.(*DropColumn); ok {
+			return ddl.ChangeClassDataLoss
+		}
+		return ddl.ChangeClassSafe
+	default:
+		return ddl.ChangeClassSafe
+	}
+}
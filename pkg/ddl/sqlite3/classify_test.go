@@ -0,0 +1,46 @@
+package sqlite3
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestClassifyStmt(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE "groups" (id TEXT NOT NULL);
+CREATE TABLE "users" (id TEXT NOT NULL, "name" TEXT NOT NULL, memo TEXT, CONSTRAINT users_pkey PRIMARY KEY (id));
+CREATE TABLE "posts" (id TEXT NOT NULL, body TEXT);`)).Parse()
+		require.NoError(t, err)
+		after, err := NewParser(NewLexer(`CREATE TABLE "users" (id TEXT NOT NULL, "name" TEXT NOT NULL, note TEXT, CONSTRAINT users_pkey PRIMARY KEY (id));
+CREATE TABLE "posts" (id TEXT NOT NULL, body TEXT NOT NULL);`)).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+
+		expected := []ddl.ChangeClass{
+			ddl.ChangeClassDataLoss, // DROP TABLE
+			ddl.ChangeClassDataLoss, // DROP COLUMN
+			ddl.ChangeClassSafe,     // ADD COLUMN
+			ddl.ChangeClassSafe,     // CREATE TABLE new_posts
+			ddl.ChangeClassLocking,  // INSERT INTO new_posts
+			ddl.ChangeClassLocking,  // DROP TABLE posts
+			ddl.ChangeClassSafe,     // RENAME TO posts
+		}
+		classes := make([]ddl.ChangeClass, 0, len(actual.Stmts))
+		for _, stmt := range actual.Stmts {
+			classes = append(classes, ClassifyStmt(stmt))
+		}
+		if !assert.Equal(t, expected, classes) {
+			t.Logf("❌: %s", actual)
+		}
+	})
+}
@@ -0,0 +1,146 @@
+package sqlite3
+
+import (
+	"strings"
+
+	stringz "github.com/kunitsucom/util.go/strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+const (
+	Dialect       = "sqlite3"
+	DriverName    = "sqlite3"
+	Indent        = "    "
+	CommentPrefix = "-- "
+)
+
+type Verb string
+
+const (
+	VerbCreate Verb = "CREATE"
+	VerbAlter  Verb = "ALTER"
+	VerbDrop   Verb = "DROP"
+	VerbRename Verb = "RENAME"
+	VerbInsert Verb = "INSERT"
+)
+
+type Object string
+
+const (
+	ObjectTable Object = "TABLE"
+	ObjectIndex Object = "INDEX"
+	ObjectView  Object = "VIEW"
+)
+
+type Action string
+
+const (
+	ActionAdd    Action = "ADD"
+	ActionDrop   Action = "DROP"
+	ActionRename Action = "RENAME"
+)
+
+type Stmt interface {
+	isStmt()
+	GetNameForDiff() string
+	String() string
+}
+
+type DDL struct {
+	Stmts []Stmt
+}
+
+func (d *DDL) String() string {
+	if d == nil {
+		return ""
+	}
+	return stringz.JoinStringers("", d.Stmts...)
+}
+
+type Ident struct {
+	Name          string
+	QuotationMark string
+	Raw           string
+}
+
+func (i *Ident) GoString() string { return internal.GoString(*i) }
+
+func (i *Ident) String() string {
+	if i == nil {
+		return ""
+	}
+	return i.Raw
+}
+
+func (i *Ident) StringForDiff() string {
+	if i == nil {
+		return ""
+	}
+	return i.Name
+}
+
+type ColumnIdent struct {
+	Ident *Ident
+	Order *Order
+}
+
+type Order struct{ Desc bool }
+
+func (i *ColumnIdent) GoString() string { return internal.GoString(*i) }
+
+func (i *ColumnIdent) String() string {
+	str := i.Ident.String()
+	if i.Order != nil && i.Order.Desc {
+		str += " DESC"
+	}
+	return str
+}
+
+func (i *ColumnIdent) StringForDiff() string {
+	str := i.Ident.StringForDiff()
+	if i.Order != nil && i.Order.Desc {
+		str += " DESC"
+	} else {
+		str += " ASC"
+	}
+	return str
+}
+
+// DataType represents a column type name.
+//
+// MEMO: SQLite accepts any sequence of identifiers as a type name (e.g. "UNSIGNED BIG INT"),
+// and determines the column affinity from it. https://www.sqlite.org/datatype3.html
+type DataType struct {
+	Name string
+	Type TokenType
+	Expr *Expr
+}
+
+func (s *DataType) String() string {
+	if s == nil {
+		return ""
+	}
+	str := s.Name
+	if s.Expr != nil && len(s.Expr.Idents) > 0 {
+		str += "(" + s.Expr.String() + ")"
+	}
+	return str
+}
+
+func (s *DataType) StringForDiff() string {
+	if s == nil {
+		return ""
+	}
+	str := strings.ToUpper(s.Name)
+
+	if s.Expr != nil && len(s.Expr.Idents) > 0 {
+		str += "("
+		for _, ident := range s.Expr.Idents {
+			str += ident.StringForDiff()
+		}
+		str += ")"
+	}
+
+	return str
+}
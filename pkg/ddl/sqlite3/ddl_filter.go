@@ -0,0 +1,33 @@
+package sqlite3
+
+// FilterTables returns the DDL that has only the statements of the tables for which keep reports true.
+// The statements that do not belong to a table, such as CREATE SEQUENCE, are kept.
+func (d *DDL) FilterTables(keep func(schema, table string) bool) *DDL {
+	filtered := &DDL{}
+	for _, stmt := range d.Stmts {
+		var table *ObjectName
+		switch s := stmt.(type) {
+		case *CreateTableStmt:
+			table = s.Name
+		case *AlterTableStmt:
+			table = s.Name
+		case *CreateIndexStmt:
+			table = s.TableName
+		}
+		if table != nil && !keep(table.schemaAndName()) {
+			continue
+		}
+		filtered.Stmts = append(filtered.Stmts, stmt)
+	}
+	return filtered
+}
+
+func (t *ObjectName) schemaAndName() (schema, name string) {
+	if t.Schema != nil {
+		schema = t.Schema.Name
+	}
+	if t.Name != nil {
+		name = t.Name.Name
+	}
+	return schema, name
+}
@@ -0,0 +1,82 @@
+package sqlite3
+
+import (
+	"strings"
+
+	stringz "github.com/kunitsucom/util.go/strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.sqlite.org/lang_createindex.html
+
+var _ Stmt = (*CreateIndexStmt)(nil)
+
+type CreateIndexStmt struct {
+	Comment     string
+	Unique      bool
+	IfNotExists bool
+	Name        *Ident
+	TableName   *ObjectName
+	Columns     []*ColumnIdent
+	Where       *Expr
+}
+
+func (s *CreateIndexStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *CreateIndexStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "CREATE "
+	if s.Unique {
+		str += "UNIQUE "
+	}
+	str += "INDEX "
+	if s.IfNotExists {
+		str += "IF NOT EXISTS "
+	}
+	str += s.Name.String() + " ON " + s.TableName.String()
+	str += " (" + stringz.JoinStringers(", ", s.Columns...) + ")"
+	if s.Where != nil {
+		str += " WHERE " + s.Where.String()
+	}
+	str += ";\n"
+	return str
+}
+
+func (s *CreateIndexStmt) StringForDiff() string {
+	str := "CREATE "
+	if s.Unique {
+		str += "UNIQUE "
+	}
+	str += "INDEX "
+	str += s.Name.StringForDiff() + " ON " + s.TableName.StringForDiff()
+	str += " ("
+	for i, c := range s.Columns {
+		if i > 0 {
+			str += ", "
+		}
+		str += c.StringForDiff()
+	}
+	str += ")"
+	if s.Where != nil {
+		str += " WHERE"
+		for _, v := range s.Where.Idents {
+			str += " " + v.StringForDiff()
+		}
+	}
+	str += ";\n"
+	return str
+}
+
+func (*CreateIndexStmt) isStmt()            {}
+func (s *CreateIndexStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,99 @@
+package sqlite3
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.sqlite.org/lang_altertable.html
+
+var _ Stmt = (*AlterTableStmt)(nil)
+
+type AlterTableStmt struct {
+	Comment string
+	Indent  string
+	Name    *ObjectName
+	Action  AlterTableAction
+}
+
+func (*AlterTableStmt) isStmt() {}
+
+func (s *AlterTableStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *AlterTableStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "ALTER TABLE "
+	str += s.Name.String() + " "
+	switch a := s.Action.(type) {
+	case *RenameTable:
+		str += "RENAME TO "
+		str += a.NewName.String()
+	case *RenameColumn:
+		str += "RENAME COLUMN " + a.Name.String() + " TO " + a.NewName.String()
+	case *AddColumn:
+		str += "ADD COLUMN " + a.Column.String()
+	case *DropColumn:
+		str += "DROP COLUMN " + a.Name.String()
+	}
+
+	return str + ";\n"
+}
+
+func (s *AlterTableStmt) GoString() string { return internal.GoString(*s) }
+
+// AlterTableAction represents an action of ALTER TABLE.
+//
+// MEMO: SQLite supports only RENAME TO, RENAME COLUMN, ADD COLUMN and DROP COLUMN.
+// Any other change requires the table to be rebuilt. See DiffCreateTable.
+type AlterTableAction interface {
+	isAlterTableAction()
+	GoString() string
+}
+
+// RenameTable represents ALTER TABLE table_name RENAME TO new_table_name.
+type RenameTable struct {
+	NewName *ObjectName
+}
+
+func (*RenameTable) isAlterTableAction() {}
+
+func (s *RenameTable) GoString() string { return internal.GoString(*s) }
+
+// RenameColumn represents ALTER TABLE table_name RENAME COLUMN.
+type RenameColumn struct {
+	Name    *Ident
+	NewName *Ident
+}
+
+func (*RenameColumn) isAlterTableAction() {}
+
+func (s *RenameColumn) GoString() string { return internal.GoString(*s) }
+
+// AddColumn represents ALTER TABLE table_name ADD COLUMN.
+type AddColumn struct {
+	Column *Column
+}
+
+func (*AddColumn) isAlterTableAction() {}
+
+func (s *AddColumn) GoString() string { return internal.GoString(*s) }
+
+// DropColumn represents ALTER TABLE table_name DROP COLUMN.
+type DropColumn struct {
+	Name *Ident
+}
+
+func (*DropColumn) isAlterTableAction() {}
+
+func (s *DropColumn) GoString() string { return internal.GoString(*s) }
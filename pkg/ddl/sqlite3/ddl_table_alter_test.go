@@ -0,0 +1,73 @@
+package sqlite3
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+)
+
+func TestAlterTableStmt_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		stmt     *AlterTableStmt
+		expected string
+	}{
+		{
+			name: "success,RenameTable",
+			stmt: &AlterTableStmt{
+				Comment: "test comment content",
+				Name:    &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+				Action:  &RenameTable{NewName: &ObjectName{Name: &Ident{Name: "test2", QuotationMark: `"`, Raw: `"test2"`}}},
+			},
+			expected: `-- test comment content
+ALTER TABLE "test" RENAME TO "test2";
+`,
+		},
+		{
+			name: "success,RenameColumn",
+			stmt: &AlterTableStmt{
+				Name:   &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+				Action: &RenameColumn{Name: &Ident{Name: "a", Raw: "a"}, NewName: &Ident{Name: "b", Raw: "b"}},
+			},
+			expected: `ALTER TABLE "test" RENAME COLUMN a TO b;
+`,
+		},
+		{
+			name: "success,AddColumn",
+			stmt: &AlterTableStmt{
+				Name: &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+				Action: &AddColumn{Column: &Column{
+					Name:     &Ident{Name: "a", Raw: "a"},
+					DataType: &DataType{Name: "TEXT"},
+					Collate:  &Ident{Name: "NOCASE", Raw: "NOCASE"},
+					Default:  &Default{Value: &Expr{Idents: []*Ident{NewRawIdent("'x'")}}},
+					NotNull:  true,
+				}},
+			},
+			expected: `ALTER TABLE "test" ADD COLUMN a TEXT COLLATE NOCASE DEFAULT 'x' NOT NULL;
+`,
+		},
+		{
+			name: "success,DropColumn",
+			stmt: &AlterTableStmt{
+				Name:   &ObjectName{Schema: &Ident{Name: "main", Raw: "main"}, Name: &Ident{Name: "test", Raw: "test"}},
+				Action: &DropColumn{Name: &Ident{Name: "a", Raw: "a"}},
+			},
+			expected: `ALTER TABLE main.test DROP COLUMN a;
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.expected, tt.stmt.String())
+			assert.Equal(t, tt.stmt.Name.StringForDiff(), tt.stmt.GetNameForDiff())
+
+			t.Logf("✅: %s: stmt: %#v", t.Name(), tt.stmt)
+		})
+	}
+}
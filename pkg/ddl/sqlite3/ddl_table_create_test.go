@@ -0,0 +1,60 @@
+package sqlite3
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+)
+
+func TestCreateTableStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateTableStmt{
+			Comment:     "test comment content",
+			IfNotExists: true,
+			Name:        &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			Columns: []*Column{
+				{Name: &Ident{Name: "id", Raw: "id"}, DataType: &DataType{Name: "INTEGER"}, NotNull: true},
+				{Name: &Ident{Name: "name", Raw: "name"}, DataType: &DataType{Name: "VARCHAR", Expr: &Expr{Idents: []*Ident{NewRawIdent("255")}}}},
+				{Name: &Ident{Name: "data", Raw: "data"}},
+			},
+			Constraints: Constraints{
+				&PrimaryKeyConstraint{Name: &Ident{Name: "test_pkey", Raw: "test_pkey"}, Columns: []*ColumnIdent{{Ident: &Ident{Name: "id", Raw: "id"}}}},
+			},
+			Options: []*Option{
+				{Name: "WITHOUT ROWID"},
+				{Name: "STRICT"},
+			},
+		}
+
+		expected := `-- test comment content
+CREATE TABLE IF NOT EXISTS "test" (
+    id INTEGER NOT NULL,
+    name VARCHAR(255),
+    data,
+    CONSTRAINT test_pkey PRIMARY KEY (id)
+) WITHOUT ROWID, STRICT;
+`
+		actual := stmt.String()
+		assert.Equal(t, expected, actual)
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
+
+func TestCreateTableStmt_GetNameForDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,main", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &CreateTableStmt{Name: NewObjectName(`"main.test"`)}
+		expected := "test"
+		actual := stmt.GetNameForDiff()
+
+		assert.Equal(t, expected, actual)
+	})
+}
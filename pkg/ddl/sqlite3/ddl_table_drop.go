@@ -0,0 +1,46 @@
+package sqlite3
+
+import (
+	"strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.sqlite.org/lang_droptable.html
+
+var _ Stmt = (*DropTableStmt)(nil)
+
+type DropTableStmt struct {
+	Comment  string
+	IfExists bool
+	Name     *ObjectName
+	// MEMO: not printed, but used to classify the change.
+	// Rebuild is true if the table is dropped to be rebuilt, and DroppedColumns are the columns not copied to the rebuilt table.
+	Rebuild        bool
+	DroppedColumns []*Ident
+}
+
+func (s *DropTableStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *DropTableStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "DROP TABLE "
+	if s.IfExists {
+		str += "IF EXISTS "
+	}
+	str += s.Name.String() + ";\n"
+	return str
+}
+
+func (*DropTableStmt) isStmt()            {}
+func (s *DropTableStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,63 @@
+package sqlite3
+
+import (
+	"strings"
+
+	stringz "github.com/kunitsucom/util.go/strings"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// MEMO: https://www.sqlite.org/lang_insert.html
+
+var _ Stmt = (*InsertIntoSelectStmt)(nil)
+
+// InsertIntoSelectStmt represents INSERT INTO table_name (columns) SELECT columns FROM source_table_name.
+// It is used to copy rows when a table is rebuilt.
+type InsertIntoSelectStmt struct {
+	Comment       string
+	Name          *ObjectName
+	Columns       []*Ident
+	SourceName    *ObjectName
+	SourceColumns []*Ident
+	// SourceDefaults are the defaults that replace NULL of SourceColumns with, such as for the column altered to NOT NULL.
+	// The source column whose default is nil is copied as it is.
+	SourceDefaults []*Default
+}
+
+func (s *InsertIntoSelectStmt) GetNameForDiff() string {
+	return s.Name.StringForDiff()
+}
+
+func (s *InsertIntoSelectStmt) String() string {
+	var str string
+	if s.Comment != "" {
+		comments := strings.Split(s.Comment, "\n")
+		for i := range comments {
+			if comments[i] != "" {
+				str += CommentPrefix + comments[i] + "\n"
+			}
+		}
+	}
+	str += "INSERT INTO " + s.Name.String()
+	str += " (" + stringz.JoinStringers(", ", s.Columns...) + ")"
+	str += " SELECT " + strings.Join(s.sourceExprs(), ", ")
+	str += " FROM " + s.SourceName.String()
+	str += ";\n"
+	return str
+}
+
+func (s *InsertIntoSelectStmt) sourceExprs() []string {
+	exprs := make([]string, 0, len(s.SourceColumns))
+	for i, column := range s.SourceColumns {
+		if i < len(s.SourceDefaults) && s.SourceDefaults[i] != nil && s.SourceDefaults[i].Value != nil {
+			exprs = append(exprs, "COALESCE("+column.String()+", "+s.SourceDefaults[i].Value.String()+")")
+			continue
+		}
+		exprs = append(exprs, column.String())
+	}
+	return exprs
+}
+
+func (*InsertIntoSelectStmt) isStmt()            {}
+func (s *InsertIntoSelectStmt) GoString() string { return internal.GoString(*s) }
@@ -0,0 +1,32 @@
+package sqlite3
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+)
+
+func TestInsertIntoSelectStmt_String(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		stmt := &InsertIntoSelectStmt{
+			Comment:       "test comment content",
+			Name:          &ObjectName{Name: &Ident{Name: "new_test", QuotationMark: `"`, Raw: `"new_test"`}},
+			Columns:       []*Ident{{Name: "id", Raw: "id"}, {Name: "name", QuotationMark: `"`, Raw: `"name"`}},
+			SourceName:    &ObjectName{Name: &Ident{Name: "test", QuotationMark: `"`, Raw: `"test"`}},
+			SourceColumns: []*Ident{{Name: "id", Raw: "id"}, {Name: "name", QuotationMark: `"`, Raw: `"name"`}},
+		}
+
+		expected := `-- test comment content
+INSERT INTO "new_test" (id, "name") SELECT id, "name" FROM "test";
+`
+		actual := stmt.String()
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, "new_test", stmt.GetNameForDiff())
+
+		t.Logf("✅: %s: stmt: %#v", t.Name(), stmt)
+	})
+}
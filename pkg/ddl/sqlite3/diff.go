@@ -0,0 +1,232 @@
+package sqlite3
+
+import (
+	"reflect"
+
+	errorz "github.com/kunitsucom/util.go/errors"
+	"github.com/kunitsucom/util.go/exp/diff/simplediff"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+type DiffConfig struct {
+	Renames         ddl.Renames
+	RenameHeuristic bool
+}
+
+type DiffOption interface {
+	apply(c *DiffConfig)
+}
+
+// DiffRenames declares the tables and columns renamed from before to after,
+// so that they are renamed by ALTER TABLE instead of being dropped and created.
+func DiffRenames(renames ddl.Renames) DiffOption { //nolint:ireturn
+	return &diffConfigRenames{
+		renames: renames,
+	}
+}
+
+type diffConfigRenames struct {
+	renames ddl.Renames
+}
+
+func (o *diffConfigRenames) apply(c *DiffConfig) {
+	c.Renames = append(c.Renames, o.renames...)
+}
+
+// DiffRenameHeuristic regards a dropped column and an added column that have the same definition as renamed.
+func DiffRenameHeuristic(enabled bool) DiffOption { //nolint:ireturn
+	return &diffConfigRenameHeuristic{
+		renameHeuristic: enabled,
+	}
+}
+
+type diffConfigRenameHeuristic struct {
+	renameHeuristic bool
+}
+
+func (o *diffConfigRenameHeuristic) apply(c *DiffConfig) {
+	c.RenameHeuristic = o.renameHeuristic
+}
+
+//nolint:funlen,cyclop,gocognit,gocyclo
+func Diff(before, after *DDL, opts ...DiffOption) (*DDL, error) {
+	config := &DiffConfig{}
+
+	for _, opt := range opts {
+		opt.apply(config)
+	}
+
+	result := &DDL{}
+
+	switch {
+	case before == nil && after != nil:
+		// MEMO: Tables are created in the order of their foreign key dependencies.
+		createTableStmts := make([]*CreateTableStmt, 0)
+		for _, stmt := range after.Stmts {
+			if s, ok := stmt.(*CreateTableStmt); ok {
+				createTableStmts = append(createTableStmts, s)
+			}
+		}
+		result.Stmts = append(result.Stmts, sortCreateTableStmts(createTableStmts)...)
+		for _, stmt := range after.Stmts {
+			if _, ok := stmt.(*CreateTableStmt); !ok {
+				result.Stmts = append(result.Stmts, stmt)
+			}
+		}
+		return result, nil
+	case before != nil && after == nil:
+		dropTableStmts := make([]*CreateTableStmt, 0)
+		for _, stmt := range before.Stmts {
+			switch s := stmt.(type) {
+			case *CreateTableStmt:
+				dropTableStmts = append(dropTableStmts, s)
+			case *CreateIndexStmt:
+				result.Stmts = append(result.Stmts, &DropIndexStmt{
+					Name: s.Name,
+				})
+			default:
+				return nil, apperr.Errorf("%s: %T: %w", s.GetNameForDiff(), s, ddl.ErrNotSupported)
+			}
+		}
+		// MEMO: Tables are dropped in the reverse order of their foreign key dependencies.
+		result.Stmts = append(result.Stmts, sortDropTableStmts(dropTableStmts)...)
+		return result, nil
+	case (before == nil && after == nil) || reflect.DeepEqual(before, after) || before.String() == after.String():
+		return nil, ddl.ErrNoDifference
+	}
+
+	// MEMO: The indexes of a rebuilt table are dropped with the table, so they must be re-created after the rebuild.
+	diffCreateTableConfig := &DiffCreateTableConfig{
+		Renames:         config.Renames,
+		RenameHeuristic: config.RenameHeuristic,
+	}
+	rebuiltTables := make(map[string]bool)
+	for _, beforeStmt := range before.Stmts {
+		if beforeStmt, ok := beforeStmt.(*CreateTableStmt); ok {
+			if afterStmt := config.findAlterTable(beforeStmt, before, after); afterStmt != nil {
+				if _, renamedBefore := diffCreateTableConfig.renameColumns(beforeStmt, afterStmt, beforeStmt.Name); requiresRebuild(renamedBefore, afterStmt) {
+					rebuiltTables[afterStmt.Name.StringForDiff()] = true
+				}
+			}
+		}
+	}
+
+	// DROP TABLE table_name;
+	// MEMO: Tables are dropped in the reverse order of their foreign key dependencies.
+	dropTableStmts := make([]*CreateTableStmt, 0)
+	for _, stmt := range onlyLeftStmt(before, after) {
+		switch beforeStmt := stmt.(type) {
+		case *CreateTableStmt:
+			if config.findRenamedTable(beforeStmt, before, after) != nil {
+				// MEMO: renamed by ALTER TABLE
+				continue
+			}
+			dropTableStmts = append(dropTableStmts, beforeStmt)
+		case *CreateIndexStmt:
+			result.Stmts = append(result.Stmts, &DropIndexStmt{
+				Name: beforeStmt.Name,
+			})
+		default:
+			return nil, apperr.Errorf("%s: %T: %w", beforeStmt.GetNameForDiff(), beforeStmt, ddl.ErrNotSupported)
+		}
+	}
+	result.Stmts = append(result.Stmts, sortDropTableStmts(dropTableStmts)...)
+
+	// DROP INDEX index_name;
+	// MEMO: SQLite cannot drop a column used by an index, so changed indexes are dropped before ALTER TABLE.
+	for _, beforeStmt := range before.Stmts {
+		if beforeStmt, ok := beforeStmt.(*CreateIndexStmt); ok {
+			if afterStmt, ok := findStmtByTypeAndName(beforeStmt, after.Stmts).(*CreateIndexStmt); ok {
+				if beforeStmt.StringForDiff() != afterStmt.StringForDiff() && !rebuiltTables[beforeStmt.TableName.StringForDiff()] {
+					result.Stmts = append(result.Stmts, &DropIndexStmt{
+						Comment: simplediff.Diff(beforeStmt.StringForDiff(), afterStmt.StringForDiff()).String(),
+						Name:    beforeStmt.Name,
+					})
+				}
+			}
+		}
+	}
+
+	// CREATE TABLE table_name
+	// MEMO: Tables are created in the order of their foreign key dependencies, and then the indexes are created.
+	createTableStmts := make([]*CreateTableStmt, 0)
+	createIndexStmts := make([]Stmt, 0)
+	for _, stmt := range onlyLeftStmt(after, before) {
+		switch afterStmt := stmt.(type) {
+		case *CreateTableStmt:
+			if config.isRenamedTable(afterStmt, before, after) {
+				// MEMO: renamed by ALTER TABLE
+				continue
+			}
+			createTableStmts = append(createTableStmts, afterStmt)
+		case *CreateIndexStmt:
+			if rebuiltTables[afterStmt.TableName.StringForDiff()] {
+				continue
+			}
+			createIndexStmts = append(createIndexStmts, afterStmt)
+		default:
+			return nil, apperr.Errorf("%s: %T: %w", afterStmt.GetNameForDiff(), afterStmt, ddl.ErrNotSupported)
+		}
+	}
+	result.Stmts = append(result.Stmts, sortCreateTableStmts(createTableStmts)...)
+	result.Stmts = append(result.Stmts, createIndexStmts...)
+
+	// ALTER TABLE table_name ...
+	for _, beforeStmt := range before.Stmts {
+		if beforeStmt, ok := beforeStmt.(*CreateTableStmt); ok {
+			if afterStmt := config.findAlterTable(beforeStmt, before, after); afterStmt != nil {
+				alterStmt, err := DiffCreateTable(beforeStmt, afterStmt,
+					DiffCreateTableRenames(config.Renames),
+					DiffCreateTableRenameHeuristic(config.RenameHeuristic),
+				)
+				if err == nil {
+					result.Stmts = append(result.Stmts, alterStmt.Stmts...)
+				}
+				errorz.PanicOrIgnore(err, ddl.ErrNoDifference) // MEMO: If before and after table_name is match, DiffCreateTable does not return error except ddl.ErrNoDifference.
+			}
+		}
+	}
+
+	// CREATE INDEX index_name ...
+	for _, afterStmt := range after.Stmts {
+		if afterStmt, ok := afterStmt.(*CreateIndexStmt); ok {
+			if rebuiltTables[afterStmt.TableName.StringForDiff()] {
+				result.Stmts = append(result.Stmts, afterStmt)
+				continue
+			}
+			if beforeStmt, ok := findStmtByTypeAndName(afterStmt, before.Stmts).(*CreateIndexStmt); ok && beforeStmt.StringForDiff() != afterStmt.StringForDiff() {
+				result.Stmts = append(result.Stmts, afterStmt)
+			}
+		}
+	}
+
+	if len(result.Stmts) == 0 {
+		return nil, ddl.ErrNoDifference
+	}
+
+	return result, nil
+}
+
+func onlyLeftStmt(left, right *DDL) []Stmt {
+	result := make([]Stmt, 0)
+
+	for _, stmt := range left.Stmts {
+		if findStmtByTypeAndName(stmt, right.Stmts) == nil {
+			result = append(result, stmt)
+		}
+	}
+
+	return result
+}
+
+func findStmtByTypeAndName(stmt Stmt, stmts []Stmt) Stmt { //nolint:ireturn
+	for _, s := range stmts {
+		if reflect.TypeOf(stmt) == reflect.TypeOf(s) && stmt.GetNameForDiff() == s.GetNameForDiff() {
+			return s
+		}
+	}
+	return nil
+}
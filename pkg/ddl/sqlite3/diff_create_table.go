@@ -0,0 +1,337 @@
+package sqlite3
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/kunitsucom/util.go/exp/diff/simplediff"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+// MEMO: https://www.sqlite.org/lang_altertable.html#otheralter
+
+const rebuildTablePrefix = "new_"
+
+type DiffCreateTableConfig struct {
+	Renames         ddl.Renames
+	RenameHeuristic bool
+}
+
+type DiffCreateTableOption interface {
+	apply(c *DiffCreateTableConfig)
+}
+
+func DiffCreateTableRenames(renames ddl.Renames) DiffCreateTableOption { //nolint:ireturn
+	return &diffCreateTableConfigRenames{
+		renames: renames,
+	}
+}
+
+type diffCreateTableConfigRenames struct {
+	renames ddl.Renames
+}
+
+func (o *diffCreateTableConfigRenames) apply(c *DiffCreateTableConfig) {
+	c.Renames = append(c.Renames, o.renames...)
+}
+
+func DiffCreateTableRenameHeuristic(enabled bool) DiffCreateTableOption { //nolint:ireturn
+	return &diffCreateTableConfigRenameHeuristic{
+		renameHeuristic: enabled,
+	}
+}
+
+type diffCreateTableConfigRenameHeuristic struct {
+	renameHeuristic bool
+}
+
+func (o *diffCreateTableConfigRenameHeuristic) apply(c *DiffCreateTableConfig) {
+	c.RenameHeuristic = o.renameHeuristic
+}
+
+// DiffCreateTable returns the DDL to migrate the table from before to after.
+//
+// SQLite's ALTER TABLE supports only RENAME TO, RENAME COLUMN, ADD COLUMN and DROP COLUMN,
+// so any other change is applied by rebuilding the table:
+//
+//	CREATE TABLE "new_table_name" (...);
+//	INSERT INTO "new_table_name" (...) SELECT ... FROM "table_name";
+//	DROP TABLE "table_name";
+//	ALTER TABLE "new_table_name" RENAME TO "table_name";
+//
+// The indexes of the rebuilt table are dropped with the old table, so Diff re-creates them.
+// When the table is referenced by foreign keys, the DDL should be applied with PRAGMA foreign_keys = OFF.
+//
+//nolint:funlen,cyclop
+func DiffCreateTable(before, after *CreateTableStmt, opts ...DiffCreateTableOption) (*DDL, error) {
+	config := &DiffCreateTableConfig{}
+
+	for _, opt := range opts {
+		opt.apply(config)
+	}
+
+	result := &DDL{}
+
+	switch {
+	case before == nil && after != nil:
+		// CREATE TABLE table_name
+		result.Stmts = append(result.Stmts, after)
+		return result, nil
+	case before != nil && after == nil:
+		// DROP TABLE table_name;
+		result.Stmts = append(result.Stmts, &DropTableStmt{
+			Name: before.Name,
+		})
+		return result, nil
+	case (before == nil && after == nil) || reflect.DeepEqual(before, after) || before.String() == after.String():
+		return nil, apperr.Errorf("before: %s, after: %s: %w", before.GetNameForDiff(), after.GetNameForDiff(), ddl.ErrNoDifference)
+	}
+
+	if renameColumnStmts, renamedBefore := config.renameColumns(before, after, before.Name); requiresRebuild(renamedBefore, after) {
+		// MEMO: The columns are renamed before the rebuild, so that the data is copied from the renamed columns.
+		result.Stmts = append(result.Stmts, renameColumnStmts...)
+		result.Stmts = append(result.Stmts, rebuildTable(renamedBefore, after)...)
+		return result, nil
+	}
+
+	if before.Name.StringForDiff() != after.Name.StringForDiff() {
+		// ALTER TABLE table_name RENAME TO new_table_name;
+		result.Stmts = append(result.Stmts, &AlterTableStmt{
+			Comment: simplediff.Diff(before.Name.StringForDiff(), after.Name.StringForDiff()).String(),
+			Name:    before.Name,
+			Action: &RenameTable{
+				NewName: after.Name,
+			},
+		})
+	}
+
+	// ALTER TABLE table_name RENAME COLUMN column_name TO new_column_name;
+	renameColumnStmts, before := config.renameColumns(before, after, after.Name)
+	result.Stmts = append(result.Stmts, renameColumnStmts...)
+
+	for _, beforeColumn := range before.Columns {
+		if findColumnByName(beforeColumn.Name.Name, after.Columns) == nil {
+			// ALTER TABLE table_name DROP COLUMN column_name;
+			result.Stmts = append(result.Stmts, &AlterTableStmt{
+				Comment: simplediff.Diff(beforeColumn.String(), "").String(),
+				Name:    after.Name, // ALTER TABLE RENAME TO で変更された後の可能性があるため after.Name を使用する
+				Action: &DropColumn{
+					Name: beforeColumn.Name,
+				},
+			})
+		}
+	}
+
+	for _, afterColumn := range onlyLeftColumn(after.Columns, before.Columns) {
+		// ALTER TABLE table_name ADD COLUMN column_name data_type;
+		result.Stmts = append(result.Stmts, &AlterTableStmt{
+			Comment: simplediff.Diff("", afterColumn.String()).String(),
+			Name:    after.Name,
+			Action: &AddColumn{
+				Column: afterColumn,
+			},
+		})
+	}
+
+	if len(result.Stmts) == 0 {
+		return nil, apperr.Errorf("before: %s, after: %s: %w", before.GetNameForDiff(), after.GetNameForDiff(), ddl.ErrNoDifference)
+	}
+
+	return result, nil
+}
+
+// requiresRebuild reports whether the difference between before and after cannot be applied by ALTER TABLE.
+//
+//nolint:cyclop
+func requiresRebuild(before, after *CreateTableStmt) bool {
+	if optionsStringForDiff(before.Options) != optionsStringForDiff(after.Options) {
+		return true
+	}
+
+	if constraintsStringForDiff(before.Constraints) != constraintsStringForDiff(after.Constraints) {
+		return true
+	}
+
+	for _, beforeColumn := range before.Columns {
+		afterColumn := findColumnByName(beforeColumn.Name.Name, after.Columns)
+		if afterColumn == nil {
+			// MEMO: DROP COLUMN fails if the column is PRIMARY KEY, UNIQUE, or a part of a constraint such as REFERENCES.
+			// The column constraints are normalized to the table constraints, except PRIMARY KEY AUTOINCREMENT kept in the column.
+			if beforeColumn.Autoincrement || isColumnReferencedByConstraints(beforeColumn.Name, before.Constraints) {
+				return true
+			}
+			continue
+		}
+		if beforeColumn.StringForDiff() != afterColumn.StringForDiff() {
+			return true
+		}
+	}
+
+	for _, afterColumn := range onlyLeftColumn(after.Columns, before.Columns) {
+		if !canAddColumn(afterColumn) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// canAddColumn reports whether the column can be added by ALTER TABLE ADD COLUMN.
+//
+// MEMO: https://www.sqlite.org/lang_altertable.html#altertabaddcol
+func canAddColumn(column *Column) bool {
+	if column.Autoincrement {
+		return false
+	}
+
+	if column.Default == nil || column.Default.Value == nil || len(column.Default.Value.Idents) == 0 {
+		return !column.NotNull
+	}
+
+	for _, ident := range column.Default.Value.Idents {
+		switch strings.ToUpper(ident.StringForDiff()) {
+		case "(", "CURRENT_TIME", "CURRENT_DATE", "CURRENT_TIMESTAMP":
+			return false
+		}
+	}
+
+	return true
+}
+
+func isColumnReferencedByConstraints(name *Ident, constraints Constraints) bool {
+	for _, constraint := range constraints {
+		switch c := constraint.(type) {
+		case *PrimaryKeyConstraint:
+			if findColumnIdentByName(name.Name, c.Columns) != nil {
+				return true
+			}
+		case *ForeignKeyConstraint:
+			if findColumnIdentByName(name.Name, c.Columns) != nil {
+				return true
+			}
+		case *UniqueConstraint:
+			if findColumnIdentByName(name.Name, c.Columns) != nil {
+				return true
+			}
+		case *CheckConstraint:
+			for _, ident := range c.Expr.Idents {
+				if ident.StringForDiff() == name.Name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func rebuildTable(before, after *CreateTableStmt) []Stmt {
+	newName := &ObjectName{
+		Schema: after.Name.Schema,
+		Name: NewIdent(
+			rebuildTablePrefix+after.Name.Name.Name,
+			after.Name.Name.QuotationMark,
+			after.Name.Name.QuotationMark+rebuildTablePrefix+after.Name.Name.Name+after.Name.Name.QuotationMark,
+		),
+	}
+
+	newTable := *after
+	newTable.Comment = simplediff.Diff(strings.TrimSuffix(before.String(), "\n"), strings.TrimSuffix(after.String(), "\n")).String()
+	newTable.IfNotExists = false
+	newTable.Name = newName
+
+	columns := make([]*Ident, 0, len(after.Columns))
+	sourceColumns := make([]*Ident, 0, len(after.Columns))
+	sourceDefaults := make([]*Default, 0, len(after.Columns))
+	for _, afterColumn := range after.Columns {
+		if beforeColumn := findColumnByName(afterColumn.Name.Name, before.Columns); beforeColumn != nil {
+			columns = append(columns, afterColumn.Name)
+			sourceColumns = append(sourceColumns, beforeColumn.Name)
+			// MEMO: INSERT does not apply the default to NULL, so NULL of the nullable column is replaced with the default of the NOT NULL column explicitly.
+			var sourceDefault *Default
+			if afterColumn.NotNull && !beforeColumn.NotNull {
+				sourceDefault = afterColumn.Default
+			}
+			sourceDefaults = append(sourceDefaults, sourceDefault)
+		}
+	}
+
+	stmts := []Stmt{&newTable}
+	if len(columns) > 0 {
+		stmts = append(stmts, &InsertIntoSelectStmt{
+			Name:           newName,
+			Columns:        columns,
+			SourceName:     before.Name,
+			SourceColumns:  sourceColumns,
+			SourceDefaults: sourceDefaults,
+		})
+	}
+	droppedColumns := make([]*Ident, 0)
+	for _, beforeColumn := range onlyLeftColumn(before.Columns, after.Columns) {
+		droppedColumns = append(droppedColumns, beforeColumn.Name)
+	}
+	stmts = append(stmts,
+		&DropTableStmt{
+			Name:           before.Name,
+			Rebuild:        true,
+			DroppedColumns: droppedColumns,
+		},
+		&AlterTableStmt{
+			Name: newName,
+			Action: &RenameTable{
+				NewName: &ObjectName{Name: after.Name.Name},
+			},
+		},
+	)
+
+	return stmts
+}
+
+func optionsStringForDiff(options []*Option) string {
+	strs := make([]string, 0, len(options))
+	for _, option := range options {
+		strs = append(strs, strings.ToUpper(option.Name))
+	}
+	return strings.Join(strs, ", ")
+}
+
+func constraintsStringForDiff(constraints Constraints) string {
+	strs := make([]string, 0, len(constraints))
+	for _, constraint := range constraints {
+		strs = append(strs, constraint.StringForDiff())
+	}
+	sort.Strings(strs)
+	return strings.Join(strs, ", ")
+}
+
+func onlyLeftColumn(left, right []*Column) []*Column {
+	onlyLeftColumns := make([]*Column, 0)
+	for _, leftColumn := range left {
+		foundColumnByRight := findColumnByName(leftColumn.Name.Name, right)
+		if foundColumnByRight == nil {
+			onlyLeftColumns = append(onlyLeftColumns, leftColumn)
+		}
+	}
+	return onlyLeftColumns
+}
+
+func findColumnByName(name string, columns []*Column) *Column {
+	for _, column := range columns {
+		if column.Name.Name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+func findColumnIdentByName(name string, columns []*ColumnIdent) *ColumnIdent {
+	for _, column := range columns {
+		if column.Ident.Name == name {
+			return column
+		}
+	}
+	return nil
+}
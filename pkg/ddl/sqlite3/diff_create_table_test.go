@@ -0,0 +1,306 @@
+package sqlite3
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+//nolint:paralleltest,tparallel
+func TestDiffCreateTable(t *testing.T) {
+	t.Run("failure,ddl.ErrNoDifference", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT NOT NULL UNIQUE, description TEXT);`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT NOT NULL UNIQUE, description TEXT);`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+		)
+
+		assert.ErrorIs(t, err, ddl.ErrNoDifference)
+		assert.Nil(t, actual)
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
+	t.Run("success,ADD_COLUMN", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT NOT NULL, description TEXT);`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT NOT NULL, "age" INTEGER NOT NULL DEFAULT 0, description TEXT);`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+		)
+
+		expectedStr := `-- -
+-- +"age" INTEGER DEFAULT 0 NOT NULL
+ALTER TABLE "users" ADD COLUMN "age" INTEGER DEFAULT 0 NOT NULL;
+`
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStr, actual.String())
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
+	t.Run("success,DROP_COLUMN", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT NOT NULL, description TEXT);`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT NOT NULL);`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+		)
+
+		expectedStr := `-- -description TEXT
+-- +
+ALTER TABLE "users" DROP COLUMN description;
+`
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStr, actual.String())
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
+	t.Run("success,RENAME_TO", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT NOT NULL);`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "accounts" (id INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT NOT NULL);`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+		)
+
+		expectedStr := `-- -users
+-- +accounts
+ALTER TABLE "users" RENAME TO "accounts";
+`
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStr, actual.String())
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
+	t.Run("success,REBUILD,ALTER_COLUMN", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT, "name" TEXT NOT NULL, description TEXT);`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT, "name" VARCHAR(255), description TEXT, created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP);`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+		)
+
+		expectedStr := `--  CREATE TABLE "users" (
+--      id INTEGER PRIMARY KEY AUTOINCREMENT,
+-- -    "name" TEXT NOT NULL,
+-- -    description TEXT
+-- +    "name" VARCHAR(255),
+-- +    description TEXT,
+-- +    created_at TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL
+--  );
+CREATE TABLE "new_users" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    "name" VARCHAR(255),
+    description TEXT,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL
+);
+INSERT INTO "new_users" (id, "name", description) SELECT id, "name", description FROM "users";
+DROP TABLE "users";
+ALTER TABLE "new_users" RENAME TO "users";
+`
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStr, actual.String())
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
+	t.Run("success,REBUILD,DROP_CONSTRAINT", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id TEXT NOT NULL PRIMARY KEY, "name" TEXT NOT NULL UNIQUE, "age" INTEGER CHECK ("age" >= 0));`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "users" (id TEXT NOT NULL PRIMARY KEY, "name" TEXT NOT NULL) WITHOUT ROWID;`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+		)
+
+		expectedStr := `--  CREATE TABLE "users" (
+--      id TEXT NOT NULL,
+--      "name" TEXT NOT NULL,
+-- -    "age" INTEGER,
+-- -    CONSTRAINT users_pkey PRIMARY KEY (id),
+-- -    CONSTRAINT users_unique_name UNIQUE ("name"),
+-- -    CONSTRAINT users_age_check CHECK ("age" >= 0)
+-- -);
+-- +    CONSTRAINT users_pkey PRIMARY KEY (id)
+-- +) WITHOUT ROWID;
+CREATE TABLE "new_users" (
+    id TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    CONSTRAINT users_pkey PRIMARY KEY (id)
+) WITHOUT ROWID;
+INSERT INTO "new_users" (id, "name") SELECT id, "name" FROM "users";
+DROP TABLE "users";
+ALTER TABLE "new_users" RENAME TO "users";
+`
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedStr, actual.String())
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
+	t.Run("success,REBUILD,NOT_NULL_WITH_DEFAULT", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id INTEGER PRIMARY KEY, "name" TEXT, "age" INTEGER NOT NULL);`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		after := `CREATE TABLE "users" (id INTEGER PRIMARY KEY, "name" TEXT NOT NULL DEFAULT '', "age" INTEGER NOT NULL DEFAULT 0);`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		actual, err := DiffCreateTable(
+			beforeDDL.Stmts[0].(*CreateTableStmt),
+			afterDDL.Stmts[0].(*CreateTableStmt),
+		)
+		assert.NoError(t, err)
+		assert.True(t, strings.Contains(actual.String(), `INSERT INTO "new_users" (id, "name", "age") SELECT id, COALESCE("name", ''), "age" FROM "users";`+"\n"))
+
+		t.Logf("✅: %s:\n%s", t.Name(), actual)
+	})
+
+	t.Run("success,before_nil", func(t *testing.T) {
+		t.Parallel()
+
+		after := `CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT);`
+		afterDDL, err := NewParser(NewLexer(after)).Parse()
+		require.NoError(t, err)
+
+		actual, err := DiffCreateTable(nil, afterDDL.Stmts[0].(*CreateTableStmt))
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE TABLE "users" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT
+);
+`, actual.String())
+	})
+
+	t.Run("success,after_nil", func(t *testing.T) {
+		t.Parallel()
+
+		before := `CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT);`
+		beforeDDL, err := NewParser(NewLexer(before)).Parse()
+		require.NoError(t, err)
+
+		actual, err := DiffCreateTable(beforeDDL.Stmts[0].(*CreateTableStmt), nil)
+		require.NoError(t, err)
+		assert.Equal(t, `DROP TABLE "users";
+`, actual.String())
+	})
+}
+
+func Test_requiresRebuild(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		before string
+		after  string
+		want   bool
+	}{
+		{name: "success,drop_column", before: `CREATE TABLE t (id INTEGER PRIMARY KEY, c TEXT);`, after: `CREATE TABLE t (id INTEGER PRIMARY KEY);`, want: false},
+		{name: "success,drop_primary_key", before: `CREATE TABLE t (id INTEGER PRIMARY KEY, c TEXT);`, after: `CREATE TABLE t (c TEXT);`, want: true},
+		{name: "success,drop_primary_key_autoincrement", before: `CREATE TABLE t (id INTEGER PRIMARY KEY AUTOINCREMENT, c TEXT);`, after: `CREATE TABLE t (c TEXT);`, want: true},
+		{name: "success,drop_unique", before: `CREATE TABLE t (id INTEGER PRIMARY KEY, c TEXT UNIQUE);`, after: `CREATE TABLE t (id INTEGER PRIMARY KEY);`, want: true},
+		{name: "success,drop_references", before: `CREATE TABLE t (id INTEGER PRIMARY KEY, c INTEGER REFERENCES u (id));`, after: `CREATE TABLE t (id INTEGER PRIMARY KEY);`, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			before, err := NewParser(NewLexer(tt.before)).Parse()
+			require.NoError(t, err)
+			after, err := NewParser(NewLexer(tt.after)).Parse()
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, requiresRebuild(before.Stmts[0].(*CreateTableStmt), after.Stmts[0].(*CreateTableStmt)))
+		})
+	}
+}
+
+func Test_canAddColumn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  bool
+	}{
+		{name: "success,nullable", input: `CREATE TABLE t (c TEXT);`, want: true},
+		{name: "success,not_null_with_default", input: `CREATE TABLE t (c TEXT NOT NULL DEFAULT 'x');`, want: true},
+		{name: "success,not_null_without_default", input: `CREATE TABLE t (c TEXT NOT NULL);`, want: false},
+		{name: "success,current_timestamp", input: `CREATE TABLE t (c TEXT DEFAULT CURRENT_TIMESTAMP);`, want: false},
+		{name: "success,expression", input: `CREATE TABLE t (c INTEGER DEFAULT (1 + 1));`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := NewParser(NewLexer(tt.input)).Parse()
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, canAddColumn(d.Stmts[0].(*CreateTableStmt).Columns[0]))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package sqlite3
+
+import (
+	"github.com/kunitsucom/ddlctl/pkg/ddl/internal"
+)
+
+// sortCreateTableStmts sorts the tables so that the tables referenced by foreign keys are created first.
+//
+// MEMO: SQLite cannot add a foreign key by ALTER TABLE, so the tables that reference each other are
+// created as they are. SQLite resolves foreign key references when the rows are modified, not when the table is created.
+func sortCreateTableStmts(stmts []*CreateTableStmt) []Stmt {
+	order, _ := internal.TopologicalSort(len(stmts), func(i, j int) bool {
+		return referencesTable(stmts[i], stmts[j].Name)
+	})
+
+	createTableStmts := make([]Stmt, 0, len(stmts))
+	for _, i := range order {
+		createTableStmts = append(createTableStmts, stmts[i])
+	}
+
+	return createTableStmts
+}
+
+// sortDropTableStmts returns DROP TABLE in the reverse order of sortCreateTableStmts,
+// so that the tables referencing others are dropped first.
+func sortDropTableStmts(stmts []*CreateTableStmt) []Stmt {
+	order, _ := internal.TopologicalSort(len(stmts), func(i, j int) bool {
+		return referencesTable(stmts[i], stmts[j].Name)
+	})
+
+	dropStmts := make([]Stmt, 0, len(stmts))
+	for i := len(order) - 1; i >= 0; i-- {
+		dropStmts = append(dropStmts, &DropTableStmt{
+			Name: stmts[order[i]].Name,
+		})
+	}
+
+	return dropStmts
+}
+
+// referencesTable reports whether stmt has a foreign key that references the table.
+func referencesTable(stmt *CreateTableStmt, table *ObjectName) bool {
+	for _, c := range stmt.Constraints {
+		if fk, ok := c.(*ForeignKeyConstraint); ok && isReferenceTo(fk.Ref, table) {
+			return true
+		}
+	}
+	return false
+}
+
+// isReferenceTo reports whether ref refers to the table.
+// If either of them is not qualified by a schema, only the table names are compared.
+func isReferenceTo(ref *Ident, table *ObjectName) bool {
+	refName := NewObjectName(ref.Raw)
+	if refName.Schema == nil || table.Schema == nil {
+		return refName.Name.StringForDiff() == table.Name.StringForDiff()
+	}
+	return refName.StringForDiff() == table.StringForDiff()
+}
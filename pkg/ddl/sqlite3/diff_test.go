@@ -0,0 +1,233 @@
+package sqlite3
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("failure,ddl.ErrNoDifference", func(t *testing.T) {
+		t.Parallel()
+
+		before := &DDL{}
+		after := &DDL{}
+		_, err := Diff(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("failure,ddl.ErrNotSupported,DropTableStmt", func(t *testing.T) {
+		t.Parallel()
+
+		{
+			before := &DDL{
+				Stmts: []Stmt{
+					&DropTableStmt{Name: &ObjectName{Name: &Ident{Name: "table_name", Raw: "table_name"}}},
+				},
+			}
+			after := (*DDL)(nil)
+			_, err := Diff(before, after)
+			require.ErrorIs(t, err, ddl.ErrNotSupported)
+		}
+		{
+			before := &DDL{
+				Stmts: []Stmt{
+					&DropTableStmt{Name: &ObjectName{Name: &Ident{Name: "table_name", Raw: "table_name"}}},
+				},
+			}
+			after := &DDL{}
+			_, err := Diff(before, after)
+			require.ErrorIs(t, err, ddl.ErrNotSupported)
+		}
+		{
+			before := &DDL{}
+			after := &DDL{
+				Stmts: []Stmt{
+					&DropTableStmt{Name: &ObjectName{Name: &Ident{Name: "table_name", Raw: "table_name"}}},
+				},
+			}
+			_, err := Diff(before, after)
+			require.ErrorIs(t, err, ddl.ErrNotSupported)
+		}
+	})
+
+	t.Run("success,before_nil", func(t *testing.T) {
+		t.Parallel()
+
+		after, err := NewParser(NewLexer(`CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT); CREATE INDEX users_idx_id ON "users" (id);`)).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(nil, after)
+		require.NoError(t, err)
+		assert.Equal(t, after.String(), actual.String())
+	})
+
+	t.Run("success,after_nil", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT); CREATE INDEX users_idx_id ON "users" (id);`)).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(before, nil)
+		require.NoError(t, err)
+		assert.Equal(t, `DROP INDEX users_idx_id;
+DROP TABLE "users";
+`, actual.String())
+	})
+
+	t.Run("success,before_nil,ForeignKey", func(t *testing.T) {
+		t.Parallel()
+
+		after, err := NewParser(NewLexer(`CREATE TABLE posts (id INTEGER PRIMARY KEY, user_id INTEGER NOT NULL REFERENCES users (id)); CREATE TABLE users (id INTEGER PRIMARY KEY);`)).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(nil, after)
+		require.NoError(t, err)
+		assert.Equal(t, `CREATE TABLE users (
+    id INTEGER,
+    CONSTRAINT users_pkey PRIMARY KEY (id)
+);
+CREATE TABLE posts (
+    id INTEGER,
+    user_id INTEGER NOT NULL,
+    CONSTRAINT posts_pkey PRIMARY KEY (id),
+    CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id)
+);
+`, actual.String())
+
+		actual, err = Diff(after, nil)
+		require.NoError(t, err)
+		assert.Equal(t, `DROP TABLE posts;
+DROP TABLE users;
+`, actual.String())
+	})
+
+	t.Run("success,CREATE_TABLE_DROP_TABLE", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT);`)).Parse()
+		require.NoError(t, err)
+		after, err := NewParser(NewLexer(`CREATE TABLE "groups" (id INTEGER PRIMARY KEY AUTOINCREMENT);`)).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		assert.Equal(t, `DROP TABLE "users";
+CREATE TABLE "groups" (
+    id INTEGER PRIMARY KEY AUTOINCREMENT
+);
+`, actual.String())
+	})
+
+	t.Run("success,REBUILD_WITH_INDEX", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE "users" (id TEXT NOT NULL PRIMARY KEY, "name" TEXT NOT NULL UNIQUE);
+CREATE INDEX users_idx_name ON "users" ("name");
+CREATE INDEX users_idx_id ON "users" (id);
+CREATE TABLE "groups" (id TEXT NOT NULL PRIMARY KEY, memo TEXT);
+CREATE INDEX groups_idx_memo ON "groups" (memo);
+CREATE INDEX groups_idx_id ON "groups" (id);
+`)).Parse()
+		require.NoError(t, err)
+		after, err := NewParser(NewLexer(`CREATE TABLE "users" (id TEXT NOT NULL PRIMARY KEY, "name" TEXT NOT NULL);
+CREATE INDEX users_idx_name ON "users" ("name" DESC);
+CREATE INDEX users_idx_new ON "users" (id, "name");
+CREATE TABLE "groups" (id TEXT NOT NULL PRIMARY KEY);
+CREATE INDEX groups_idx_id ON "groups" (id DESC);
+`)).Parse()
+		require.NoError(t, err)
+
+		actual, err := Diff(before, after)
+		require.NoError(t, err)
+		assert.Equal(t, `DROP INDEX users_idx_id;
+DROP INDEX groups_idx_memo;
+-- -CREATE INDEX groups_idx_id ON groups (id ASC);
+-- +CREATE INDEX groups_idx_id ON groups (id DESC);
+--  
+DROP INDEX groups_idx_id;
+--  CREATE TABLE "users" (
+--      id TEXT NOT NULL,
+--      "name" TEXT NOT NULL,
+-- -    CONSTRAINT users_pkey PRIMARY KEY (id),
+-- -    CONSTRAINT users_unique_name UNIQUE ("name")
+-- +    CONSTRAINT users_pkey PRIMARY KEY (id)
+--  );
+CREATE TABLE "new_users" (
+    id TEXT NOT NULL,
+    "name" TEXT NOT NULL,
+    CONSTRAINT users_pkey PRIMARY KEY (id)
+);
+INSERT INTO "new_users" (id, "name") SELECT id, "name" FROM "users";
+DROP TABLE "users";
+ALTER TABLE "new_users" RENAME TO "users";
+-- -memo TEXT
+-- +
+ALTER TABLE "groups" DROP COLUMN memo;
+CREATE INDEX users_idx_name ON "users" ("name" DESC);
+CREATE INDEX users_idx_new ON "users" (id, "name");
+CREATE INDEX groups_idx_id ON "groups" (id DESC);
+`, actual.String())
+	})
+
+	t.Run("failure,ddl.ErrNoDifference,same_definition", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT); CREATE INDEX users_idx_id ON "users" (id);`)).Parse()
+		require.NoError(t, err)
+		after, err := NewParser(NewLexer(`CREATE TABLE "users" (id INTEGER PRIMARY KEY AUTOINCREMENT);
+CREATE INDEX users_idx_id ON "users" (id ASC);`)).Parse()
+		require.NoError(t, err)
+
+		_, err = Diff(before, after)
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+
+	t.Run("success,DiffRenames", func(t *testing.T) {
+		t.Parallel()
+
+		before, err := NewParser(NewLexer(`CREATE TABLE "old_users" (id TEXT NOT NULL, "name" TEXT NOT NULL, CONSTRAINT users_pkey PRIMARY KEY (id));
+CREATE TABLE "groups" (id TEXT NOT NULL, "name" TEXT NOT NULL, memo TEXT, CONSTRAINT groups_unique_name UNIQUE ("name"));`)).Parse()
+		require.NoError(t, err)
+		after, err := NewParser(NewLexer(`CREATE TABLE "users" (id TEXT NOT NULL, full_name TEXT NOT NULL, CONSTRAINT users_pkey PRIMARY KEY (id));
+CREATE TABLE "groups" (id TEXT NOT NULL, group_name TEXT NOT NULL, memo TEXT NOT NULL DEFAULT '', CONSTRAINT groups_unique_name UNIQUE (group_name));`)).Parse()
+		require.NoError(t, err)
+
+		renames, err := ddl.ParseRenames("old_users=users, users.name=users.full_name, groups.name=groups.group_name")
+		require.NoError(t, err)
+
+		actual, err := Diff(before, after, DiffRenames(renames))
+		require.NoError(t, err)
+		assert.Equal(t, `-- -old_users
+-- +users
+ALTER TABLE "old_users" RENAME TO "users";
+-- -"name" TEXT NOT NULL
+-- +full_name TEXT NOT NULL
+ALTER TABLE "users" RENAME COLUMN "name" TO full_name;
+-- -"name" TEXT NOT NULL
+-- +group_name TEXT NOT NULL
+ALTER TABLE "groups" RENAME COLUMN "name" TO group_name;
+--  CREATE TABLE "groups" (
+--      id TEXT NOT NULL,
+--      group_name TEXT NOT NULL,
+-- -    memo TEXT,
+-- +    memo TEXT DEFAULT '' NOT NULL,
+--      CONSTRAINT groups_unique_name UNIQUE (group_name)
+--  );
+CREATE TABLE "new_groups" (
+    id TEXT NOT NULL,
+    group_name TEXT NOT NULL,
+    memo TEXT DEFAULT '' NOT NULL,
+    CONSTRAINT groups_unique_name UNIQUE (group_name)
+);
+INSERT INTO "new_groups" (id, group_name, memo) SELECT id, group_name, COALESCE(memo, '') FROM "groups";
+DROP TABLE "groups";
+ALTER TABLE "new_groups" RENAME TO "groups";
+`, actual.String())
+	})
+}
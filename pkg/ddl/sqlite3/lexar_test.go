@@ -0,0 +1,229 @@
+package sqlite3
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func Test_lookupIdent(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  TokenType
+	}{
+		{name: "success,EQUAL", input: "EQUAL", want: TOKEN_EQUAL},
+		{name: "success,GREATER", input: "GREATER", want: TOKEN_GREATER},
+		{name: "success,LESS", input: "LESS", want: TOKEN_LESS},
+		{name: "success,CREATE", input: "CREATE", want: TOKEN_CREATE},
+		{name: "success,ALTER", input: "ALTER", want: TOKEN_ALTER},
+		{name: "success,DROP", input: "DROP", want: TOKEN_DROP},
+		{name: "success,RENAME", input: "RENAME", want: TOKEN_RENAME},
+		{name: "success,INSERT", input: "INSERT", want: TOKEN_INSERT},
+		{name: "success,DELETE", input: "DELETE", want: TOKEN_DELETE},
+		{name: "success,UPDATE", input: "UPDATE", want: TOKEN_UPDATE},
+		{name: "success,TABLE", input: "TABLE", want: TOKEN_TABLE},
+		{name: "success,INDEX", input: "INDEX", want: TOKEN_INDEX},
+		{name: "success,VIEW", input: "VIEW", want: TOKEN_VIEW},
+		{name: "success,IF", input: "IF", want: TOKEN_IF},
+		{name: "success,EXISTS", input: "EXISTS", want: TOKEN_EXISTS},
+		{name: "success,ON", input: "ON", want: TOKEN_ON},
+		{name: "success,TO", input: "TO", want: TOKEN_TO},
+		{name: "success,WHERE", input: "WHERE", want: TOKEN_WHERE},
+		{name: "success,WITHOUT", input: "WITHOUT", want: TOKEN_WITHOUT},
+		{name: "success,ROWID", input: "ROWID", want: TOKEN_ROWID},
+		{name: "success,STRICT", input: "STRICT", want: TOKEN_STRICT},
+		{name: "success,INTEGER", input: "INTEGER", want: TOKEN_INTEGER},
+		{name: "success,INT", input: "INT", want: TOKEN_INTEGER},
+		{name: "success,TEXT", input: "TEXT", want: TOKEN_TEXT},
+		{name: "success,BLOB", input: "BLOB", want: TOKEN_BLOB},
+		{name: "success,REAL", input: "REAL", want: TOKEN_REAL},
+		{name: "success,NUMERIC", input: "NUMERIC", want: TOKEN_NUMERIC},
+		{name: "success,DEFAULT", input: "DEFAULT", want: TOKEN_DEFAULT},
+		{name: "success,NOT", input: "NOT", want: TOKEN_NOT},
+		{name: "success,ASC", input: "ASC", want: TOKEN_ASC},
+		{name: "success,DESC", input: "DESC", want: TOKEN_DESC},
+		{name: "success,AUTOINCREMENT", input: "AUTOINCREMENT", want: TOKEN_AUTOINCREMENT},
+		{name: "success,COLLATE", input: "COLLATE", want: TOKEN_COLLATE},
+		{name: "success,CASCADE", input: "CASCADE", want: TOKEN_CASCADE},
+		{name: "success,RESTRICT", input: "RESTRICT", want: TOKEN_RESTRICT},
+		{name: "success,SET", input: "SET", want: TOKEN_SET},
+		{name: "success,NO", input: "NO", want: TOKEN_NO},
+		{name: "success,ACTION", input: "ACTION", want: TOKEN_ACTION},
+		{name: "success,CONSTRAINT", input: "CONSTRAINT", want: TOKEN_CONSTRAINT},
+		{name: "success,PRIMARY", input: "PRIMARY", want: TOKEN_PRIMARY},
+		{name: "success,KEY", input: "KEY", want: TOKEN_KEY},
+		{name: "success,FOREIGN", input: "FOREIGN", want: TOKEN_FOREIGN},
+		{name: "success,REFERENCES", input: "REFERENCES", want: TOKEN_REFERENCES},
+		{name: "success,UNIQUE", input: "UNIQUE", want: TOKEN_UNIQUE},
+		{name: "success,CHECK", input: "CHECK", want: TOKEN_CHECK},
+		{name: "success,NULL", input: "NULL", want: TOKEN_NULL},
+		{name: "success,TRUE", input: "TRUE", want: TOKEN_TRUE},
+		{name: "success,FALSE", input: "FALSE", want: TOKEN_FALSE},
+		{name: "success,IDENT", input: "users", want: TOKEN_IDENT},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := lookupIdent(tt.input)
+
+			if !require.Equal(t, tt.want, got) {
+				t.FailNow()
+			}
+		})
+	}
+}
+
+func TestLex(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  []Token
+	}{
+		{
+			name: "success,CREATE_TABLE",
+			input: `CREATE TABLE IF NOT EXISTS "users" (
+    "id"    INTEGER PRIMARY KEY AUTOINCREMENT,
+    "name"  VARCHAR(255) NOT NULL, -- comment
+    "email" TEXT COLLATE NOCASE,
+    UNIQUE ("email")
+) WITHOUT ROWID, STRICT;`,
+			want: []Token{
+				{Type: TOKEN_CREATE, Literal: Literal{Str: "CREATE"}},
+				{Type: TOKEN_TABLE, Literal: Literal{Str: "TABLE"}},
+				{Type: TOKEN_IF, Literal: Literal{Str: "IF"}},
+				{Type: TOKEN_NOT, Literal: Literal{Str: "NOT"}},
+				{Type: TOKEN_EXISTS, Literal: Literal{Str: "EXISTS"}},
+				{Type: TOKEN_IDENT, Literal: Literal{Str: `"users"`}},
+				{Type: TOKEN_OPEN_PAREN, Literal: Literal{Str: "("}},
+				{Type: TOKEN_IDENT, Literal: Literal{Str: `"id"`}},
+				{Type: TOKEN_INTEGER, Literal: Literal{Str: "INTEGER"}},
+				{Type: TOKEN_PRIMARY, Literal: Literal{Str: "PRIMARY"}},
+				{Type: TOKEN_KEY, Literal: Literal{Str: "KEY"}},
+				{Type: TOKEN_AUTOINCREMENT, Literal: Literal{Str: "AUTOINCREMENT"}},
+				{Type: TOKEN_COMMA, Literal: Literal{Str: ","}},
+				{Type: TOKEN_IDENT, Literal: Literal{Str: `"name"`}},
+				{Type: TOKEN_IDENT, Literal: Literal{Str: "VARCHAR"}},
+				{Type: TOKEN_OPEN_PAREN, Literal: Literal{Str: "("}},
+				{Type: TOKEN_IDENT, Literal: Literal{Str: "255"}},
+				{Type: TOKEN_CLOSE_PAREN, Literal: Literal{Str: ")"}},
+				{Type: TOKEN_NOT, Literal: Literal{Str: "NOT"}},
+				{Type: TOKEN_NULL, Literal: Literal{Str: "NULL"}},
+				{Type: TOKEN_COMMA, Literal: Literal{Str: ","}},
+				{Type: TOKEN_IDENT, Literal: Literal{Str: `"email"`}},
+				{Type: TOKEN_TEXT, Literal: Literal{Str: "TEXT"}},
+				{Type: TOKEN_COLLATE, Literal: Literal{Str: "COLLATE"}},
+				{Type: TOKEN_IDENT, Literal: Literal{Str: "NOCASE"}},
+				{Type: TOKEN_COMMA, Literal: Literal{Str: ","}},
+				{Type: TOKEN_UNIQUE, Literal: Literal{Str: "UNIQUE"}},
+				{Type: TOKEN_OPEN_PAREN, Literal: Literal{Str: "("}},
+				{Type: TOKEN_IDENT, Literal: Literal{Str: `"email"`}},
+				{Type: TOKEN_CLOSE_PAREN, Literal: Literal{Str: ")"}},
+				{Type: TOKEN_CLOSE_PAREN, Literal: Literal{Str: ")"}},
+				{Type: TOKEN_WITHOUT, Literal: Literal{Str: "WITHOUT"}},
+				{Type: TOKEN_ROWID, Literal: Literal{Str: "ROWID"}},
+				{Type: TOKEN_COMMA, Literal: Literal{Str: ","}},
+				{Type: TOKEN_STRICT, Literal: Literal{Str: "STRICT"}},
+				{Type: TOKEN_SEMICOLON, Literal: Literal{Str: ";"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := NewLexer(tt.input)
+			got := make([]Token, 0)
+			for {
+				tok := l.NextToken()
+				if tok.Type == TOKEN_EOF {
+					break
+				}
+				got = append(got, tok)
+			}
+
+			if !require.Equal(t, tt.want, got) {
+				t.FailNow()
+			}
+		})
+	}
+}
+
+func TestLexer_NextToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  Token
+	}{
+		{
+			name:  "success,||",
+			input: `||`,
+			want: Token{
+				Type:    TOKEN_STRING_CONCAT,
+				Literal: Literal{Str: "||"},
+			},
+		},
+		{
+			name:  "failure,|",
+			input: `|`,
+			want: Token{
+				Type:    TOKEN_ILLEGAL,
+				Literal: Literal{Str: "|"},
+			},
+		},
+		{
+			name:  "failure,!",
+			input: `!`,
+			want: Token{
+				Type:    TOKEN_ILLEGAL,
+				Literal: Literal{Str: "!"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			l := NewLexer(tt.input)
+			got := l.NextToken()
+
+			if !require.Equal(t, tt.want, got) {
+				t.FailNow()
+			}
+		})
+	}
+}
+
+func TestLiteral(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,String", func(t *testing.T) {
+		t.Parallel()
+
+		literal := Literal{Str: "users"}
+		expected := literal.Str
+		actual := literal.String()
+
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("success,StringForDiff", func(t *testing.T) {
+		t.Parallel()
+
+		literal := Literal{Str: "users"}
+		expected := literal.Str
+		actual := literal.StringForDiff()
+
+		require.Equal(t, expected, actual)
+	})
+}
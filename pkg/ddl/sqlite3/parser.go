@@ -0,0 +1,875 @@
+package sqlite3
+
+// MEMO: https://www.sqlite.org/lang_createtable.html
+// MEMO: https://www.sqlite.org/lang_createindex.html
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	filepathz "github.com/kunitsucom/util.go/path/filepath"
+	stringz "github.com/kunitsucom/util.go/strings"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	"github.com/kunitsucom/ddlctl/pkg/logs"
+)
+
+//nolint:gochecknoglobals
+var quotationMarks = []string{`"`, "`"}
+
+func NewRawIdent(raw string) *Ident {
+	for _, q := range quotationMarks {
+		if strings.HasPrefix(raw, q) && strings.HasSuffix(raw, q) {
+			return &Ident{
+				Name:          strings.Trim(raw, q),
+				QuotationMark: q,
+				Raw:           raw,
+			}
+		}
+	}
+
+	return &Ident{
+		Name:          raw,
+		QuotationMark: "",
+		Raw:           raw,
+	}
+}
+
+func NewIdent(name, quotationMark, raw string) *Ident {
+	return &Ident{
+		Name:          name,
+		QuotationMark: quotationMark,
+		Raw:           raw,
+	}
+}
+
+// Parser はSQL文を解析するパーサーです。
+type Parser struct {
+	l            *Lexer
+	currentToken Token
+	peekToken    Token
+}
+
+// NewParser は新しいParserを生成します。
+func NewParser(l *Lexer) *Parser {
+	p := &Parser{
+		l: l,
+	}
+
+	return p
+}
+
+// nextToken は次のトークンを読み込みます。
+func (p *Parser) nextToken() {
+	p.currentToken = p.peekToken
+	p.peekToken = p.l.NextToken()
+
+	_, file, line, _ := runtime.Caller(1)
+	logs.Trace.Printf("🪲: nextToken: caller=%s:%d currentToken: %#v, peekToken: %#v", filepathz.Short(file), line, p.currentToken, p.peekToken)
+}
+
+// Parse はSQL文を解析します。
+func (p *Parser) Parse() (*DDL, error) { //nolint:ireturn
+	p.nextToken() // current = ""
+	p.nextToken() // current = CREATE or ALTER or ...
+
+	d := &DDL{}
+
+LabelDDL:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_CREATE:
+			stmt, err := p.parseCreateStatement()
+			if err != nil {
+				return nil, apperr.Errorf("parseCreateStatement: %w", err)
+			}
+			d.Stmts = append(d.Stmts, stmt)
+		case TOKEN_CLOSE_PAREN:
+			// do nothing
+		case TOKEN_SEMICOLON:
+			// do nothing
+		case TOKEN_EOF:
+			break LabelDDL
+		default:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+
+		p.nextToken()
+	}
+	return d, nil
+}
+
+func (p *Parser) parseCreateStatement() (Stmt, error) { //nolint:ireturn
+	p.nextToken() // current = TABLE or INDEX or ...
+
+	switch p.currentToken.Type { //nolint:exhaustive
+	case TOKEN_TABLE:
+		stmt, err := p.parseCreateTableStmt()
+		if err != nil {
+			return nil, apperr.Errorf("parseCreateTableStmt: %w", err)
+		}
+		return stmt, nil
+	case TOKEN_INDEX, TOKEN_UNIQUE:
+		stmt, err := p.parseCreateIndexStmt()
+		if err != nil {
+			return nil, apperr.Errorf("parseCreateIndexStmt: %w", err)
+		}
+		return stmt, nil
+	default:
+		return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+	}
+}
+
+//nolint:cyclop,funlen
+func (p *Parser) parseCreateTableStmt() (*CreateTableStmt, error) {
+	createTableStmt := &CreateTableStmt{
+		Indent: Indent,
+	}
+
+	if p.isPeekToken(TOKEN_IF) {
+		p.nextToken() // current = IF
+		if err := p.checkPeekToken(TOKEN_NOT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = NOT
+		if err := p.checkPeekToken(TOKEN_EXISTS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = EXISTS
+		createTableStmt.IfNotExists = true
+	}
+
+	p.nextToken() // current = table_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	createTableStmt.Name = NewObjectName(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("table_name=%s: ", createTableStmt.Name.StringForDiff())
+
+	p.nextToken() // current = (
+
+	if err := p.checkCurrentToken(TOKEN_OPEN_PAREN); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+	}
+
+	p.nextToken() // current = column_name
+
+LabelColumns:
+	for {
+		switch { //nolint:exhaustive
+		case p.isCurrentToken(TOKEN_IDENT):
+			column, constraints, err := p.parseColumn(createTableStmt.Name.Name)
+			if err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"parseColumn: %w", err)
+			}
+			createTableStmt.Columns = append(createTableStmt.Columns, column)
+			if len(constraints) > 0 {
+				for _, c := range constraints {
+					createTableStmt.Constraints = createTableStmt.Constraints.Append(c)
+				}
+			}
+		case isConstraint(p.currentToken.Type):
+			constraint, err := p.parseTableConstraint(createTableStmt.Name.Name)
+			if err != nil {
+				return nil, apperr.Errorf(errFmtPrefix+"parseTableConstraint: %w", err)
+			}
+			createTableStmt.Constraints = createTableStmt.Constraints.Append(constraint)
+		case p.isCurrentToken(TOKEN_COMMA):
+			p.nextToken()
+			continue
+		case p.isCurrentToken(TOKEN_CLOSE_PAREN):
+			switch p.peekToken.Type { //nolint:exhaustive
+			case TOKEN_SEMICOLON, TOKEN_EOF:
+				break LabelColumns
+			case TOKEN_WITHOUT, TOKEN_STRICT:
+				p.nextToken() // current = WITHOUT or STRICT
+				options, err := p.parseTableOptions()
+				if err != nil {
+					return nil, apperr.Errorf(errFmtPrefix+"parseTableOptions: %w", err)
+				}
+				createTableStmt.Options = options
+				break LabelColumns
+			default:
+				return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedPeekToken)
+			}
+		default:
+			return nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+	}
+
+	return createTableStmt, nil
+}
+
+func (p *Parser) parseTableOptions() ([]*Option, error) {
+	options := make([]*Option, 0)
+
+LabelOptions:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_WITHOUT:
+			if err := p.checkPeekToken(TOKEN_ROWID); err != nil {
+				return nil, apperr.Errorf("checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = ROWID
+			options = append(options, &Option{Name: "WITHOUT ROWID"})
+		case TOKEN_STRICT:
+			options = append(options, &Option{Name: "STRICT"})
+		case TOKEN_COMMA:
+			// do nothing
+		case TOKEN_SEMICOLON, TOKEN_EOF:
+			break LabelOptions
+		default:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+
+		if p.isPeekToken(TOKEN_SEMICOLON, TOKEN_EOF) {
+			break LabelOptions
+		}
+		p.nextToken()
+	}
+
+	return options, nil
+}
+
+//nolint:cyclop,funlen
+func (p *Parser) parseCreateIndexStmt() (*CreateIndexStmt, error) {
+	createIndexStmt := &CreateIndexStmt{}
+
+	if p.isCurrentToken(TOKEN_UNIQUE) {
+		createIndexStmt.Unique = true
+		p.nextToken() // current = INDEX
+	}
+
+	if p.isPeekToken(TOKEN_IF) {
+		p.nextToken() // current = IF
+		if err := p.checkPeekToken(TOKEN_NOT); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = NOT
+		if err := p.checkPeekToken(TOKEN_EXISTS); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = EXISTS
+		createIndexStmt.IfNotExists = true
+	}
+
+	p.nextToken() // current = index_name
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	createIndexStmt.Name = NewRawIdent(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("index_name=%s: ", createIndexStmt.Name.StringForDiff())
+
+	p.nextToken() // current = ON
+
+	if err := p.checkCurrentToken(TOKEN_ON); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+	}
+
+	p.nextToken() // current = table_name
+
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+	}
+
+	createIndexStmt.TableName = NewObjectName(p.currentToken.Literal.Str)
+
+	p.nextToken() // current = (
+
+	if err := p.checkCurrentToken(TOKEN_OPEN_PAREN); err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"checkCurrentToken: %w", err)
+	}
+
+	idents, err := p.parseColumnIdents()
+	if err != nil {
+		return nil, apperr.Errorf(errFmtPrefix+"parseColumnIdents: %w", err)
+	}
+
+	createIndexStmt.Columns = idents
+
+	if p.isCurrentToken(TOKEN_WHERE) {
+		p.nextToken() // current = expr
+		where, err := p.parseWhereExpr()
+		if err != nil {
+			return nil, apperr.Errorf(errFmtPrefix+"parseWhereExpr: %w", err)
+		}
+		createIndexStmt.Where = where
+	}
+
+	return createIndexStmt, nil
+}
+
+func (p *Parser) parseWhereExpr() (*Expr, error) {
+	expr := &Expr{}
+
+LabelWhere:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_OPEN_PAREN:
+			ids, err := p.parseExpr()
+			if err != nil {
+				return nil, apperr.Errorf("parseExpr: %w", err)
+			}
+			expr = expr.Append(ids...)
+			continue
+		case TOKEN_SEMICOLON, TOKEN_EOF:
+			break LabelWhere
+		case TOKEN_ILLEGAL:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		default:
+			expr = expr.Append(NewRawIdent(p.currentToken.Literal.Str))
+		}
+
+		p.nextToken()
+	}
+
+	return expr, nil
+}
+
+//nolint:funlen,cyclop,gocognit
+func (p *Parser) parseColumn(tableName *Ident) (*Column, []Constraint, error) {
+	column := &Column{}
+	constraints := make(Constraints, 0)
+
+	if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+		return nil, nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+
+	column.Name = NewRawIdent(p.currentToken.Literal.Str)
+	errFmtPrefix := fmt.Sprintf("column_name=%s: ", column.Name.StringForDiff())
+
+	p.nextToken() // current = DATA_TYPE
+
+	// MEMO: In SQLite, the data type of a column is optional.
+	if isDataType(p.currentToken.Type) {
+		dataType, err := p.parseDataType()
+		if err != nil {
+			return nil, nil, apperr.Errorf(errFmtPrefix+"parseDataType: %w", err)
+		}
+		column.DataType = dataType
+	}
+
+LabelColumnConstraints:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_CONSTRAINT:
+			// MEMO: The name of a column constraint is ignored, because the constraints are normalized to table constraints.
+			if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+				return nil, nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = constraint_name
+		case TOKEN_NOT:
+			if err := p.checkPeekToken(TOKEN_NULL); err != nil {
+				return nil, nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = NULL
+			column.NotNull = true
+		case TOKEN_NULL:
+			column.NotNull = false
+		case TOKEN_DEFAULT:
+			p.nextToken() // current = DEFAULT
+			def, err := p.parseColumnDefault()
+			if err != nil {
+				return nil, nil, apperr.Errorf(errFmtPrefix+"parseColumnDefault: %w", err)
+			}
+			column.Default = def
+			continue
+		case TOKEN_COLLATE:
+			if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+				return nil, nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = collation_name
+			column.Collate = NewRawIdent(p.currentToken.Literal.Str)
+		case TOKEN_PRIMARY:
+			if err := p.checkPeekToken(TOKEN_KEY); err != nil {
+				return nil, nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = KEY
+			columnIdent := &ColumnIdent{Ident: column.Name}
+			if p.isPeekToken(TOKEN_ASC, TOKEN_DESC) {
+				p.nextToken() // current = ASC or DESC
+				columnIdent.Order = &Order{Desc: p.isCurrentToken(TOKEN_DESC)}
+			}
+			if p.isPeekToken(TOKEN_AUTOINCREMENT) {
+				p.nextToken() // current = AUTOINCREMENT
+				// MEMO: AUTOINCREMENT is only allowed in a column constraint, so PRIMARY KEY is kept in the column definition.
+				column.Autoincrement = true
+				break
+			}
+			constraints = constraints.Append(&PrimaryKeyConstraint{
+				Name:    NewRawIdent(tableName.StringForDiff() + "_pkey"),
+				Columns: []*ColumnIdent{columnIdent},
+			})
+		case TOKEN_UNIQUE:
+			constraints = constraints.Append(&UniqueConstraint{
+				Name:    NewRawIdent(fmt.Sprintf("%s_unique_%s", tableName.StringForDiff(), column.Name.StringForDiff())),
+				Columns: []*ColumnIdent{{Ident: column.Name}},
+			})
+		case TOKEN_CHECK:
+			if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil {
+				return nil, nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = (
+			constraint := &CheckConstraint{
+				Name: NewRawIdent(fmt.Sprintf("%s_%s_check", tableName.StringForDiff(), column.Name.StringForDiff())),
+			}
+			idents, err := p.parseExpr()
+			if err != nil {
+				return nil, nil, apperr.Errorf(errFmtPrefix+"parseExpr: %w", err)
+			}
+			constraint.Expr = constraint.Expr.Append(idents...)
+			constraints = constraints.Append(constraint)
+			continue
+		case TOKEN_REFERENCES:
+			if err := p.checkPeekToken(TOKEN_IDENT); err != nil {
+				return nil, nil, apperr.Errorf(errFmtPrefix+"checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = table_name
+			constraint := &ForeignKeyConstraint{
+				Name:    NewRawIdent(fmt.Sprintf("%s_%s_fkey", tableName.StringForDiff(), column.Name.StringForDiff())),
+				Ref:     NewRawIdent(p.currentToken.Literal.Str),
+				Columns: []*ColumnIdent{{Ident: column.Name}},
+			}
+			p.nextToken() // current = (
+			idents, err := p.parseColumnIdents()
+			if err != nil {
+				return nil, nil, apperr.Errorf(errFmtPrefix+"parseColumnIdents: %w", err)
+			}
+			constraint.RefColumns = idents
+			onAction, err := p.parseOnAction()
+			if err != nil {
+				return nil, nil, apperr.Errorf(errFmtPrefix+"parseOnAction: %w", err)
+			}
+			constraint.OnAction = onAction
+			constraints = constraints.Append(constraint)
+			continue
+		case TOKEN_COMMA, TOKEN_CLOSE_PAREN:
+			break LabelColumnConstraints
+		default:
+			return nil, nil, apperr.Errorf(errFmtPrefix+"currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+
+		p.nextToken()
+	}
+
+	return column, constraints, nil
+}
+
+//nolint:cyclop
+func (p *Parser) parseColumnDefault() (*Default, error) {
+	def := &Default{}
+
+LabelDefault:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_IDENT:
+			def.Value = def.Value.Append(NewRawIdent(p.currentToken.Literal.String()))
+		case TOKEN_OPEN_PAREN:
+			ids, err := p.parseExpr()
+			if err != nil {
+				return nil, apperr.Errorf("parseExpr: %w", err)
+			}
+			def.Value = def.Value.Append(ids...)
+			continue
+		case TOKEN_NOT, TOKEN_COMMA, TOKEN_CLOSE_PAREN, TOKEN_COLLATE:
+			break LabelDefault
+		default:
+			if isReservedValue(p.currentToken.Type) {
+				def.Value = def.Value.Append(NewIdent(string(p.currentToken.Type), "", p.currentToken.Literal.String()))
+				p.nextToken()
+				continue
+			}
+			if isOperator(p.currentToken.Type) {
+				def.Value = def.Value.Append(NewRawIdent(p.currentToken.Literal.Str))
+				p.nextToken()
+				continue
+			}
+			if isConstraint(p.currentToken.Type) {
+				break LabelDefault
+			}
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+
+		p.nextToken()
+	}
+
+	return def, nil
+}
+
+//nolint:cyclop
+func (p *Parser) parseExpr() ([]*Ident, error) {
+	idents := make([]*Ident, 0)
+
+	if err := p.checkCurrentToken(TOKEN_OPEN_PAREN); err != nil {
+		return nil, apperr.Errorf("checkCurrentToken: %w", err)
+	}
+	idents = append(idents, NewRawIdent(p.currentToken.Literal.Str))
+	p.nextToken() // current = IDENT
+
+LabelExpr:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_OPEN_PAREN:
+			ids, err := p.parseExpr()
+			if err != nil {
+				return nil, apperr.Errorf("parseExpr: %w", err)
+			}
+			idents = append(idents, ids...)
+			continue
+		case TOKEN_CLOSE_PAREN:
+			idents = append(idents, NewRawIdent(p.currentToken.Literal.Str))
+			p.nextToken()
+			break LabelExpr
+		case TOKEN_EQUAL, TOKEN_GREATER, TOKEN_LESS:
+			value := p.currentToken.Literal.Str
+			switch p.peekToken.Type { //nolint:exhaustive
+			case TOKEN_EQUAL, TOKEN_GREATER, TOKEN_LESS:
+				value += p.peekToken.Literal.Str
+				p.nextToken()
+			}
+			idents = append(idents, NewRawIdent(value))
+		case TOKEN_EOF:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		default:
+			if isReservedValue(p.currentToken.Type) {
+				idents = append(idents, NewRawIdent(p.currentToken.Type.String()))
+			} else {
+				idents = append(idents, NewRawIdent(p.currentToken.Literal.Str))
+			}
+		}
+
+		p.nextToken()
+	}
+
+	return idents, nil
+}
+
+// parseOnAction parses ON DELETE and ON UPDATE clauses of a foreign key.
+//
+//nolint:cyclop
+func (p *Parser) parseOnAction() (string, error) {
+	var onAction string
+
+	for p.isCurrentToken(TOKEN_ON) {
+		if onAction != "" {
+			onAction += " "
+		}
+		onAction += p.currentToken.Literal.String() // current = ON
+		p.nextToken()                               // current = DELETE or UPDATE
+		if err := p.checkCurrentToken(TOKEN_DELETE, TOKEN_UPDATE); err != nil {
+			return "", apperr.Errorf("checkCurrentToken: %w", err)
+		}
+		onAction += " " + p.currentToken.Literal.String()
+		p.nextToken() // current = CASCADE or RESTRICT or SET or NO
+
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_CASCADE, TOKEN_RESTRICT:
+			onAction += " " + p.currentToken.Literal.String()
+		case TOKEN_SET:
+			onAction += " " + p.currentToken.Literal.String()
+			if err := p.checkPeekToken(TOKEN_NULL, TOKEN_DEFAULT); err != nil {
+				return "", apperr.Errorf("checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = NULL or DEFAULT
+			onAction += " " + p.currentToken.Literal.String()
+		case TOKEN_NO:
+			onAction += " " + p.currentToken.Literal.String()
+			if err := p.checkPeekToken(TOKEN_ACTION); err != nil {
+				return "", apperr.Errorf("checkPeekToken: %w", err)
+			}
+			p.nextToken() // current = ACTION
+			onAction += " " + p.currentToken.Literal.String()
+		default:
+			return "", apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+		p.nextToken()
+	}
+
+	return onAction, nil
+}
+
+//nolint:funlen,cyclop
+func (p *Parser) parseTableConstraint(tableName *Ident) (Constraint, error) { //nolint:ireturn
+	var constraintName *Ident
+	if p.isCurrentToken(TOKEN_CONSTRAINT) {
+		p.nextToken() // current = constraint_name
+		if p.currentToken.Type != TOKEN_IDENT {
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+		constraintName = NewRawIdent(p.currentToken.Literal.Str)
+		p.nextToken() // current = PRIMARY or CHECK or UNIQUE or FOREIGN
+	}
+
+	switch p.currentToken.Type { //nolint:exhaustive
+	case TOKEN_PRIMARY:
+		if err := p.checkPeekToken(TOKEN_KEY); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = KEY
+		if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = (
+		idents, err := p.parseColumnIdents()
+		if err != nil {
+			return nil, apperr.Errorf("parseColumnIdents: %w", err)
+		}
+		if constraintName == nil {
+			constraintName = NewRawIdent(tableName.StringForDiff() + "_pkey")
+		}
+		return &PrimaryKeyConstraint{
+			Name:    constraintName,
+			Columns: idents,
+		}, nil
+	case TOKEN_FOREIGN:
+		if err := p.checkPeekToken(TOKEN_KEY); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = KEY
+		if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = (
+		idents, err := p.parseColumnIdents()
+		if err != nil {
+			return nil, apperr.Errorf("parseColumnIdents: %w", err)
+		}
+		if err := p.checkCurrentToken(TOKEN_REFERENCES); err != nil {
+			return nil, apperr.Errorf("checkCurrentToken: %w", err)
+		}
+		p.nextToken() // current = ref_table_name
+		if err := p.checkCurrentToken(TOKEN_IDENT); err != nil {
+			return nil, apperr.Errorf("checkCurrentToken: %w", err)
+		}
+		refName := NewRawIdent(p.currentToken.Literal.Str)
+
+		p.nextToken() // current = (
+		identsRef, err := p.parseColumnIdents()
+		if err != nil {
+			return nil, apperr.Errorf("parseColumnIdents: %w", err)
+		}
+		onAction, err := p.parseOnAction()
+		if err != nil {
+			return nil, apperr.Errorf("parseOnAction: %w", err)
+		}
+		if constraintName == nil {
+			name := tableName.StringForDiff()
+			for _, ident := range idents {
+				name += "_" + ident.Ident.StringForDiff()
+			}
+			name += "_fkey"
+			constraintName = NewRawIdent(name)
+		}
+		return &ForeignKeyConstraint{
+			Name:       constraintName,
+			Columns:    idents,
+			Ref:        refName,
+			RefColumns: identsRef,
+			OnAction:   onAction,
+		}, nil
+	case TOKEN_UNIQUE:
+		c := &UniqueConstraint{}
+		if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = (
+		idents, err := p.parseColumnIdents()
+		if err != nil {
+			return nil, apperr.Errorf("parseColumnIdents: %w", err)
+		}
+		if constraintName == nil {
+			name := tableName.StringForDiff() + "_unique"
+			for _, ident := range idents {
+				name += "_" + ident.Ident.StringForDiff()
+			}
+			constraintName = NewRawIdent(name)
+		}
+		c.Name = constraintName
+		c.Columns = idents
+		return c, nil
+	case TOKEN_CHECK:
+		if err := p.checkPeekToken(TOKEN_OPEN_PAREN); err != nil {
+			return nil, apperr.Errorf("checkPeekToken: %w", err)
+		}
+		p.nextToken() // current = (
+		idents, err := p.parseExpr()
+		if err != nil {
+			return nil, apperr.Errorf("parseExpr: %w", err)
+		}
+		if constraintName == nil {
+			constraintName = NewRawIdent(tableName.StringForDiff() + "_check")
+		}
+		return &CheckConstraint{
+			Name: constraintName,
+			Expr: (*Expr)(nil).Append(idents...),
+		}, nil
+	default:
+		return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+	}
+}
+
+// parseDataType parses a type name such as "INTEGER", "VARCHAR(255)" or "UNSIGNED BIG INT".
+func (p *Parser) parseDataType() (*DataType, error) {
+	dataType := &DataType{Type: p.currentToken.Type}
+
+	names := make([]string, 0)
+	for isDataType(p.currentToken.Type) {
+		names = append(names, p.currentToken.Literal.String())
+		p.nextToken()
+	}
+	dataType.Name = strings.Join(names, " ")
+
+	if p.isCurrentToken(TOKEN_OPEN_PAREN) {
+		idents, err := p.parseIdents()
+		if err != nil {
+			return nil, apperr.Errorf("parseIdents: %w", err)
+		}
+		dataType.Expr = dataType.Expr.Append(idents...)
+		p.nextToken() // current = next of )
+	}
+
+	return dataType, nil
+}
+
+func (p *Parser) parseColumnIdents() ([]*ColumnIdent, error) {
+	idents := make([]*ColumnIdent, 0)
+
+LabelIdents:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_OPEN_PAREN:
+			// do nothing
+		case TOKEN_IDENT:
+			ident := &ColumnIdent{Ident: NewRawIdent(p.currentToken.Literal.Str)}
+			switch p.peekToken.Type { //nolint:exhaustive
+			case TOKEN_ASC:
+				ident.Order = &Order{Desc: false}
+				p.nextToken() // current = ASC
+			case TOKEN_DESC:
+				ident.Order = &Order{Desc: true}
+				p.nextToken() // current = DESC
+			}
+			idents = append(idents, ident)
+		case TOKEN_COMMA:
+			// do nothing
+		case TOKEN_CLOSE_PAREN:
+			p.nextToken()
+			break LabelIdents
+		default:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		}
+		p.nextToken()
+	}
+
+	return idents, nil
+}
+
+func (p *Parser) parseIdents() ([]*Ident, error) {
+	idents := make([]*Ident, 0)
+
+LabelIdents:
+	for {
+		switch p.currentToken.Type { //nolint:exhaustive
+		case TOKEN_OPEN_PAREN:
+			// do nothing
+		case TOKEN_IDENT:
+			idents = append(idents, NewRawIdent(p.currentToken.Literal.Str))
+		case TOKEN_CLOSE_PAREN:
+			break LabelIdents
+		case TOKEN_EOF, TOKEN_ILLEGAL:
+			return nil, apperr.Errorf("currentToken=%#v, peekToken=%#v: %w", p.currentToken, p.peekToken, ddl.ErrUnexpectedCurrentToken)
+		default:
+			idents = append(idents, NewRawIdent(p.currentToken.Literal.Str))
+		}
+		p.nextToken()
+	}
+
+	return idents, nil
+}
+
+func isOperator(tokenType TokenType) bool {
+	switch tokenType { //nolint:exhaustive
+	case TOKEN_EQUAL, TOKEN_GREATER, TOKEN_LESS,
+		TOKEN_PLUS, TOKEN_MINUS, TOKEN_ASTERISK, TOKEN_SLASH,
+		TOKEN_STRING_CONCAT:
+		return true
+	default:
+		return false
+	}
+}
+
+func isReservedValue(tokenType TokenType) bool {
+	switch tokenType { //nolint:exhaustive
+	case TOKEN_NULL, TOKEN_TRUE, TOKEN_FALSE:
+		return true
+	default:
+		return false
+	}
+}
+
+// isDataType reports whether the token can be a part of a type name.
+//
+// MEMO: SQLite allows any identifier as a type name. https://www.sqlite.org/datatype3.html
+func isDataType(tokenType TokenType) bool {
+	switch tokenType { //nolint:exhaustive
+	case TOKEN_INTEGER, TOKEN_TEXT, TOKEN_BLOB, TOKEN_REAL, TOKEN_NUMERIC,
+		TOKEN_IDENT:
+		return true
+	default:
+		return false
+	}
+}
+
+func isConstraint(tokenType TokenType) bool {
+	switch tokenType { //nolint:exhaustive
+	case TOKEN_CONSTRAINT,
+		TOKEN_PRIMARY, TOKEN_KEY,
+		TOKEN_FOREIGN, TOKEN_REFERENCES,
+		TOKEN_UNIQUE,
+		TOKEN_CHECK:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *Parser) isCurrentToken(expectedTypes ...TokenType) bool {
+	for _, expected := range expectedTypes {
+		if expected == p.currentToken.Type {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Parser) checkCurrentToken(expectedTypes ...TokenType) error {
+	for _, expected := range expectedTypes {
+		if expected == p.currentToken.Type {
+			return nil
+		}
+	}
+	return apperr.Errorf("currentToken=%#v, peekToken=%#v: expected=%v, but got=%v: %w", p.currentToken, p.peekToken, stringz.JoinStringers(",", expectedTypes...), p.currentToken.Type, ddl.ErrUnexpectedCurrentToken)
+}
+
+func (p *Parser) isPeekToken(expectedTypes ...TokenType) bool {
+	for _, expected := range expectedTypes {
+		if expected == p.peekToken.Type {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Parser) checkPeekToken(expectedTypes ...TokenType) error {
+	for _, expected := range expectedTypes {
+		if expected == p.peekToken.Type {
+			return nil
+		}
+	}
+	return apperr.Errorf("currentToken=%#v, peekToken=%#v: expected=%v, but got=%v: %w", p.currentToken, p.peekToken, stringz.JoinStringers(",", expectedTypes...), p.peekToken.Type, ddl.ErrUnexpectedPeekToken)
+}
@@ -0,0 +1,308 @@
+//nolint:testpackage
+package sqlite3
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	"github.com/kunitsucom/ddlctl/pkg/logs"
+)
+
+//nolint:paralleltest,tparallel
+func TestParser_Parse(t *testing.T) {
+	backup := logs.Trace
+	t.Cleanup(func() {
+		logs.Trace = backup
+	})
+	logs.Trace = logs.NewTrace()
+
+	t.Run("success,CREATE_TABLE", func(t *testing.T) {
+		t.Parallel()
+
+		input := `CREATE TABLE "groups" ("id" INTEGER PRIMARY KEY AUTOINCREMENT, description TEXT); CREATE TABLE IF NOT EXISTS users (id TEXT NOT NULL PRIMARY KEY, group_id INTEGER NOT NULL REFERENCES "groups" ("id") ON DELETE CASCADE, "name" VARCHAR(255) NOT NULL UNIQUE, "email" TEXT COLLATE NOCASE, "age" INT DEFAULT 0 CHECK ("age" >= 0), created_at TEXT NOT NULL DEFAULT CURRENT_TIMESTAMP, data) WITHOUT ROWID, STRICT;`
+		expected := `CREATE TABLE "groups" (
+    "id" INTEGER PRIMARY KEY AUTOINCREMENT,
+    description TEXT
+);
+CREATE TABLE IF NOT EXISTS users (
+    id TEXT NOT NULL,
+    group_id INTEGER NOT NULL,
+    "name" VARCHAR(255) NOT NULL,
+    "email" TEXT COLLATE NOCASE,
+    "age" INT DEFAULT 0,
+    created_at TEXT DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    data,
+    CONSTRAINT users_pkey PRIMARY KEY (id),
+    CONSTRAINT users_group_id_fkey FOREIGN KEY (group_id) REFERENCES "groups" ("id") ON DELETE CASCADE,
+    CONSTRAINT users_unique_name UNIQUE ("name"),
+    CONSTRAINT users_age_check CHECK ("age" >= 0)
+) WITHOUT ROWID, STRICT;
+`
+
+		l := NewLexer(input)
+		p := NewParser(l)
+		actual, err := p.Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+
+		t.Logf("ℹ️: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		t.Logf("ℹ️: %s: stmt: %%s: \n%s", t.Name(), actual)
+	})
+
+	t.Run("success,CREATE_TABLE_table_constraints", func(t *testing.T) {
+		t.Parallel()
+
+		input := `CREATE TABLE main.user_groups (
+    user_id TEXT NOT NULL,
+    group_id INTEGER NOT NULL,
+    rank UNSIGNED BIG INT DEFAULT (1 + 1),
+    CONSTRAINT pk PRIMARY KEY (user_id, group_id DESC),
+    FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE SET NULL ON DELETE NO ACTION,
+    UNIQUE (group_id, rank),
+    CHECK (rank > 0)
+);
+`
+		expected := `CREATE TABLE main.user_groups (
+    user_id TEXT NOT NULL,
+    group_id INTEGER NOT NULL,
+    rank UNSIGNED BIG INT DEFAULT (1 + 1),
+    CONSTRAINT pk PRIMARY KEY (user_id, group_id DESC),
+    CONSTRAINT user_groups_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON UPDATE SET NULL ON DELETE NO ACTION,
+    CONSTRAINT user_groups_unique_group_id_rank UNIQUE (group_id, rank),
+    CONSTRAINT user_groups_check CHECK (rank > 0)
+);
+`
+
+		l := NewLexer(input)
+		p := NewParser(l)
+		actual, err := p.Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+
+		t.Logf("ℹ️: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		t.Logf("ℹ️: %s: stmt: %%s: \n%s", t.Name(), actual)
+	})
+
+	t.Run("success,CREATE_INDEX", func(t *testing.T) {
+		t.Parallel()
+
+		input := `CREATE INDEX users_idx_name ON users (name); CREATE UNIQUE INDEX IF NOT EXISTS "users_idx_email" ON "users" ("email" ASC, "age" DESC) WHERE "email" IS NOT NULL;`
+		expected := `CREATE INDEX users_idx_name ON users (name);
+CREATE UNIQUE INDEX IF NOT EXISTS "users_idx_email" ON "users" ("email", "age" DESC) WHERE "email" IS NOT NULL;
+`
+
+		l := NewLexer(input)
+		p := NewParser(l)
+		actual, err := p.Parse()
+		require.NoError(t, err)
+
+		if !assert.Equal(t, expected, actual.String()) {
+			t.Errorf("❌: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		}
+
+		t.Logf("ℹ️: %s: stmt: %%#v: \n%#v", t.Name(), actual)
+		t.Logf("ℹ️: %s: stmt: %%s: \n%s", t.Name(), actual)
+	})
+
+	failureTests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{
+			name:    "failure,invalid",
+			input:   `)invalid`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_INVALID",
+			input:   `CREATE INVALID;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_INVALID",
+			input:   `CREATE TABLE;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_IF_INVALID",
+			input:   `CREATE TABLE IF;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_IF_NOT_INVALID",
+			input:   `CREATE TABLE IF NOT;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_INVALID",
+			input:   `CREATE TABLE "users";`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_column_name_INVALID",
+			input:   `CREATE TABLE "users" ("id";`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_column_name_data_type_INVALID",
+			input:   `CREATE TABLE "users" ("id" TEXT)(;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_column_name_DATA_TYPE_INVALID",
+			input:   `CREATE TABLE "users" ("id" VARCHAR(;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_column_name_INVALID_NOT",
+			input:   `CREATE TABLE "users" ("id" TEXT NOT DEFAULT;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_column_name_INVALID_DEFAULT",
+			input:   `CREATE TABLE "users" ("id" TEXT DEFAULT ("id"`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_column_name_INVALID_COLLATE",
+			input:   `CREATE TABLE "users" ("id" TEXT COLLATE NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_column_name_INVALID_PRIMARY_KEY",
+			input:   `CREATE TABLE "users" ("id" TEXT PRIMARY NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_column_name_INVALID_REFERENCES",
+			input:   `CREATE TABLE "users" ("id" TEXT REFERENCES NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_column_name_REFERENCES_ON_INVALID",
+			input:   `CREATE TABLE "users" ("id" TEXT REFERENCES "groups" ("id") ON NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_column_name_REFERENCES_ON_DELETE_SET_INVALID",
+			input:   `CREATE TABLE "users" ("id" TEXT REFERENCES "groups" ("id") ON DELETE SET NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_column_name_INVALID_CHECK",
+			input:   `CREATE TABLE "users" ("id" TEXT CHECK NOT`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_CONSTRAINT_INVALID_IDENT",
+			input:   `CREATE TABLE "users" ("id" TEXT, CONSTRAINT NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_CONSTRAINT_FOREIGN_KEY_IDENTS_INVALID",
+			input:   `CREATE TABLE "users" ("id" TEXT, FOREIGN KEY ("id") NOT`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_TABLE_table_name_INVALID_OPTION",
+			input:   `CREATE TABLE "users" ("id" TEXT) WITHOUT NOT;`,
+			wantErr: ddl.ErrUnexpectedPeekToken,
+		},
+		{
+			name:    "failure,CREATE_INDEX_index_name_INVALID",
+			input:   `CREATE INDEX NOT;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_INDEX_index_name_ON_INVALID",
+			input:   `CREATE INDEX "users_idx" NOT;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_INDEX_index_name_ON_table_name_INVALID",
+			input:   `CREATE INDEX "users_idx" ON "users" NOT;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+		{
+			name:    "failure,CREATE_INDEX_index_name_ON_table_name_WHERE_INVALID",
+			input:   `CREATE INDEX "users_idx" ON "users" ("id") WHERE |;`,
+			wantErr: ddl.ErrUnexpectedCurrentToken,
+		},
+	}
+
+	for _, tt := range failureTests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewParser(NewLexer(tt.input)).Parse()
+			require.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestParser_parseColumn(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,without_data_type", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewParser(NewLexer("( id,"))
+		p.nextToken()
+		p.nextToken()
+		p.nextToken()
+		column, _, err := p.parseColumn(&Ident{Name: "table_name", QuotationMark: `"`, Raw: `"table_name"`})
+		require.NoError(t, err)
+		assert.Equal(t, "id", column.String())
+	})
+
+	t.Run("failure,invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := NewParser(NewLexer(`NOT`)).parseColumn(&Ident{Name: "table_name", QuotationMark: `"`, Raw: `"table_name"`})
+		require.ErrorIs(t, err, ddl.ErrUnexpectedCurrentToken)
+	})
+}
+
+func TestParser_parseExpr(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,isReservedValue", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewParser(NewLexer(`(null)`))
+		p.nextToken()
+		p.nextToken()
+		_, err := p.parseExpr()
+		require.NoError(t, err)
+	})
+
+	t.Run("failure,invalid", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewParser(NewLexer(`NOT`))
+		p.nextToken()
+		p.nextToken()
+		_, err := p.parseExpr()
+		require.ErrorIs(t, err, ddl.ErrUnexpectedCurrentToken)
+	})
+
+	t.Run("failure,invalid2", func(t *testing.T) {
+		t.Parallel()
+
+		p := NewParser(NewLexer(`((NOT`))
+		p.nextToken()
+		p.nextToken()
+		_, err := p.parseExpr()
+		require.ErrorIs(t, err, ddl.ErrUnexpectedCurrentToken)
+	})
+}
@@ -0,0 +1,163 @@
+package ddl
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// Statement describes a DDL statement for the machine-readable output such as `--output json`.
+type Statement struct {
+	// Kind is the kind of the statement in snake case, such as "create_table", or the kind of the action for ALTER TABLE, such as "add_column".
+	Kind string `json:"kind"`
+	// Object is the name of the object that the statement changes, such as the table name.
+	Object string `json:"object"`
+	// Class is the class of the impact of the statement. It is empty for the statements that are not changes.
+	Class ChangeClass `json:"class,omitempty"`
+	// Before and After are the fragments of the object before and after the change, which are described by the comment of the statement.
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+	// Irreversible reports whether the statement cannot restore what the reversed statement loses, such as the data of a dropped column.
+	Irreversible bool `json:"irreversible,omitempty"`
+	// SQL is the statement without comments.
+	SQL string `json:"sql"`
+
+	text string
+}
+
+// IrreversibleHint is the comment that annotates the following statement as irreversible, such as:
+//
+//	-- ddlctl:irreversible
+//	ALTER TABLE "users" ADD COLUMN "name" TEXT NOT NULL;
+const IrreversibleHint = "ddlctl:irreversible"
+
+// String returns the statement annotated with its class, as the text output.
+func (s *Statement) String() string {
+	var str string
+	if s.Class != "" {
+		str += s.Class.Comment()
+	}
+	if s.Irreversible {
+		str += "-- " + IrreversibleHint + "\n"
+	}
+	return str + s.text
+}
+
+// MarkIrreversible marks the down statements that reverse the data-loss statements of up as irreversible,
+// since the lost data cannot be restored by them.
+// A down statement reverses an up statement if they change the same object and their before and after fragments are swapped.
+func MarkIrreversible(up, down []*Statement) {
+	// MEMO: The statements of up change the table after it is renamed, and the ones of down change it after it is renamed back.
+	renamed := make(map[string]string)
+	for _, d := range down {
+		if d.Kind == "rename_table" {
+			renamed[d.Before] = d.After
+		}
+	}
+
+	for _, u := range up {
+		if u.Class != ChangeClassDataLoss {
+			continue
+		}
+		object := u.Object
+		if name, ok := renamed[object]; ok {
+			object = name
+		}
+		for _, d := range down {
+			if d.Object == object && d.Before == u.After && d.After == u.Before {
+				d.Irreversible = true
+			}
+		}
+	}
+}
+
+// NewStatements describes stmts of any dialect. If classify is nil, the classes are not described.
+func NewStatements[T interface {
+	String() string
+	GetNameForDiff() string
+}](stmts []T, classify func(stmt T) ChangeClass,
+) []*Statement {
+	statements := make([]*Statement, 0, len(stmts))
+	for _, stmt := range stmts {
+		text := stmt.String()
+		s := &Statement{
+			Kind:   stmtKind(stmt),
+			Object: stmt.GetNameForDiff(),
+			SQL:    strings.TrimSpace(trimLeadingComments(text)),
+			text:   text,
+		}
+		if classify != nil {
+			s.Class = classify(stmt)
+		}
+		s.Before, s.After = stmtBeforeAndAfter(stmt)
+		statements = append(statements, s)
+	}
+	return statements
+}
+
+// stmtKind returns the kind of stmt from the name of its type, such as "create_table" for CreateTableStmt,
+// or from the name of the type of its action, such as "add_column" for AlterTableStmt with AddColumn.
+func stmtKind(stmt any) string {
+	v := reflect.Indirect(reflect.ValueOf(stmt))
+	name := strings.TrimSuffix(v.Type().Name(), "Stmt")
+	if v.Kind() == reflect.Struct {
+		if action := v.FieldByName("Action"); action.IsValid() && !action.IsZero() {
+			if action.Kind() == reflect.Interface {
+				action = action.Elem()
+			}
+			name = reflect.Indirect(action).Type().Name()
+		}
+	}
+	return toSnakeCase(name)
+}
+
+// stmtBeforeAndAfter returns the fragments of the object before and after the change,
+// which are described by the comment of stmt in the form of "-before\n+after".
+func stmtBeforeAndAfter(stmt any) (before, after string) {
+	v := reflect.Indirect(reflect.ValueOf(stmt))
+	if v.Kind() != reflect.Struct {
+		return "", ""
+	}
+	comment := v.FieldByName("Comment")
+	if !comment.IsValid() || comment.Kind() != reflect.String {
+		return "", ""
+	}
+
+	befores, afters := make([]string, 0), make([]string, 0)
+	for _, line := range strings.Split(comment.String(), "\n") {
+		switch {
+		case strings.HasPrefix(line, "-"):
+			befores = append(befores, strings.TrimPrefix(line, "-"))
+		case strings.HasPrefix(line, "+"):
+			afters = append(afters, strings.TrimPrefix(line, "+"))
+		}
+	}
+	return strings.TrimSpace(strings.Join(befores, "\n")), strings.TrimSpace(strings.Join(afters, "\n"))
+}
+
+func trimLeadingComments(text string) string {
+	for strings.HasPrefix(text, "--") {
+		_, rest, ok := strings.Cut(text, "\n")
+		if !ok {
+			return ""
+		}
+		text = rest
+	}
+	return text
+}
+
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			// MEMO: "NotNull" -> "not_null", "ADDColumn" -> "add_column"
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,109 @@
+package ddl
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+)
+
+type testStmt interface {
+	String() string
+	GetNameForDiff() string
+}
+
+type CreateTableStmt struct {
+	Comment string
+	Name    string
+}
+
+func (s *CreateTableStmt) String() string         { return "CREATE TABLE " + s.Name + " (id INT);\n" }
+func (s *CreateTableStmt) GetNameForDiff() string { return s.Name }
+
+type AddColumn struct{}
+
+type AlterTableStmt struct {
+	Comment string
+	Name    string
+	Action  any
+}
+
+func (s *AlterTableStmt) String() string {
+	return "-- -\n-- +name TEXT\nALTER TABLE " + s.Name + " ADD COLUMN name TEXT;\n"
+}
+func (s *AlterTableStmt) GetNameForDiff() string { return s.Name }
+
+func TestNewStatements(t *testing.T) {
+	t.Parallel()
+
+	stmts := []testStmt{
+		&CreateTableStmt{Name: "users"},
+		&AlterTableStmt{Comment: "-\n+name TEXT", Name: "groups", Action: &AddColumn{}},
+	}
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		actual := NewStatements(stmts, func(stmt testStmt) ChangeClass {
+			if _, ok := stmt.(*CreateTableStmt); ok {
+				return ChangeClassSafe
+			}
+			return ChangeClassLocking
+		})
+
+		expected := []*Statement{
+			{Kind: "create_table", Object: "users", Class: ChangeClassSafe, SQL: "CREATE TABLE users (id INT);", text: "CREATE TABLE users (id INT);\n"},
+			{Kind: "add_column", Object: "groups", Class: ChangeClassLocking, After: "name TEXT", SQL: "ALTER TABLE groups ADD COLUMN name TEXT;", text: "-- -\n-- +name TEXT\nALTER TABLE groups ADD COLUMN name TEXT;\n"},
+		}
+		assert.Equal(t, expected, actual)
+		assert.Equal(t, "-- ddlctl:class locking\n-- -\n-- +name TEXT\nALTER TABLE groups ADD COLUMN name TEXT;\n", actual[1].String())
+	})
+
+	t.Run("success,classify_is_nil", func(t *testing.T) {
+		t.Parallel()
+
+		actual := NewStatements(stmts, nil)
+		assert.Equal(t, ChangeClass(""), actual[0].Class)
+		assert.Equal(t, "CREATE TABLE users (id INT);\n", actual[0].String())
+	})
+}
+
+func Test_toSnakeCase(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"CreateTable":            "create_table",
+		"AlterColumnSetNotNull":  "alter_column_set_not_null",
+		"AlterColumnSetDataType": "alter_column_set_data_type",
+		"CreateVIEW":             "create_view",
+		"":                       "",
+	}
+	for input, expected := range tests {
+		assert.Equal(t, expected, toSnakeCase(input))
+	}
+}
+
+func TestMarkIrreversible(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		up := []*Statement{
+			{Kind: "drop_table", Object: "posts", Class: ChangeClassDataLoss},
+			{Kind: "drop_column", Object: "users", Class: ChangeClassDataLoss, Before: "name TEXT"},
+			{Kind: "add_column", Object: "users", Class: ChangeClassSafe, After: "age INT"},
+		}
+		down := []*Statement{
+			{Kind: "create_table", Object: "posts", Class: ChangeClassSafe},
+			{Kind: "add_column", Object: "users", Class: ChangeClassSafe, After: "name TEXT", text: "ALTER TABLE users ADD COLUMN name TEXT;\n"},
+			{Kind: "drop_column", Object: "users", Class: ChangeClassDataLoss, Before: "age INT"},
+		}
+
+		MarkIrreversible(up, down)
+
+		assert.Equal(t, true, down[0].Irreversible)
+		assert.Equal(t, true, down[1].Irreversible)
+		assert.Equal(t, false, down[2].Irreversible)
+		assert.Equal(t, "-- ddlctl:class safe\n-- ddlctl:irreversible\nALTER TABLE users ADD COLUMN name TEXT;\n", down[1].String())
+	})
+}
@@ -0,0 +1,85 @@
+package ddl
+
+import (
+	"path"
+	"regexp"
+	"strings"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+)
+
+// TableFilter selects the tables by the include and exclude patterns.
+//
+// A pattern is a glob such as "schema_*", or a regular expression enclosed in slashes such as "/^goose_.+$/".
+// A pattern matches either the table name or the table name qualified by its schema, such as "public.users".
+type TableFilter struct {
+	include []func(name string) bool
+	exclude []func(name string) bool
+}
+
+// NewTableFilter returns the TableFilter which keeps the tables that match any of include, or all the tables if include is empty,
+// except the tables that match any of exclude.
+func NewTableFilter(include, exclude []string) (*TableFilter, error) {
+	f := &TableFilter{}
+	for _, pattern := range include {
+		match, err := compileTablePattern(pattern)
+		if err != nil {
+			return nil, apperr.Errorf("compileTablePattern: %w", err)
+		}
+		f.include = append(f.include, match)
+	}
+	for _, pattern := range exclude {
+		match, err := compileTablePattern(pattern)
+		if err != nil {
+			return nil, apperr.Errorf("compileTablePattern: %w", err)
+		}
+		f.exclude = append(f.exclude, match)
+	}
+	return f, nil
+}
+
+func compileTablePattern(pattern string) (func(name string) bool, error) {
+	const minRegexpLen = 2
+	if len(pattern) > minRegexpLen && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile(pattern[1 : len(pattern)-1])
+		if err != nil {
+			return nil, apperr.Errorf("pattern=%q: %v: %w", pattern, err, ErrInvalidTablePattern) //nolint:errorlint
+		}
+		return re.MatchString, nil
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, apperr.Errorf("pattern=%q: %v: %w", pattern, err, ErrInvalidTablePattern) //nolint:errorlint
+	}
+	return func(name string) bool {
+		matched, _ := path.Match(pattern, name)
+		return matched
+	}, nil
+}
+
+// Keep reports whether the table is selected. It can be used for FilterTables of each dialect.
+func (f *TableFilter) Keep(schema, table string) bool {
+	if f == nil {
+		return true
+	}
+
+	names := []string{table}
+	if schema != "" {
+		names = append(names, schema+"."+table)
+	}
+	matchAny := func(matches []func(name string) bool) bool {
+		for _, match := range matches {
+			for _, name := range names {
+				if match(name) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	if len(f.include) > 0 && !matchAny(f.include) {
+		return false
+	}
+	return !matchAny(f.exclude)
+}
@@ -0,0 +1,55 @@
+package ddl
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+)
+
+func TestTableFilter_Keep(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,nil", func(t *testing.T) {
+		t.Parallel()
+
+		var f *TableFilter
+		assert.True(t, f.Keep("public", "users"))
+	})
+
+	t.Run("success,exclude", func(t *testing.T) {
+		t.Parallel()
+
+		f, err := NewTableFilter(nil, []string{"schema_migrations", "/^goose_.+$/", "audit.*"})
+		require.NoError(t, err)
+		assert.True(t, f.Keep("public", "users"))
+		assert.False(t, f.Keep("public", "schema_migrations"))
+		assert.False(t, f.Keep("", "goose_db_version"))
+		assert.False(t, f.Keep("audit", "users"))
+	})
+
+	t.Run("success,include", func(t *testing.T) {
+		t.Parallel()
+
+		f, err := NewTableFilter([]string{"user*"}, []string{"users_backup"})
+		require.NoError(t, err)
+		assert.True(t, f.Keep("public", "users"))
+		assert.True(t, f.Keep("", "user_groups"))
+		assert.False(t, f.Keep("public", "groups"))
+		assert.False(t, f.Keep("public", "users_backup"))
+	})
+
+	t.Run("failure,ErrInvalidTablePattern,glob", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewTableFilter([]string{"users["}, nil)
+		require.ErrorIs(t, err, ErrInvalidTablePattern)
+	})
+
+	t.Run("failure,ErrInvalidTablePattern,regexp", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewTableFilter(nil, []string{"/users(/"})
+		require.ErrorIs(t, err, ErrInvalidTablePattern)
+	})
+}
@@ -14,13 +14,18 @@ import (
 	errorz "github.com/kunitsucom/util.go/errors"
 	"github.com/kunitsucom/util.go/retry"
 	stringz "github.com/kunitsucom/util.go/strings"
+	"github.com/kunitsucom/util.go/version"
 
 	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
 	"github.com/kunitsucom/ddlctl/pkg/ddl"
 	ddlcrdb "github.com/kunitsucom/ddlctl/pkg/ddl/cockroachdb"
 	ddlmysql "github.com/kunitsucom/ddlctl/pkg/ddl/mysql"
 	ddlspanner "github.com/kunitsucom/ddlctl/pkg/ddl/spanner"
+	ddlsqlite3 "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
 	"github.com/kunitsucom/ddlctl/pkg/ddlctl/diff"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/history"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/plan"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/show"
 	"github.com/kunitsucom/ddlctl/pkg/internal/config"
 	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
 	"github.com/kunitsucom/ddlctl/pkg/internal/util"
@@ -33,34 +38,81 @@ func Command(ctx context.Context, args []string) (err error) {
 		return apperr.Errorf("config.Load: %w", err)
 	}
 
-	const beforeAndAfterForDiff = 2
-	if len(args) != beforeAndAfterForDiff {
-		return apperr.Errorf("args=%v: %w", args, apperr.ErrTwoArgumentsRequired)
-	}
-
 	dialect := config.Dialect()
-	language := config.Language()
-	leftArg, rightArg := args[0], args[1]
+	var dsn, schema, desiredDDL, ddlStr string
+	var classes ddl.ChangeClasses
+	if planFile := config.Plan(); planFile != "" {
+		if len(args) != 1 {
+			return apperr.Errorf("args=%v: %w", args, apperr.ErrOneArgumentRequired)
+		}
+		dsn = args[0]
 
-	buf := new(strings.Builder)
-	if err := diff.Diff(ctx, buf, dialect, language, leftArg, rightArg); err != nil {
-		if errors.Is(err, ddl.ErrNoDifference) {
-			_, _ = fmt.Fprintln(os.Stdout, ddl.ErrNoDifference.Error())
-			return nil
+		p, currentSchema, err := readPlan(ctx, dialect, dsn, planFile)
+		if err != nil {
+			return apperr.Errorf("readPlan: %w", err)
+		}
+		dialect, schema, desiredDDL, ddlStr = p.Dialect, currentSchema, p.DesiredDDL, p.DDL
+
+		stmts, err := classifyPlan(p, schema)
+		if err != nil {
+			return apperr.Errorf("classifyPlan: %w", err)
+		}
+		classes = ddl.CountChangeClasses(stmts)
+	} else {
+		const beforeAndAfterForDiff = 2
+		if len(args) != beforeAndAfterForDiff {
+			return apperr.Errorf("args=%v: %w", args, apperr.ErrTwoArgumentsRequired)
 		}
-		return apperr.Errorf("diff: %w", err)
+		dsn = args[0]
+
+		language := config.Language()
+		if schema, err = diff.Resolve(ctx, language, dialect, args[0]); err != nil {
+			return apperr.Errorf("diff.Resolve: %w", err)
+		}
+		if desiredDDL, err = diff.Resolve(ctx, language, dialect, args[1]); err != nil {
+			return apperr.Errorf("diff.Resolve: %w", err)
+		}
+
+		stmts, err := diff.DiffStatements(dialect, schema, desiredDDL)
+		if err != nil && !errors.Is(err, ddl.ErrNoDifference) {
+			return apperr.Errorf("diff.DiffStatements: %w", err)
+		}
+		buf := new(strings.Builder)
+		for _, stmt := range stmts {
+			buf.WriteString(stmt.String())
+		}
+		ddlStr = buf.String()
+		classes = ddl.CountChangeClasses(stmts)
 	}
-	ddlStr := buf.String()
 
-	msg := `
-ddlctl will exec the following DDL queries:
+	if ddlStr == "" {
+		_, _ = fmt.Fprintln(os.Stdout, ddl.ErrNoDifference.Error())
+		return nil
+	}
 
--- 8< --
+	if _, err := txMode(dialect); err != nil {
+		return apperr.Errorf("txMode: %w", err)
+	}
 
-` + ddlStr + `
+	if _, err := os.Stdout.WriteString(plan.Describe(ddlStr)); err != nil {
+		return apperr.Errorf("os.Stdout.WriteString: %w", err)
+	}
 
--- >8 --
+	// MEMO: The data-loss DDL queries are refused even with --auto-approve, because the lost data cannot be restored.
+	//       The classes are counted from the classified statements, not from the comments that can be edited.
+	if n := classes[ddl.ChangeClassDataLoss]; n > 0 && !config.AllowDestructive() {
+		return apperr.Errorf("%d data-loss DDL queries are refused, use --%s option to apply them: %w", n, consts.OptionAllowDestructive, apperr.ErrDestructiveChangeNotAllowed)
+	}
 
+	if shadowDSN := config.ShadowDSN(); shadowDSN != "" {
+		os.Stdout.WriteString("\nvalidating on the shadow database...\n")
+		if err := validateOnShadow(ctx, dialect, shadowDSN, schema, ddlStr, desiredDDL); err != nil {
+			return apperr.Errorf("validateOnShadow: %w", err)
+		}
+		os.Stdout.WriteString("ok\n")
+	}
+
+	msg := `
 Do you want to apply these DDL queries?
   ddlctl will exec the DDL queries described above.
   Only 'yes' will be accepted to approve.
@@ -83,8 +135,17 @@ Enter a value: `
 
 	os.Stdout.WriteString("\nexecuting...\n")
 
-	if err := Apply(ctx, dialect, leftArg, ddlStr); err != nil {
-		return apperr.Errorf("Apply: %w", err)
+	appliedAt := time.Now()
+	applyErr := Apply(ctx, dialect, dsn, ddlStr)
+	if config.RecordHistory() {
+		// MEMO: The failure is also recorded, because the DDL queries might have been applied partially.
+		record := history.NewRecord(appliedAt, version.Version(), ddlStr, time.Since(appliedAt), applyErr)
+		if err := history.Save(ctx, dialect, dsn, record); err != nil {
+			return apperr.Errorf("history.Save: %w", errors.Join(err, applyErr))
+		}
+	}
+	if applyErr != nil {
+		return apperr.Errorf("Apply: %w", applyErr)
 	}
 
 	os.Stdout.WriteString("done\n")
@@ -126,7 +187,17 @@ func Apply(ctx context.Context, dialect, dsn, ddlStr string) error {
 		); err != nil {
 			return apperr.Errorf("splitExec: %w", err)
 		}
-	case ddlcrdb.DriverName:
+	case ddlcrdb.DriverName: // MEMO: postgres and cockroachdb
+		mode, err := txMode(dialect)
+		if err != nil {
+			return apperr.Errorf("txMode: %w", err)
+		}
+		if mode != TxNone {
+			if err := txExec(ctx, db, ddlStr, mode); err != nil {
+				return apperr.Errorf("txExec: %w", err)
+			}
+			break
+		}
 		if err := splitExec(
 			ctx,
 			db,
@@ -169,6 +240,41 @@ func Apply(ctx context.Context, dialect, dsn, ddlStr string) error {
 				return apperr.Errorf("conn.ExecContext: q=%s: %w", q, err)
 			}
 		}
+	case ddlsqlite3.DriverName:
+		// MEMO: A table rebuild drops the old table, so foreign key enforcement is disabled while applying.
+		// PRAGMA foreign_keys is a no-op inside a transaction, so it is set before BEGIN. https://www.sqlite.org/lang_altertable.html#otheralter
+		conn, err := db.Conn(ctx)
+		if err != nil {
+			return apperr.Errorf("db.Conn: %w", err)
+		}
+		defer func() {
+			if err2 := conn.Close(); err == nil && err2 != nil {
+				err = apperr.Errorf("conn.Close: %w", err2)
+			}
+		}()
+		var foreignKeys int
+		if err := conn.QueryRowContext(ctx, "PRAGMA foreign_keys").Scan(&foreignKeys); err != nil {
+			return apperr.Errorf("conn.QueryRowContext: %w", err)
+		}
+		if _, err := conn.ExecContext(ctx, "PRAGMA foreign_keys = OFF"); err != nil {
+			return apperr.Errorf("conn.ExecContext: %w", err)
+		}
+		defer func() {
+			if _, err2 := conn.ExecContext(ctx, fmt.Sprintf("PRAGMA foreign_keys = %d", foreignKeys)); err == nil && err2 != nil {
+				err = apperr.Errorf("conn.ExecContext: %w", err2)
+			}
+		}()
+		tx, err := conn.BeginTx(ctx, nil)
+		if err != nil {
+			return apperr.Errorf("conn.BeginTx: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, ddlStr); err != nil {
+			_ = tx.Rollback()
+			return apperr.Errorf("tx.ExecContext: q=%s: %w", ddlStr, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return apperr.Errorf("tx.Commit: %w", err)
+		}
 	default:
 		if _, err := db.ExecContext(ctx, ddlStr); err != nil {
 			return apperr.Errorf("db.ExecContext: q=%s: %w", ddlStr, err)
@@ -178,6 +284,61 @@ func Apply(ctx context.Context, dialect, dsn, ddlStr string) error {
 	return nil
 }
 
+// readPlan reads the plan file, and verifies that the schema of dsn is not changed since the plan was created.
+// It returns the plan and the current schema of dsn.
+func readPlan(ctx context.Context, dialect, dsn, planFile string) (*plan.Plan, string, error) {
+	p, err := plan.ReadFile(planFile)
+	if err != nil {
+		return nil, "", apperr.Errorf("plan.ReadFile: %w", err)
+	}
+
+	// MEMO: --dialect is optional with --plan, because the plan has its dialect.
+	if dialect != "" && dialect != p.Dialect {
+		return nil, "", apperr.Errorf("dialect=%s, plan.Dialect=%s: %w", dialect, p.Dialect, apperr.ErrPlanDialectMismatch)
+	}
+
+	// MEMO: The schema is shown with the filters of the plan, so that the same objects as the plan are compared.
+	config.SetFilters(p.Filters.Schema, p.Filters.ExcludeSchema, p.Filters.IncludeTable, p.Filters.ExcludeTable)
+	config.SetRenames(p.Renames, p.RenameHeuristic)
+
+	schema, err := show.Show(ctx, p.Dialect, dsn)
+	if err != nil {
+		return nil, "", apperr.Errorf("show.Show: %w", err)
+	}
+
+	fingerprint, err := plan.Fingerprint(p.Dialect, schema, p.Filters)
+	if err != nil {
+		return nil, "", apperr.Errorf("plan.Fingerprint: %w", err)
+	}
+	if fingerprint != p.Fingerprint {
+		return nil, "", apperr.Errorf("fingerprint=%s, plan.Fingerprint=%s, re-create the plan: %w", fingerprint, p.Fingerprint, apperr.ErrSchemaChangedSincePlan)
+	}
+
+	return p, schema, nil
+}
+
+// classifyPlan returns the classified statements of the DDL of the plan.
+//
+// MEMO: The classes are not read from the comments of the plan file, which can be edited.
+// The statements are made again from the schema, which is the same as the one that the plan is created from,
+// and the desired DDL of the plan, and the plan whose DDL differs from them is refused.
+func classifyPlan(p *plan.Plan, schema string) ([]*ddl.Statement, error) {
+	stmts, err := diff.DiffStatements(p.Dialect, schema, p.DesiredDDL)
+	if err != nil && !errors.Is(err, ddl.ErrNoDifference) {
+		return nil, apperr.Errorf("diff.DiffStatements: %w", err)
+	}
+
+	buf := new(strings.Builder)
+	for _, stmt := range stmts {
+		buf.WriteString(stmt.String())
+	}
+	if util.RemoveCommentsAndEmptyLines("--", buf.String()) != util.RemoveCommentsAndEmptyLines("--", p.DDL) {
+		return nil, apperr.Errorf("the DDL of the plan differs from the difference to its desired DDL, re-create the plan: %w", apperr.ErrPlanDDLMismatch)
+	}
+
+	return stmts, nil
+}
+
 func prompt() error {
 	scanner := bufio.NewScanner(os.Stdin)
 	scanner.Scan()
@@ -201,6 +362,8 @@ func splitExec(
 ) error {
 	ddls := strings.Split(util.RemoveCommentsAndEmptyLines("--", ddlStr), ";\n")
 	const interval = 500 * time.Millisecond
+	// MEMO: The diff is already ordered by the dependencies between tables, so the retry is only a fallback for
+	// the DDL written by hand or the dependencies that ddlctl cannot detect.
 	retryer := retry.New(ctx, retry.NewConfig(interval, interval, retry.WithMaxRetries(len(ddls))))
 	if err := retryer.Do(func(ctx context.Context) error {
 		var outerErr error
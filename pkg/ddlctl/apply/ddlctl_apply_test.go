@@ -0,0 +1,117 @@
+package apply
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	ddlsqlite3 "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/plan"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/show"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+	"github.com/kunitsucom/ddlctl/pkg/internal/fixture"
+)
+
+//nolint:paralleltest
+func Test_readPlan(t *testing.T) {
+	load := func(t *testing.T, args ...string) context.Context {
+		t.Helper()
+		cmd := fixture.Cmd()
+		cmd.Options = append(cmd.Options, &cliz.StringOption{Name: consts.OptionExcludeTable, Default: cliz.Default("")})
+		_, err := cmd.Parse(append([]string{"ddlctl", "--dialect=sqlite3"}, args...))
+		require.NoError(t, err)
+		ctx := cliz.WithContext(context.Background(), cmd)
+		rollback := config.MustLoad(ctx)
+		t.Cleanup(rollback)
+		return ctx
+	}
+
+	setup := func(t *testing.T) (dsn, planFile string) {
+		t.Helper()
+		dir := t.TempDir()
+		dsn, planFile = filepath.Join(dir, "test.db"), filepath.Join(dir, "plan.json")
+		require.NoError(t, Apply(context.Background(), ddlsqlite3.Dialect, dsn, "CREATE TABLE a (id INTEGER PRIMARY KEY);\nCREATE TABLE b (id INTEGER PRIMARY KEY);\n"))
+		src := filepath.Join(dir, "desired.sql")
+		require.NoError(t, os.WriteFile(src, []byte("CREATE TABLE a (id INTEGER PRIMARY KEY, name TEXT);\n"), 0o600))
+
+		ctx := load(t, "--exclude-table=b")
+		p, err := plan.Create(ctx, ddlsqlite3.Dialect, "", dsn, src)
+		require.NoError(t, err)
+		f, err := os.Create(planFile)
+		require.NoError(t, err)
+		require.NoError(t, plan.Write(f, p))
+		require.NoError(t, f.Close())
+		return dsn, planFile
+	}
+
+	t.Run("success,filters of the plan", func(t *testing.T) {
+		dsn, planFile := setup(t)
+		require.NoError(t, Apply(context.Background(), ddlsqlite3.Dialect, dsn, "ALTER TABLE b ADD COLUMN name TEXT;\n"))
+
+		ctx := load(t)
+		p, _, err := readPlan(ctx, ddlsqlite3.Dialect, dsn, planFile)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b"}, p.Filters.ExcludeTable)
+		assert.Equal(t, []string{"b"}, config.ExcludeTable())
+	})
+
+	t.Run("failure,ErrSchemaChangedSincePlan", func(t *testing.T) {
+		dsn, planFile := setup(t)
+		require.NoError(t, Apply(context.Background(), ddlsqlite3.Dialect, dsn, "ALTER TABLE a ADD COLUMN memo TEXT;\n"))
+
+		ctx := load(t)
+		_, _, err := readPlan(ctx, ddlsqlite3.Dialect, dsn, planFile)
+		require.ErrorIs(t, err, apperr.ErrSchemaChangedSincePlan)
+	})
+}
+
+//nolint:paralleltest
+func Test_classifyPlan(t *testing.T) {
+	setup := func(t *testing.T) (*plan.Plan, string) {
+		t.Helper()
+		cmd := fixture.Cmd()
+		_, err := cmd.Parse([]string{"ddlctl", "--dialect=sqlite3"})
+		require.NoError(t, err)
+		ctx := cliz.WithContext(context.Background(), cmd)
+		rollback := config.MustLoad(ctx)
+		t.Cleanup(rollback)
+
+		dir := t.TempDir()
+		dsn, src := filepath.Join(dir, "test.db"), filepath.Join(dir, "desired.sql")
+		require.NoError(t, Apply(context.Background(), ddlsqlite3.Dialect, dsn, "CREATE TABLE a (id INTEGER PRIMARY KEY);\nCREATE TABLE b (id INTEGER PRIMARY KEY);\n"))
+		require.NoError(t, os.WriteFile(src, []byte("CREATE TABLE a (id INTEGER PRIMARY KEY);\n"), 0o600))
+
+		p, err := plan.Create(ctx, ddlsqlite3.Dialect, "", dsn, src)
+		require.NoError(t, err)
+		schema, err := show.Show(ctx, ddlsqlite3.Dialect, dsn)
+		require.NoError(t, err)
+		return p, schema
+	}
+
+	t.Run("success,class comments are edited", func(t *testing.T) {
+		p, schema := setup(t)
+		p.DDL = strings.ReplaceAll(p.DDL, ddl.ChangeClassDataLoss.Comment(), ddl.ChangeClassSafe.Comment())
+		require.Equal(t, 0, ddl.ExtractChangeClasses(p.DDL)[ddl.ChangeClassDataLoss])
+
+		stmts, err := classifyPlan(p, schema)
+		require.NoError(t, err)
+		assert.Equal(t, ddl.ChangeClasses{ddl.ChangeClassDataLoss: 1}, ddl.CountChangeClasses(stmts))
+	})
+
+	t.Run("failure,ErrPlanDDLMismatch", func(t *testing.T) {
+		p, schema := setup(t)
+		p.DDL += "DROP TABLE a;\n"
+
+		_, err := classifyPlan(p, schema)
+		require.ErrorIs(t, err, apperr.ErrPlanDDLMismatch)
+	})
+}
@@ -0,0 +1,60 @@
+package apply
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/diff"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/show"
+)
+
+// validateOnShadow applies ddlStr to the shadow database which has the same schema as schema,
+// and verifies that the schema of the shadow database converges to desiredDDL.
+func validateOnShadow(ctx context.Context, dialect, shadowDSN, schema, ddlStr, desiredDDL string) error {
+	// MEMO: The shadow database is brought to the current schema by diff, so that it does not have to be empty.
+	// The current schema is loaded as it is, without --rename and the filters such as --exclude-table,
+	// since ddlStr may depend on the tables out of them, and the hints are not for the shadow database.
+	shadowSchema, err := show.Show(ctx, dialect, shadowDSN)
+	if err != nil {
+		return apperr.Errorf("show.Show: %w", err)
+	}
+	loadStmts, err := diff.DiffStatementsUnfiltered(dialect, shadowSchema, schema)
+	if err != nil && !errors.Is(err, ddl.ErrNoDifference) {
+		return apperr.Errorf("diff.DiffStatementsUnfiltered: %w", err)
+	}
+	loadDDL := new(strings.Builder)
+	for _, stmt := range loadStmts {
+		loadDDL.WriteString(stmt.String())
+	}
+	if loadDDL.Len() > 0 {
+		if err := Apply(ctx, dialect, shadowDSN, loadDDL.String()); err != nil {
+			return apperr.Errorf("Apply: load the current schema: %w", err)
+		}
+	}
+
+	if err := Apply(ctx, dialect, shadowDSN, ddlStr); err != nil {
+		return apperr.Errorf("Apply: %w", err)
+	}
+
+	appliedSchema, err := show.Show(ctx, dialect, shadowDSN)
+	if err != nil {
+		return apperr.Errorf("show.Show: %w", err)
+	}
+	rest := new(strings.Builder)
+	if err := diff.DiffDDL(rest, dialect, appliedSchema, desiredDDL); err != nil {
+		if errors.Is(err, ddl.ErrNoDifference) {
+			return nil
+		}
+		return apperr.Errorf("diff.DiffDDL: %w", err)
+	}
+
+	if _, err := os.Stdout.WriteString("\nthe following difference remains after applying the DDL queries on the shadow database:\n\n" + rest.String()); err != nil {
+		return apperr.Errorf("os.Stdout.WriteString: %w", err)
+	}
+
+	return apperr.Errorf("diff.DiffDDL: %w", apperr.ErrShadowNotConverged)
+}
@@ -0,0 +1,53 @@
+package apply
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	ddlsqlite3 "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+	"github.com/kunitsucom/ddlctl/pkg/internal/fixture"
+)
+
+//nolint:paralleltest
+func Test_validateOnShadow(t *testing.T) {
+	cmd := fixture.Cmd()
+	cmd.Options = append(cmd.Options, &cliz.StringOption{Name: consts.OptionExcludeTable, Default: cliz.Default("")})
+	_, err := cmd.Parse([]string{"--dialect=sqlite3", "--exclude-table=c"})
+	require.NoError(t, err)
+	rollback := config.MustLoad(cliz.WithContext(context.Background(), cmd))
+	t.Cleanup(rollback)
+
+	const (
+		schema     = "CREATE TABLE a (id INTEGER PRIMARY KEY);\nCREATE TABLE c (id INTEGER PRIMARY KEY);\n"
+		desiredDDL = "CREATE TABLE a (id INTEGER PRIMARY KEY, name TEXT);\nCREATE TABLE b (id INTEGER PRIMARY KEY);\n"
+	)
+
+	t.Run("success,", func(t *testing.T) {
+		shadowDSN := filepath.Join(t.TempDir(), "shadow.db")
+		ddlStr := "CREATE TABLE b (id INTEGER PRIMARY KEY);\nALTER TABLE a ADD COLUMN name TEXT;\n"
+		require.NoError(t, validateOnShadow(context.Background(), ddlsqlite3.Dialect, shadowDSN, schema, ddlStr, desiredDDL))
+	})
+
+	t.Run("success,excluded table", func(t *testing.T) {
+		// MEMO: The excluded table is also loaded into the shadow database, since ddlStr may depend on it.
+		shadowDSN := filepath.Join(t.TempDir(), "shadow.db")
+		ddlStr := "CREATE TABLE b (id INTEGER PRIMARY KEY);\nALTER TABLE a ADD COLUMN name TEXT;\nCREATE INDEX c_idx_id ON c (id);\n"
+		require.NoError(t, validateOnShadow(context.Background(), ddlsqlite3.Dialect, shadowDSN, schema, ddlStr, desiredDDL))
+	})
+
+	t.Run("failure,ErrShadowNotConverged", func(t *testing.T) {
+		shadowDSN := filepath.Join(t.TempDir(), "shadow.db")
+		ddlStr := "CREATE TABLE b (id INTEGER PRIMARY KEY);\n"
+		err := validateOnShadow(context.Background(), ddlsqlite3.Dialect, shadowDSN, schema, ddlStr, desiredDDL)
+		assert.Equal(t, true, errors.Is(err, apperr.ErrShadowNotConverged))
+	})
+}
@@ -0,0 +1,149 @@
+package apply
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	ddlcrdb "github.com/kunitsucom/ddlctl/pkg/ddl/cockroachdb"
+	ddlpg "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/util"
+	"github.com/kunitsucom/ddlctl/pkg/logs"
+)
+
+const (
+	// TxAll runs all the DDL queries in a transaction, and rolls back on the first error.
+	TxAll = "all"
+	// TxPerStatement runs each DDL query in its own transaction, and stops on the first error.
+	TxPerStatement = "per-statement"
+	// TxNone runs the DDL queries without an explicit transaction.
+	TxNone = "none"
+)
+
+// txMode returns the transaction mode specified by --tx, or the default one of the dialect.
+// --tx is only for postgres and cockroachdb, and is refused for the other dialects rather than ignored.
+func txMode(dialect string) (string, error) {
+	mode := config.Tx()
+	if mode != "" && dialect != ddlpg.Dialect && dialect != ddlcrdb.Dialect {
+		return "", apperr.Errorf("dialect=%s, tx=%s: %w", dialect, mode, apperr.ErrNotSupported)
+	}
+
+	switch mode {
+	case TxAll, TxPerStatement, TxNone:
+		return mode, nil
+	case "":
+		// MEMO: The DDL queries are run one by one without an explicit transaction by default, as before --tx is introduced.
+		// CockroachDB does not guarantee the atomicity of the schema changes in a transaction either.
+		return TxNone, nil
+	default:
+		return "", apperr.Errorf("tx=%s: %w", mode, apperr.ErrNotSupported)
+	}
+}
+
+// noTxStmtRegex matches the DDL queries that cannot run inside a transaction block,
+// or whose result cannot be used by the following queries until committed, such as ALTER TYPE ... ADD VALUE.
+//
+// MEMO: https://www.postgresql.org/docs/current/sql-createindex.html#SQL-CREATEINDEX-CONCURRENTLY
+// MEMO: https://www.postgresql.org/docs/current/sql-altertype.html#SQL-ALTERTYPE-NOTES
+//
+//nolint:gochecknoglobals
+var noTxStmtRegex = regexp.MustCompile(`(?is)^\s*(` +
+	`(CREATE\s+(UNIQUE\s+)?INDEX|DROP\s+INDEX|REINDEX\s+\S+)\s+CONCURRENTLY\b` +
+	`|(CREATE|DROP)\s+(DATABASE|TABLESPACE)\b` +
+	`|ALTER\s+SYSTEM\b` +
+	`|ALTER\s+TYPE\s+.+\s+ADD\s+VALUE\b` +
+	`|VACUUM\b` +
+	`)`)
+
+// canRunInTx reports whether the DDL query can run inside a transaction block.
+func canRunInTx(q string) bool {
+	return !noTxStmtRegex.MatchString(q)
+}
+
+// splitStmts splits the DDL into the queries without comments.
+func splitStmts(ddlStr string) []string {
+	var stmts []string
+	for _, q := range strings.Split(util.RemoveCommentsAndEmptyLines("--", ddlStr), ";\n") {
+		if q = strings.TrimSpace(q); q != "" {
+			stmts = append(stmts, q)
+		}
+	}
+	return stmts
+}
+
+// txExec runs the DDL queries in the transactions according to mode.
+// The queries that cannot run inside a transaction block, such as CREATE INDEX CONCURRENTLY and ALTER TYPE ... ADD VALUE,
+// are run outside of the transactions in order with TxPerStatement.
+// TxAll refuses them, because the transaction would be split at them and a later failure could not roll back the earlier queries.
+func txExec(ctx context.Context, db *sql.DB, ddlStr string, mode string) error {
+	stmts := splitStmts(ddlStr)
+	if mode == TxAll {
+		var noTxStmts []string
+		for _, q := range stmts {
+			if !canRunInTx(q) {
+				noTxStmts = append(noTxStmts, q)
+			}
+		}
+		if len(noTxStmts) > 0 {
+			return apperr.Errorf("tx=%s: the queries cannot run inside a transaction block, use --tx=%s: %s: %w", mode, TxPerStatement, strings.Join(noTxStmts, "; "), apperr.ErrTxSplitRequired)
+		}
+	}
+
+	var group []string
+	flush := func() error {
+		if len(group) == 0 {
+			return nil
+		}
+		defer func() { group = nil }()
+		if err := execInTx(ctx, db, group); err != nil {
+			return apperr.Errorf("execInTx: %w", err)
+		}
+		return nil
+	}
+
+	for _, q := range stmts {
+		if !canRunInTx(q) {
+			if err := flush(); err != nil {
+				return apperr.Errorf("flush: %w", err)
+			}
+			logs.Debug.Printf("run outside of transaction: q=%s", q)
+			if _, err := db.ExecContext(ctx, q); err != nil {
+				return apperr.Errorf("db.ExecContext: q=%s: %w", q, err)
+			}
+			continue
+		}
+
+		group = append(group, q)
+		if mode == TxPerStatement {
+			if err := flush(); err != nil {
+				return apperr.Errorf("flush: %w", err)
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return apperr.Errorf("flush: %w", err)
+	}
+
+	return nil
+}
+
+func execInTx(ctx context.Context, db *sql.DB, stmts []string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return apperr.Errorf("db.BeginTx: %w", err)
+	}
+	for _, q := range stmts {
+		if _, err := tx.ExecContext(ctx, q); err != nil {
+			_ = tx.Rollback()
+			return apperr.Errorf("tx.ExecContext: q=%s: %w", q, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return apperr.Errorf("tx.Commit: %w", err)
+	}
+	return nil
+}
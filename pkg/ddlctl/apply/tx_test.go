@@ -0,0 +1,173 @@
+package apply
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+	_ "github.com/mattn/go-sqlite3" //nolint:revive
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	ddlcrdb "github.com/kunitsucom/ddlctl/pkg/ddl/cockroachdb"
+	ddlmysql "github.com/kunitsucom/ddlctl/pkg/ddl/mysql"
+	ddlpg "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
+	ddlspanner "github.com/kunitsucom/ddlctl/pkg/ddl/spanner"
+	ddlsqlite3 "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+	"github.com/kunitsucom/ddlctl/pkg/internal/fixture"
+)
+
+func Test_canRunInTx(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		q        string
+		expected bool
+	}{
+		{`CREATE TABLE "users" ("id" INTEGER)`, true},
+		{`CREATE INDEX "users_idx_name" ON "users" ("name")`, true},
+		{`CREATE INDEX CONCURRENTLY "users_idx_name" ON "users" ("name")`, false},
+		{`create unique index concurrently users_idx_name on users (name)`, false},
+		{"DROP INDEX\n  CONCURRENTLY users_idx_name", false},
+		{`REINDEX INDEX CONCURRENTLY users_idx_name`, false},
+		{`CREATE DATABASE testdb`, false},
+		{`VACUUM users`, false},
+		{`ALTER TABLE "users" ADD COLUMN "concurrently" TEXT`, true},
+		{`ALTER TYPE user_status ADD VALUE 'banned' AFTER 'inactive'`, false},
+		{`alter type public."user_status" add value if not exists 'banned'`, false},
+		{`ALTER TYPE user_status RENAME TO user_status_old`, true},
+		{`ALTER TABLE "users" ADD COLUMN "value" TEXT`, true},
+	} {
+		if !assert.Equal(t, tt.expected, canRunInTx(tt.q)) {
+			t.Errorf("❌: %s", tt.q)
+		}
+	}
+}
+
+//nolint:paralleltest
+func Test_txMode(t *testing.T) {
+	load := func(t *testing.T, args ...string) {
+		t.Helper()
+		cmd := fixture.Cmd()
+		cmd.Options = append(cmd.Options, &cliz.StringOption{Name: consts.OptionTx, Default: cliz.Default("")})
+		_, err := cmd.Parse(append([]string{"ddlctl"}, args...))
+		require.NoError(t, err)
+		rollback := config.MustLoad(cliz.WithContext(context.Background(), cmd))
+		t.Cleanup(rollback)
+	}
+
+	t.Run("success,default", func(t *testing.T) {
+		load(t)
+		for dialect, expected := range map[string]string{
+			ddlpg.Dialect:      TxNone,
+			ddlcrdb.Dialect:    TxNone,
+			ddlsqlite3.Dialect: TxNone,
+		} {
+			mode, err := txMode(dialect)
+			require.NoError(t, err)
+			assert.Equal(t, expected, mode)
+		}
+	})
+
+	t.Run("success,tx", func(t *testing.T) {
+		load(t, "--tx="+TxPerStatement)
+		mode, err := txMode(ddlcrdb.Dialect)
+		require.NoError(t, err)
+		assert.Equal(t, TxPerStatement, mode)
+	})
+
+	t.Run("failure,ErrNotSupported", func(t *testing.T) {
+		load(t, "--tx="+TxAll)
+		for _, dialect := range []string{ddlmysql.Dialect, ddlspanner.Dialect, ddlsqlite3.Dialect} {
+			_, err := txMode(dialect)
+			require.ErrorIs(t, err, apperr.ErrNotSupported)
+		}
+
+		load(t, "--tx=unknown")
+		_, err := txMode(ddlpg.Dialect)
+		require.ErrorIs(t, err, apperr.ErrNotSupported)
+	})
+}
+
+func Test_splitStmts(t *testing.T) {
+	t.Parallel()
+
+	actual := splitStmts(`-- ddlctl:class safe
+CREATE TABLE a (id INTEGER);
+-- ddlctl:class locking
+CREATE INDEX CONCURRENTLY a_idx_id ON a (id);
+`)
+	assert.Equal(t, []string{"CREATE TABLE a (id INTEGER)", "CREATE INDEX CONCURRENTLY a_idx_id ON a (id)"}, actual)
+}
+
+func Test_txExec(t *testing.T) {
+	t.Parallel()
+
+	tables := func(t *testing.T, db *sql.DB) []string {
+		t.Helper()
+		rows, err := db.Query(`SELECT name FROM sqlite_master WHERE type = 'table' ORDER BY name`)
+		require.NoError(t, err)
+		defer rows.Close()
+		var names []string
+		for rows.Next() {
+			var name string
+			require.NoError(t, rows.Scan(&name))
+			names = append(names, name)
+		}
+		require.NoError(t, rows.Err())
+		return names
+	}
+
+	const ddlStr = "CREATE TABLE a (id INTEGER);\nCREATE TABLE b (id INTEGER);\nCREATE TABLE a (id INTEGER);\n"
+
+	for _, tt := range []struct {
+		mode     string
+		expected []string
+	}{
+		{TxAll, nil},
+		{TxPerStatement, []string{"a", "b"}},
+	} {
+		t.Run("failure,"+tt.mode, func(t *testing.T) {
+			t.Parallel()
+
+			db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "tx.db"))
+			require.NoError(t, err)
+			defer db.Close()
+
+			require.Error(t, txExec(context.Background(), db, ddlStr, tt.mode))
+			assert.Equal(t, tt.expected, tables(t, db))
+		})
+	}
+
+	const splitDDLStr = "CREATE TABLE a (id INTEGER);\nVACUUM;\nCREATE TABLE b (id INTEGER);\nCREATE TABLE a (id INTEGER);\n"
+
+	t.Run("failure,ErrTxSplitRequired", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "tx.db"))
+		require.NoError(t, err)
+		defer db.Close()
+
+		err = txExec(context.Background(), db, splitDDLStr, TxAll)
+		require.ErrorIs(t, err, apperr.ErrTxSplitRequired)
+		require.ErrorContains(t, err, "VACUUM")
+		assert.Equal(t, []string(nil), tables(t, db))
+	})
+
+	t.Run("failure,split", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "tx.db"))
+		require.NoError(t, err)
+		defer db.Close()
+
+		// MEMO: The query that runs outside of the transactions, such as ALTER TYPE ... ADD VALUE of postgres, is run in order.
+		require.Error(t, txExec(context.Background(), db, splitDDLStr, TxPerStatement))
+		assert.Equal(t, []string{"a", "b"}, tables(t, db))
+	})
+}
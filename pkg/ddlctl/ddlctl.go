@@ -13,6 +13,9 @@ import (
 	"github.com/kunitsucom/ddlctl/pkg/ddlctl/apply"
 	"github.com/kunitsucom/ddlctl/pkg/ddlctl/diff"
 	"github.com/kunitsucom/ddlctl/pkg/ddlctl/generate"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/history"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/plan"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/reverse"
 	"github.com/kunitsucom/ddlctl/pkg/ddlctl/show"
 	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
 )
@@ -22,7 +25,7 @@ var (
 	optLanguage = &cliz.StringOption{
 		Name:        consts.OptionLanguage,
 		Environment: consts.EnvKeyLanguage,
-		Description: "programming language to generate DDL",
+		Description: "programming language to generate DDL, one of `go` or `proto`",
 		Default:     cliz.Default("go"),
 	}
 	optDialect = &cliz.StringOption{
@@ -31,6 +34,48 @@ var (
 		Description: "SQL dialect to generate DDL",
 		Default:     cliz.Default(""),
 	}
+	optRename = &cliz.StringOption{
+		Name:        consts.OptionRename,
+		Environment: consts.EnvKeyRename,
+		Description: "rename hints like `old_table=new_table,table.old_column=table.new_column`, or the file of them",
+		Default:     cliz.Default(""),
+	}
+	optRenameHeuristic = &cliz.BoolOption{
+		Name:        consts.OptionRenameHeuristic,
+		Environment: consts.EnvKeyRenameHeuristic,
+		Description: "regard a dropped column and an added column that have the same definition as renamed",
+		Default:     cliz.Default(false),
+	}
+	optSchema = &cliz.StringOption{
+		Name:        consts.OptionSchema,
+		Environment: consts.EnvKeySchema,
+		Description: "comma-separated schemas to show and diff for postgres and cockroachdb (default: `public` for postgres, all for cockroachdb)",
+		Default:     cliz.Default(""),
+	}
+	optExcludeSchema = &cliz.StringOption{
+		Name:        consts.OptionExcludeSchema,
+		Environment: consts.EnvKeyExcludeSchema,
+		Description: "comma-separated schemas not to show and diff for postgres and cockroachdb",
+		Default:     cliz.Default(""),
+	}
+	optIncludeTable = &cliz.StringOption{
+		Name:        consts.OptionIncludeTable,
+		Environment: consts.EnvKeyIncludeTable,
+		Description: "comma-separated patterns of the tables to show and diff, each of which is a glob like `users_*` or a regular expression like `/^users_[0-9]+$/`, matched against the table name with or without its schema",
+		Default:     cliz.Default(""),
+	}
+	optExcludeTable = &cliz.StringOption{
+		Name:        consts.OptionExcludeTable,
+		Environment: consts.EnvKeyExcludeTable,
+		Description: "comma-separated patterns of the tables not to show and diff, such as `schema_migrations,goose_db_version`",
+		Default:     cliz.Default(""),
+	}
+	optOutput = &cliz.StringOption{
+		Name:        consts.OptionOutput,
+		Environment: consts.EnvKeyOutput,
+		Description: "output format, one of `text` or `json`. `json` describes each statement with its kind, object, before and after fragments and class",
+		Default:     cliz.Default("text"),
+	}
 	opts = []cliz.Option{
 		optLanguage,
 		optDialect,
@@ -53,6 +98,18 @@ var (
 			Description: "primary key annotation key for Go struct tag",
 			Default:     cliz.Default("pk"),
 		},
+		&cliz.BoolOption{
+			Name:        consts.OptionGoInferTypes,
+			Environment: consts.EnvKeyGoInferTypes,
+			Description: "infer the column type from the Go type of the field that does not have the DDL annotation, such as `string` as `TEXT NOT NULL` and `*time.Time` as `TIMESTAMPTZ` for postgres",
+			Default:     cliz.Default(false),
+		},
+		&cliz.StringOption{
+			Name:        consts.OptionGoTypeMapping,
+			Environment: consts.EnvKeyGoTypeMapping,
+			Description: "mapping from Go types to column types for --" + consts.OptionGoInferTypes + " like `string=VARCHAR(64),decimal.Decimal=NUMERIC(10, 2)`, or the file of them, which overrides the default mapping of the dialect",
+			Default:     cliz.Default(""),
+		},
 	}
 )
 
@@ -79,7 +136,7 @@ func DDLCtl(ctx context.Context) error {
 				Name:        "generate",
 				Short:       "gen",
 				Usage:       "ddlctl generate [options] --dialect <DDL dialect> <source> <destination>",
-				Description: "generate DDL from source (file or directory) to destination (file or directory).",
+				Description: "generate DDL from source (file, directory, or Go package pattern such as ./models/...) to destination (file or directory).",
 				Options:     opts,
 				RunFunc:     generate.Command,
 			},
@@ -87,30 +144,113 @@ func DDLCtl(ctx context.Context) error {
 				Name:        "show",
 				Usage:       "ddlctl show --dialect <DDL dialect> <DSN>",
 				Description: "show DDL from DSN like `SHOW CREATE TABLE`.",
-				Options:     []cliz.Option{optDialect},
+				Options:     []cliz.Option{optDialect, optSchema, optExcludeSchema, optIncludeTable, optExcludeTable, optOutput},
 				RunFunc:     show.Command,
 			},
+			{
+				Name:        "reverse",
+				Usage:       "ddlctl reverse [options] --dialect <DDL dialect> <DSN|file.sql> <destination>",
+				Description: "generate Go structs annotated for `ddlctl generate` from the DDL of <DSN|file.sql> to destination (file or directory).",
+				Options:     append(opts, optSchema, optExcludeSchema, optIncludeTable, optExcludeTable),
+				RunFunc:     reverse.Command,
+			},
 			{
 				Name:        "diff",
 				Usage:       "ddlctl diff [options] --dialect <DDL dialect> <before DDL source> <after DDL source>",
 				Description: "diff DDL from <before DDL source> to <after DDL source>.",
-				Options:     opts,
-				RunFunc:     diff.Command,
+				Options: append(opts,
+					optRename,
+					optRenameHeuristic,
+					optSchema,
+					optExcludeSchema,
+					optIncludeTable,
+					optExcludeTable,
+					optOutput,
+					&cliz.BoolOption{
+						Name:        consts.OptionExitCode,
+						Environment: consts.EnvKeyExitCode,
+						Description: "exit with 2 and write the summary of the drifted objects to stderr if there is a difference, 0 if not, and 1 on error",
+						Default:     cliz.Default(false),
+					},
+					&cliz.BoolOption{
+						Name:        consts.OptionWithDown,
+						Environment: consts.EnvKeyWithDown,
+						Description: "also generate the down DDL to roll back the difference, which is written to --down-output, or to `down_statements` with --output json",
+						Default:     cliz.Default(false),
+					},
+					&cliz.StringOption{
+						Name:        consts.OptionDownOutput,
+						Environment: consts.EnvKeyDownOutput,
+						Description: "write the down DDL to roll back the difference to the file, where the statements that cannot restore the lost data are marked `-- ddlctl:irreversible`; implies --with-down",
+						Default:     cliz.Default(""),
+					},
+				),
+				RunFunc: diff.Command,
+			},
+			{
+				Name:        "plan",
+				Usage:       "ddlctl plan [options] --dialect <DDL dialect> <DSN to apply> <DDL source> <plan file>",
+				Description: "save the plan to apply DDL from <DDL source> to <DSN to apply> as <plan file>.",
+				Options:     append(opts, optRename, optRenameHeuristic, optSchema, optExcludeSchema, optIncludeTable, optExcludeTable, optOutput),
+				RunFunc:     plan.Command,
 			},
 			{
 				Name:        "apply",
 				Usage:       "ddlctl apply [options] --dialect <DDL dialect> <DSN to apply> <DDL source>",
-				Description: "apply DDL from <DDL source> to <DSN to apply>.",
+				Description: "apply DDL from <DDL source> to <DSN to apply>. With --plan, apply the saved plan to <DSN to apply> instead.",
 				Options: append(opts,
+					optRename,
+					optRenameHeuristic,
+					optSchema,
+					optExcludeSchema,
+					optIncludeTable,
+					optExcludeTable,
+					&cliz.StringOption{
+						Name:        consts.OptionPlan,
+						Environment: consts.EnvKeyPlan,
+						Description: "plan file saved by `ddlctl plan`, which is applied only if the schema is not changed since the plan was created",
+						Default:     cliz.Default(""),
+					},
+					&cliz.StringOption{
+						Name:        consts.OptionShadowDSN,
+						Environment: consts.EnvKeyShadowDSN,
+						Description: "DSN of a throwaway database to validate that the DDL queries converge to <DDL source> before applying them",
+						Default:     cliz.Default(""),
+					},
+					&cliz.StringOption{
+						Name:        consts.OptionTx,
+						Environment: consts.EnvKeyTx,
+						Description: "transaction mode for postgres and cockroachdb, one of `all`, `per-statement` or `none` (default: `none`); `all` is refused if the DDL has a query that cannot run inside a transaction block, such as `CREATE INDEX CONCURRENTLY`",
+						Default:     cliz.Default(""),
+					},
+					&cliz.BoolOption{
+						Name:        consts.OptionRecordHistory,
+						Environment: consts.EnvKeyRecordHistory,
+						Description: "record the applied DDL, its duration and outcome into the " + history.TableName + " table",
+						Default:     cliz.Default(false),
+					},
 					&cliz.BoolOption{
 						Name:        consts.OptionAutoApprove,
 						Environment: consts.EnvKeyAutoApprove,
 						Description: "auto approve",
 						Default:     cliz.Default(false),
 					},
+					&cliz.BoolOption{
+						Name:        consts.OptionAllowDestructive,
+						Environment: consts.EnvKeyAllowDestructive,
+						Description: "allow to apply the data-loss DDL queries such as DROP TABLE or DROP COLUMN, even with --auto-approve",
+						Default:     cliz.Default(false),
+					},
 				),
 				RunFunc: apply.Command,
 			},
+			{
+				Name:        "history",
+				Usage:       "ddlctl history --dialect <DDL dialect> <DSN>",
+				Description: "show the history of DDL applied to <DSN> by `ddlctl apply --" + consts.OptionRecordHistory + "`.",
+				Options:     []cliz.Option{optDialect},
+				RunFunc:     history.Command,
+			},
 		},
 		Options: []cliz.Option{
 			&cliz.BoolOption{
@@ -3,8 +3,10 @@ package diff
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"os"
+	"slices"
 	"strings"
 
 	osz "github.com/kunitsucom/util.go/os"
@@ -15,9 +17,15 @@ import (
 	ddlmysql "github.com/kunitsucom/ddlctl/pkg/ddl/mysql"
 	ddlpg "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
 	ddlspanner "github.com/kunitsucom/ddlctl/pkg/ddl/spanner"
+	ddlsqlite3 "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
 	"github.com/kunitsucom/ddlctl/pkg/ddlctl/generate"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/history"
 	"github.com/kunitsucom/ddlctl/pkg/ddlctl/show"
 	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+	ddlctlgo "github.com/kunitsucom/ddlctl/pkg/internal/lang/go"
+	ddlctlproto "github.com/kunitsucom/ddlctl/pkg/internal/lang/proto"
+	"github.com/kunitsucom/ddlctl/pkg/internal/util"
 	"github.com/kunitsucom/ddlctl/pkg/logs"
 )
 
@@ -35,7 +43,8 @@ func Command(ctx context.Context, args []string) error {
 	language := config.Language()
 	leftArg, rightArg := args[0], args[1]
 
-	if err := Diff(ctx, os.Stdout, dialect, language, leftArg, rightArg); err != nil {
+	stmts, err := Diff(ctx, os.Stdout, dialect, language, leftArg, rightArg)
+	if err != nil {
 		if errors.Is(err, ddl.ErrNoDifference) {
 			logs.Debug.Print(ddl.ErrNoDifference.Error())
 			return nil
@@ -43,19 +52,33 @@ func Command(ctx context.Context, args []string) error {
 		return apperr.Errorf("diff: %w", err)
 	}
 
+	if config.ExitCode() {
+		// MEMO: The summary is written to stderr so that stdout has only the difference in the format of --output.
+		_, _ = io.WriteString(os.Stderr, Summarize(stmts))
+		return apperr.Errorf("diff: %w", apperr.ErrDriftDetected)
+	}
+
 	return nil
 }
 
+// Resolve returns the DDL of arg, which is a DSN, a SQL file, or a source for `ddlctl generate`.
+//
 //nolint:cyclop
-func resolve(ctx context.Context, language, dialect, arg string) (ddl string, err error) {
+func Resolve(ctx context.Context, language, dialect, arg string) (ddl string, err error) {
 	switch {
-	case osz.IsFile(arg): // NOTE: expect SQL file
+	case dialect == ddlsqlite3.Dialect && isSQLite3DatabaseFile(arg): // NOTE: expect SQLite3 database file as DSN
+		genDDL, err := show.Show(ctx, dialect, arg)
+		if err != nil {
+			return "", apperr.Errorf("Show: %w", err)
+		}
+		ddl = genDDL
+	case osz.IsFile(arg) && !isSourceFile(language, arg): // NOTE: expect SQL file
 		ddlBytes, err := os.ReadFile(arg)
 		if err != nil {
 			return "", apperr.Errorf("os.ReadFile: %w", err)
 		}
 		ddl = string(ddlBytes)
-	case osz.Exists(arg): // NOTE: expect ddlctl generate format
+	case osz.Exists(arg), strings.HasSuffix(arg, "..."): // NOTE: expect ddlctl generate format, or Go package pattern such as "./models/..."
 		b := new(strings.Builder)
 		if err := generate.Generate(ctx, b, arg, dialect, language); err != nil {
 			return "", apperr.Errorf("Generate: %w", err)
@@ -72,18 +95,264 @@ func resolve(ctx context.Context, language, dialect, arg string) (ddl string, er
 	return ddl, nil
 }
 
-//nolint:cyclop,funlen,gocognit
-func Diff(ctx context.Context, out io.Writer, dialect, language, src string, dst string) error {
-	srcDDL, err := resolve(ctx, language, dialect, src)
+// isSourceFile reports whether the file is a source file of the language, such as a Go file, which is a source for `ddlctl generate` rather than a SQL file.
+func isSourceFile(language, path string) bool {
+	switch language {
+	case ddlctlgo.Language:
+		return strings.HasSuffix(path, ".go")
+	case ddlctlproto.Language:
+		return strings.HasSuffix(path, ".proto")
+	default:
+		return false
+	}
+}
+
+// isSQLite3DatabaseFile reports whether the file starts with the SQLite3 database header.
+//
+// MEMO: https://www.sqlite.org/fileformat.html#the_database_header
+func isSQLite3DatabaseFile(path string) bool {
+	const header = "SQLite format 3\x00"
+
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	buf := make([]byte, len(header))
+	if _, err := io.ReadFull(f, buf); err != nil {
+		return false
+	}
+
+	return string(buf) == header
+}
+
+// RenameHints returns the rename hints of --rename, which is the content of the file if it is a file.
+func RenameHints() (string, error) {
+	hints := config.Rename()
+	if osz.IsFile(hints) { // NOTE: expect rename hints file
+		b, err := os.ReadFile(hints)
+		if err != nil {
+			return "", apperr.Errorf("os.ReadFile: %w", err)
+		}
+		hints = string(b)
+	}
+	return hints, nil
+}
+
+// loadRenames returns the rename hints declared by the --rename option and by the comments in the after DDL.
+func loadRenames(dstDDL string) (ddl.Renames, error) {
+	hints, err := RenameHints()
+	if err != nil {
+		return nil, apperr.Errorf("RenameHints: %w", err)
+	}
+
+	renames, err := ddl.ParseRenames(hints)
+	if err != nil {
+		return nil, apperr.Errorf("ddl.ParseRenames: %w", err)
+	}
+
+	extracted, err := ddl.ExtractRenames(dstDDL)
+	if err != nil {
+		return nil, apperr.Errorf("ddl.ExtractRenames: %w", err)
+	}
+
+	return append(renames, extracted...), nil
+}
+
+// newIsTargetTable returns the function that reports whether the table is the target of diff.
+// The history table and the tables filtered out by --include-table and --exclude-table are not the target.
+func newIsTargetTable() (func(schema, table string) bool, error) {
+	tableFilter, err := ddl.NewTableFilter(config.IncludeTable(), config.ExcludeTable())
+	if err != nil {
+		return nil, apperr.Errorf("ddl.NewTableFilter: %w", err)
+	}
+
+	return func(schema, table string) bool {
+		return table != history.TableName && tableFilter.Keep(schema, table)
+	}, nil
+}
+
+// isTargetSchema reports whether the schema is the target of diff by --schema and --exclude-schema.
+// The objects that are not qualified by a schema are regarded as in the public schema.
+func isTargetSchema(schema string) bool {
+	if schema == "" {
+		schema = "public"
+	}
+	if schemas := config.Schema(); len(schemas) > 0 && !slices.Contains(schemas, schema) {
+		return false
+	}
+	return !slices.Contains(config.ExcludeSchema(), schema)
+}
+
+// Diff writes the difference from src to dst in the format of --output, and returns the statements of it.
+// The down statements to roll back the difference are written to --down-output, so that out has only the up statements.
+//
+//nolint:cyclop
+func Diff(ctx context.Context, out io.Writer, dialect, language, src string, dst string) ([]*ddl.Statement, error) {
+	downOutput := config.DownOutput()
+	withDown := config.WithDown() || downOutput != ""
+	if withDown && downOutput == "" && config.Output() != config.OutputJSON {
+		// MEMO: The down statements are not written to out after the up statements, so that out can be applied as it is.
+		return nil, apperr.Errorf("--%s: %w", consts.OptionDownOutput, apperr.ErrDownOutputRequired)
+	}
+
+	srcDDL, err := Resolve(ctx, language, dialect, src)
+	if err != nil {
+		return nil, apperr.Errorf("Resolve: %w", err)
+	}
+
+	dstDDL, err := Resolve(ctx, language, dialect, dst)
+	if err != nil {
+		return nil, apperr.Errorf("Resolve: %w", err)
+	}
+
+	stmts, err := DiffStatements(dialect, srcDDL, dstDDL)
+	if err != nil {
+		if !errors.Is(err, ddl.ErrNoDifference) {
+			return nil, apperr.Errorf("DiffStatements: %w", err)
+		}
+		stmts = make([]*ddl.Statement, 0)
+	}
+
+	down := make([]*ddl.Statement, 0)
+	if withDown && len(stmts) > 0 {
+		down, err = DiffDownStatements(dialect, srcDDL, dstDDL, stmts)
+		if err != nil && !errors.Is(err, ddl.ErrNoDifference) {
+			return nil, apperr.Errorf("DiffDownStatements: %w", err)
+		}
+	}
+
+	switch config.Output() {
+	case config.OutputJSON:
+		// MEMO: No difference is written as the empty statements so that the machines do not have to handle the empty output.
+		if err := util.WriteJSON(out, &Output{Statements: stmts, DownStatements: down}); err != nil {
+			return nil, apperr.Errorf("util.WriteJSON: %w", err)
+		}
+	default:
+		if err := writeStatements(out, stmts); err != nil {
+			return nil, apperr.Errorf("writeStatements: %w", err)
+		}
+	}
+
+	if downOutput != "" {
+		// NOTE: The file is written even if there is no difference, so that the down DDL of the previous run does not remain.
+		b := new(strings.Builder)
+		if err := writeStatements(b, down); err != nil {
+			return nil, apperr.Errorf("writeStatements: %w", err)
+		}
+		const rw_r__r__ = 0o644 //nolint:revive,stylecheck
+		if err := os.WriteFile(downOutput, []byte(b.String()), rw_r__r__); err != nil {
+			return nil, apperr.Errorf("os.WriteFile: %w", err)
+		}
+	}
+
+	if len(stmts) == 0 {
+		return nil, apperr.Errorf("Diff: %w", ddl.ErrNoDifference)
+	}
+
+	return stmts, nil
+}
+
+func writeStatements(out io.Writer, stmts []*ddl.Statement) error {
+	for _, stmt := range stmts {
+		if _, err := io.WriteString(out, stmt.String()); err != nil {
+			return apperr.Errorf("io.WriteString: %w", err)
+		}
+	}
+	return nil
+}
+
+// Summarize returns the concise summary of the drifted objects and the statements to converge them.
+func Summarize(stmts []*ddl.Statement) string {
+	objects := make([]string, 0)
+	kinds := make(map[string][]string)
+	classes := make(map[ddl.ChangeClass]int)
+	for _, stmt := range stmts {
+		if _, ok := kinds[stmt.Object]; !ok {
+			objects = append(objects, stmt.Object)
+		}
+		kinds[stmt.Object] = append(kinds[stmt.Object], stmt.Kind)
+		classes[stmt.Class]++
+	}
+
+	summary := fmt.Sprintf("Drift: %d objects, %d statements (%d safe, %d locking, %d data-loss).\n",
+		len(objects), len(stmts), classes[ddl.ChangeClassSafe], classes[ddl.ChangeClassLocking], classes[ddl.ChangeClassDataLoss])
+	for _, object := range objects {
+		summary += "  " + object + ": " + strings.Join(kinds[object], ", ") + "\n"
+	}
+
+	return summary
+}
+
+// Output is the document written by `ddlctl diff --output json`.
+//
+//nolint:tagliatelle
+type Output struct {
+	Statements []*ddl.Statement `json:"statements"`
+	// DownStatements is the statements to roll back Statements, which are written only with --with-down.
+	DownStatements []*ddl.Statement `json:"down_statements,omitempty"`
+}
+
+// DiffDDL writes the difference from srcDDL to dstDDL, which are already resolved to DDL.
+func DiffDDL(out io.Writer, dialect, srcDDL, dstDDL string) error {
+	stmts, err := DiffStatements(dialect, srcDDL, dstDDL)
+	if err != nil {
+		return apperr.Errorf("DiffStatements: %w", err)
+	}
+
+	if err := writeStatements(out, stmts); err != nil {
+		return apperr.Errorf("writeStatements: %w", err)
+	}
+
+	return nil
+}
+
+// DiffStatements returns the difference from srcDDL to dstDDL as the statements, each of which is classified.
+func DiffStatements(dialect, srcDDL, dstDDL string) ([]*ddl.Statement, error) {
+	renames, err := loadRenames(dstDDL)
 	if err != nil {
-		return apperr.Errorf("resolve: %w", err)
+		return nil, apperr.Errorf("loadRenames: %w", err)
 	}
 
-	dstDDL, err := resolve(ctx, language, dialect, dst)
+	isTargetTable, err := newIsTargetTable()
 	if err != nil {
-		return apperr.Errorf("resolve: %w", err)
+		return nil, apperr.Errorf("newIsTargetTable: %w", err)
 	}
 
+	return diffStatements(dialect, srcDDL, dstDDL, renames, config.RenameHeuristic(), isTargetTable, isTargetSchema)
+}
+
+// DiffStatementsUnfiltered returns the difference from srcDDL to dstDDL without the rename hints and the filters of the options,
+// such as to bring a database to dstDDL as it is.
+func DiffStatementsUnfiltered(dialect, srcDDL, dstDDL string) ([]*ddl.Statement, error) {
+	return diffStatements(dialect, srcDDL, dstDDL, nil, false, func(_, _ string) bool { return true }, func(_ string) bool { return true })
+}
+
+// DiffDownStatements returns the statements to roll back the difference from srcDDL to dstDDL, that is the difference from dstDDL to srcDDL.
+// The down statements that reverse the data-loss statements of up are marked irreversible.
+func DiffDownStatements(dialect, srcDDL, dstDDL string, up []*ddl.Statement) ([]*ddl.Statement, error) {
+	renames, err := loadRenames(dstDDL)
+	if err != nil {
+		return nil, apperr.Errorf("loadRenames: %w", err)
+	}
+
+	isTargetTable, err := newIsTargetTable()
+	if err != nil {
+		return nil, apperr.Errorf("newIsTargetTable: %w", err)
+	}
+
+	down, err := diffStatements(dialect, dstDDL, srcDDL, renames.Reverse(), config.RenameHeuristic(), isTargetTable, isTargetSchema)
+	if err != nil {
+		return nil, apperr.Errorf("diffStatements: %w", err)
+	}
+	ddl.MarkIrreversible(up, down)
+
+	return down, nil
+}
+
+//nolint:cyclop,funlen,gocognit
+func diffStatements(dialect, srcDDL, dstDDL string, renames ddl.Renames, renameHeuristic bool, isTargetTable func(schema, table string) bool, isTargetSchema func(schema string) bool) ([]*ddl.Statement, error) {
 	logs.Trace.Printf("srcDDL: %q", srcDDL)
 	logs.Trace.Printf("dstDDL: %q", dstDDL)
 
@@ -91,86 +360,96 @@ func Diff(ctx context.Context, out io.Writer, dialect, language, src string, dst
 	case ddlmysql.Dialect:
 		leftDDL, err := ddlmysql.NewParser(ddlmysql.NewLexer(srcDDL)).Parse()
 		if err != nil {
-			return apperr.Errorf("myddl.NewParser: %w", err)
+			return nil, apperr.Errorf("myddl.NewParser: %w", err)
 		}
+		leftDDL = leftDDL.FilterTables(isTargetTable)
 		rightDDL, err := ddlmysql.NewParser(ddlmysql.NewLexer(dstDDL)).Parse()
 		if err != nil {
-			return apperr.Errorf("myddl.NewParser: %w", err)
+			return nil, apperr.Errorf("myddl.NewParser: %w", err)
 		}
+		rightDDL = rightDDL.FilterTables(isTargetTable)
 
-		result, err := ddlmysql.Diff(leftDDL, rightDDL)
+		result, err := ddlmysql.Diff(leftDDL, rightDDL, ddlmysql.DiffRenames(renames), ddlmysql.DiffRenameHeuristic(renameHeuristic))
 		if err != nil {
-			return apperr.Errorf("myddl.Diff: %w", err)
+			return nil, apperr.Errorf("myddl.Diff: %w", err)
 		}
 
-		if _, err := io.WriteString(out, result.String()); err != nil {
-			return apperr.Errorf("io.WriteString: %w", err)
-		}
-
-		return nil
+		return ddl.NewStatements(result.Stmts, ddlmysql.ClassifyStmt), nil
 	case ddlpg.Dialect:
 		leftDDL, err := ddlpg.NewParser(ddlpg.NewLexer(srcDDL)).Parse()
 		if err != nil {
-			return apperr.Errorf("pgddl.NewParser: %w", err)
+			return nil, apperr.Errorf("pgddl.NewParser: %w", err)
 		}
+		leftDDL = leftDDL.FilterTables(isTargetTable).FilterSchemas(isTargetSchema)
 		rightDDL, err := ddlpg.NewParser(ddlpg.NewLexer(dstDDL)).Parse()
 		if err != nil {
-			return apperr.Errorf("pgddl.NewParser: %w", err)
+			return nil, apperr.Errorf("pgddl.NewParser: %w", err)
 		}
+		rightDDL = rightDDL.FilterTables(isTargetTable).FilterSchemas(isTargetSchema)
 
-		result, err := ddlpg.Diff(leftDDL, rightDDL)
+		result, err := ddlpg.Diff(leftDDL, rightDDL, ddlpg.DiffRenames(renames), ddlpg.DiffRenameHeuristic(renameHeuristic))
 		if err != nil {
-			return apperr.Errorf("pgddl.Diff: %w", err)
-		}
-
-		if _, err := io.WriteString(out, result.String()); err != nil {
-			return apperr.Errorf("io.WriteString: %w", err)
+			return nil, apperr.Errorf("pgddl.Diff: %w", err)
 		}
 
-		return nil
+		return ddl.NewStatements(result.Stmts, ddlpg.ClassifyStmt), nil
 	case ddlcrdb.Dialect:
 		leftDDL, err := ddlcrdb.NewParser(ddlcrdb.NewLexer(srcDDL)).Parse()
 		if err != nil {
-			return apperr.Errorf("pgddl.NewParser: %w", err)
+			return nil, apperr.Errorf("pgddl.NewParser: %w", err)
 		}
+		leftDDL = leftDDL.FilterTables(isTargetTable).FilterSchemas(isTargetSchema)
 		rightDDL, err := ddlcrdb.NewParser(ddlcrdb.NewLexer(dstDDL)).Parse()
 		if err != nil {
-			return apperr.Errorf("pgddl.NewParser: %w", err)
+			return nil, apperr.Errorf("pgddl.NewParser: %w", err)
 		}
+		rightDDL = rightDDL.FilterTables(isTargetTable).FilterSchemas(isTargetSchema)
 
-		result, err := ddlcrdb.Diff(leftDDL, rightDDL)
+		result, err := ddlcrdb.Diff(leftDDL, rightDDL, ddlcrdb.DiffRenames(renames), ddlcrdb.DiffRenameHeuristic(renameHeuristic))
 		if err != nil {
-			return apperr.Errorf("pgddl.Diff: %w", err)
-		}
-
-		if _, err := io.WriteString(out, result.String()); err != nil {
-			return apperr.Errorf("io.WriteString: %w", err)
+			return nil, apperr.Errorf("pgddl.Diff: %w", err)
 		}
 
-		return nil
+		return ddl.NewStatements(result.Stmts, ddlcrdb.ClassifyStmt), nil
 	case ddlspanner.Dialect:
 		leftDDL, err := ddlspanner.NewParser(ddlspanner.NewLexer(srcDDL)).Parse()
 		if err != nil {
-			return apperr.Errorf("spanddl.NewParser: %w", err)
+			return nil, apperr.Errorf("spanddl.NewParser: %w", err)
 		}
+		leftDDL = leftDDL.FilterTables(isTargetTable)
 		rightDDL, err := ddlspanner.NewParser(ddlspanner.NewLexer(dstDDL)).Parse()
 		if err != nil {
-			return apperr.Errorf("spanddl.NewParser: %w", err)
+			return nil, apperr.Errorf("spanddl.NewParser: %w", err)
 		}
+		rightDDL = rightDDL.FilterTables(isTargetTable)
 
-		result, err := ddlspanner.Diff(leftDDL, rightDDL)
+		result, err := ddlspanner.Diff(leftDDL, rightDDL, ddlspanner.DiffRenames(renames)) // MEMO: Spanner does not support RENAME COLUMN, so the heuristic is not applied.
 		if err != nil {
-			return apperr.Errorf("spanddl.Diff: %w", err)
+			return nil, apperr.Errorf("spanddl.Diff: %w", err)
 		}
 
-		if _, err := io.WriteString(out, result.String()); err != nil {
-			return apperr.Errorf("io.WriteString: %w", err)
+		return ddl.NewStatements(result.Stmts, ddlspanner.ClassifyStmt), nil
+	case ddlsqlite3.Dialect:
+		leftDDL, err := ddlsqlite3.NewParser(ddlsqlite3.NewLexer(srcDDL)).Parse()
+		if err != nil {
+			return nil, apperr.Errorf("sqlite3ddl.NewParser: %w", err)
+		}
+		leftDDL = leftDDL.FilterTables(isTargetTable)
+		rightDDL, err := ddlsqlite3.NewParser(ddlsqlite3.NewLexer(dstDDL)).Parse()
+		if err != nil {
+			return nil, apperr.Errorf("sqlite3ddl.NewParser: %w", err)
+		}
+		rightDDL = rightDDL.FilterTables(isTargetTable)
+
+		result, err := ddlsqlite3.Diff(leftDDL, rightDDL, ddlsqlite3.DiffRenames(renames), ddlsqlite3.DiffRenameHeuristic(renameHeuristic))
+		if err != nil {
+			return nil, apperr.Errorf("sqlite3ddl.Diff: %w", err)
 		}
 
-		return nil
+		return ddl.NewStatements(result.Stmts, ddlsqlite3.ClassifyStmt), nil
 	case "":
-		return apperr.Errorf("dialect=%s: %w", dialect, apperr.ErrDialectIsEmpty)
+		return nil, apperr.Errorf("dialect=%s: %w", dialect, apperr.ErrDialectIsEmpty)
 	default:
-		return apperr.Errorf("dialect=%s: %w", dialect, apperr.ErrNotSupported)
+		return nil, apperr.Errorf("dialect=%s: %w", dialect, apperr.ErrNotSupported)
 	}
 }
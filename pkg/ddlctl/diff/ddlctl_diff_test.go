@@ -0,0 +1,201 @@
+package diff
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	ddlpg "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+	"github.com/kunitsucom/ddlctl/pkg/internal/fixture"
+	"github.com/kunitsucom/ddlctl/pkg/internal/util"
+)
+
+//nolint:paralleltest
+func TestDiffDDL(t *testing.T) {
+	cmd := fixture.Cmd()
+	cmd.Options = append(cmd.Options, &cliz.StringOption{
+		Name:    consts.OptionExcludeTable,
+		Default: cliz.Default(""),
+	})
+	_, err := cmd.Parse([]string{"--exclude-table=schema_migrations,/^goose_/"})
+	require.NoError(t, err)
+	rollback := config.MustLoad(cliz.WithContext(context.Background(), cmd))
+	t.Cleanup(rollback)
+
+	t.Run("success,exclude-table", func(t *testing.T) {
+		const (
+			srcDDL = `CREATE TABLE public.users (id BIGINT NOT NULL);
+CREATE TABLE public.schema_migrations (version BIGINT NOT NULL);
+CREATE TABLE public.goose_db_version (id BIGINT NOT NULL);
+`
+			dstDDL = `CREATE TABLE public.users (id BIGINT NOT NULL, name TEXT NOT NULL);
+`
+		)
+
+		out := new(strings.Builder)
+		require.NoError(t, DiffDDL(out, ddlpg.Dialect, srcDDL, dstDDL))
+
+		expected := `-- ddlctl:class safe
+-- -
+-- +name TEXT NOT NULL
+ALTER TABLE public.users ADD COLUMN name TEXT NOT NULL;
+`
+		assert.Equal(t, expected, out.String())
+	})
+	t.Run("success,DiffStatements", func(t *testing.T) {
+		const (
+			srcDDL = `CREATE TABLE public.users (id BIGINT NOT NULL, age INT);
+`
+			dstDDL = `CREATE TABLE public.users (id BIGINT NOT NULL, age BIGINT);
+`
+		)
+
+		actual, err := DiffStatements(ddlpg.Dialect, srcDDL, dstDDL)
+		require.NoError(t, err)
+
+		out := new(strings.Builder)
+		require.NoError(t, util.WriteJSON(out, &Output{Statements: actual}))
+
+		expected := `{
+  "statements": [
+    {
+      "kind": "alter_column_set_data_type",
+      "object": "public.users",
+      "class": "locking",
+      "before": "age INT",
+      "after": "age BIGINT",
+      "sql": "ALTER TABLE public.users ALTER COLUMN age SET DATA TYPE BIGINT;"
+    }
+  ]
+}
+`
+		assert.Equal(t, expected, out.String())
+	})
+
+	t.Run("failure,ErrNoDifference", func(t *testing.T) {
+		_, err := DiffStatements(ddlpg.Dialect, "CREATE TABLE users (id BIGINT);\n", "CREATE TABLE users (id BIGINT);\n")
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+	})
+	t.Run("success,DiffDownStatements", func(t *testing.T) {
+		const (
+			srcDDL = `CREATE TABLE public.users (id BIGINT NOT NULL, name TEXT NOT NULL);
+`
+			dstDDL = `-- ddlctl:rename users=members
+CREATE TABLE public.members (id BIGINT NOT NULL);
+`
+		)
+
+		up, err := DiffStatements(ddlpg.Dialect, srcDDL, dstDDL)
+		require.NoError(t, err)
+
+		down, err := DiffDownStatements(ddlpg.Dialect, srcDDL, dstDDL, up)
+		require.NoError(t, err)
+
+		out := new(strings.Builder)
+		require.NoError(t, writeStatements(out, down))
+
+		expected := `-- ddlctl:class safe
+-- -public.members
+-- +public.users
+ALTER TABLE public.members RENAME TO users;
+-- ddlctl:class safe
+-- ddlctl:irreversible
+-- -
+-- +name TEXT NOT NULL
+ALTER TABLE public.users ADD COLUMN name TEXT NOT NULL;
+`
+		assert.Equal(t, expected, out.String())
+	})
+}
+
+//nolint:paralleltest
+func TestDiff(t *testing.T) {
+	const (
+		srcDDL = `CREATE TABLE public.users (id BIGINT NOT NULL, name TEXT NOT NULL);
+`
+		dstDDL = `CREATE TABLE public.users (id BIGINT NOT NULL);
+`
+	)
+	dir := t.TempDir()
+	src, dst := filepath.Join(dir, "before.sql"), filepath.Join(dir, "after.sql")
+	require.NoError(t, os.WriteFile(src, []byte(srcDDL), 0o600))
+	require.NoError(t, os.WriteFile(dst, []byte(dstDDL), 0o600))
+
+	load := func(t *testing.T, args ...string) context.Context {
+		t.Helper()
+		cmd := fixture.Cmd()
+		cmd.Options = append(cmd.Options,
+			&cliz.BoolOption{Name: consts.OptionWithDown, Default: cliz.Default(false)},
+			&cliz.StringOption{Name: consts.OptionDownOutput, Default: cliz.Default("")},
+		)
+		_, err := cmd.Parse(append([]string{"ddlctl"}, args...))
+		require.NoError(t, err)
+		ctx := cliz.WithContext(context.Background(), cmd)
+		rollback := config.MustLoad(ctx)
+		t.Cleanup(rollback)
+		return ctx
+	}
+
+	t.Run("success,down-output", func(t *testing.T) {
+		downOutput := filepath.Join(dir, "down.sql")
+		ctx := load(t, "--down-output="+downOutput)
+
+		out := new(strings.Builder)
+		_, err := Diff(ctx, out, ddlpg.Dialect, "", src, dst)
+		require.NoError(t, err)
+
+		assert.Equal(t, `-- ddlctl:class data-loss
+-- -name TEXT NOT NULL
+-- +
+ALTER TABLE public.users DROP COLUMN name;
+`, out.String())
+
+		down, err := os.ReadFile(downOutput)
+		require.NoError(t, err)
+		assert.Equal(t, `-- ddlctl:class safe
+-- ddlctl:irreversible
+-- -
+-- +name TEXT NOT NULL
+ALTER TABLE public.users ADD COLUMN name TEXT NOT NULL;
+`, string(down))
+	})
+
+	t.Run("failure,ErrDownOutputRequired", func(t *testing.T) {
+		ctx := load(t, "--with-down")
+
+		out := new(strings.Builder)
+		_, err := Diff(ctx, out, ddlpg.Dialect, "", src, dst)
+		require.ErrorIs(t, err, apperr.ErrDownOutputRequired)
+		assert.Equal(t, "", out.String())
+	})
+}
+
+func TestSummarize(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		actual := Summarize([]*ddl.Statement{
+			{Kind: "create_table", Object: "posts", Class: ddl.ChangeClassSafe},
+			{Kind: "alter_column_set_data_type", Object: "users", Class: ddl.ChangeClassLocking},
+			{Kind: "drop_column", Object: "users", Class: ddl.ChangeClassDataLoss},
+		})
+
+		expected := `Drift: 2 objects, 3 statements (1 safe, 1 locking, 1 data-loss).
+  posts: create_table
+  users: alter_column_set_data_type, drop_column
+`
+		assert.Equal(t, expected, actual)
+	})
+}
@@ -13,7 +13,9 @@ import (
 	"github.com/kunitsucom/ddlctl/pkg/internal/generator/dialect/mysql"
 	"github.com/kunitsucom/ddlctl/pkg/internal/generator/dialect/postgres"
 	"github.com/kunitsucom/ddlctl/pkg/internal/generator/dialect/spanner"
+	"github.com/kunitsucom/ddlctl/pkg/internal/generator/dialect/sqlite3"
 	ddlctlgo "github.com/kunitsucom/ddlctl/pkg/internal/lang/go"
+	ddlctlproto "github.com/kunitsucom/ddlctl/pkg/internal/lang/proto"
 	"github.com/kunitsucom/ddlctl/pkg/logs"
 )
 
@@ -68,6 +70,12 @@ func Parse(ctx context.Context, language string, src string) (*generator.DDL, er
 			return nil, apperr.Errorf("ddlctlgo.Parse: %w", err)
 		}
 		return ddl, nil
+	case ddlctlproto.Language:
+		ddl, err := ddlctlproto.Parse(ctx, src)
+		if err != nil {
+			return nil, apperr.Errorf("ddlctlproto.Parse: %w", err)
+		}
+		return ddl, nil
 	default:
 		return nil, apperr.Errorf("language=%s: %w", language, apperr.ErrNotSupported)
 	}
@@ -90,6 +98,11 @@ func Fprint(w io.Writer, dialect string, ddl *generator.DDL) error {
 			return apperr.Errorf("mysql.Fprint: %w", err)
 		}
 		return nil
+	case sqlite3.Dialect:
+		if err := sqlite3.Fprint(w, ddl); err != nil {
+			return apperr.Errorf("sqlite3.Fprint: %w", err)
+		}
+		return nil
 	case "":
 		return apperr.Errorf("dialect=%s: %w", dialect, apperr.ErrDialectIsEmpty)
 	default:
@@ -0,0 +1,50 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+)
+
+func Command(ctx context.Context, args []string) error {
+	if _, err := config.Load(ctx); err != nil {
+		return apperr.Errorf("config.Load: %w", err)
+	}
+
+	if len(args) != 1 {
+		return apperr.Errorf("args=%v: %w", args, apperr.ErrOneArgumentRequired)
+	}
+
+	records, err := List(ctx, config.Dialect(), args[0])
+	if err != nil {
+		return apperr.Errorf("List: %w", err)
+	}
+
+	if err := Write(os.Stdout, records); err != nil {
+		return apperr.Errorf("Write: %w", err)
+	}
+
+	return nil
+}
+
+// Write writes the records as SQL comments followed by the applied DDL.
+func Write(w io.Writer, records []*Record) error {
+	for _, r := range records {
+		if _, err := fmt.Fprintf(w, `-- applied_at: %s
+-- applied_by: %s
+-- ddlctl_version: %s
+-- ddl_hash: %s
+-- duration: %s
+-- outcome: %s
+%s
+`, r.AppliedAt.UTC().Format(time.RFC3339Nano), r.AppliedBy, r.DDLCtlVersion, r.DDLHash, r.Duration, r.Outcome(), r.DDL); err != nil {
+			return apperr.Errorf("fmt.Fprintf: %w", err)
+		}
+	}
+	return nil
+}
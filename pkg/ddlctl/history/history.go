@@ -0,0 +1,277 @@
+package history
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"os/user"
+	"time"
+
+	sqlz "github.com/kunitsucom/util.go/database/sql"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	ddlcrdb "github.com/kunitsucom/ddlctl/pkg/ddl/cockroachdb"
+	ddlmysql "github.com/kunitsucom/ddlctl/pkg/ddl/mysql"
+	ddlpg "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
+	ddlspanner "github.com/kunitsucom/ddlctl/pkg/ddl/spanner"
+	ddlsqlite3 "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
+)
+
+// TableName is the name of the table that records the history of `ddlctl apply`.
+// The table is not the target of `ddlctl diff`.
+const TableName = "ddlctl_schema_history"
+
+// Record is the record of `ddlctl apply`.
+type Record struct {
+	AppliedAt     time.Time
+	AppliedBy     string
+	DDLCtlVersion string
+	DDLHash       string
+	DDL           string
+	Duration      time.Duration
+	Success       bool
+	ErrorMessage  string
+}
+
+// NewRecord returns the record of ddlStr applied at appliedAt, which took duration and resulted in applyErr.
+func NewRecord(appliedAt time.Time, ddlctlVersion, ddlStr string, duration time.Duration, applyErr error) *Record {
+	r := &Record{
+		AppliedAt:     appliedAt.UTC(),
+		DDLCtlVersion: ddlctlVersion,
+		DDLHash:       Hash(ddlStr),
+		DDL:           ddlStr,
+		Duration:      duration,
+		Success:       applyErr == nil,
+	}
+	if u, err := user.Current(); err == nil {
+		r.AppliedBy = u.Username
+	}
+	if applyErr != nil {
+		r.ErrorMessage = applyErr.Error()
+	}
+	return r
+}
+
+// Hash returns the hash of the applied DDL.
+func Hash(ddlStr string) string {
+	sum := sha256.Sum256([]byte(ddlStr))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// Outcome returns "success", or "failure" with the error message.
+func (r *Record) Outcome() string {
+	if r.Success {
+		return "success"
+	}
+	return "failure: " + r.ErrorMessage
+}
+
+//nolint:gochecknoglobals
+var createTableQueries = map[string]string{
+	ddlpg.Dialect: `CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+    applied_at     TIMESTAMP WITH TIME ZONE NOT NULL,
+    applied_by     TEXT NOT NULL,
+    ddlctl_version TEXT NOT NULL,
+    ddl_hash       TEXT NOT NULL,
+    ddl            TEXT NOT NULL,
+    duration_ms    BIGINT NOT NULL,
+    success        BOOLEAN NOT NULL,
+    error_message  TEXT NOT NULL,
+    PRIMARY KEY (applied_at, ddl_hash)
+);`,
+	ddlcrdb.Dialect: `CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+    applied_at     TIMESTAMPTZ NOT NULL,
+    applied_by     STRING NOT NULL,
+    ddlctl_version STRING NOT NULL,
+    ddl_hash       STRING NOT NULL,
+    ddl            STRING NOT NULL,
+    duration_ms    INT8 NOT NULL,
+    success        BOOL NOT NULL,
+    error_message  STRING NOT NULL,
+    PRIMARY KEY (applied_at, ddl_hash)
+);`,
+	ddlmysql.Dialect: `CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+    applied_at     DATETIME(6) NOT NULL,
+    applied_by     VARCHAR(255) NOT NULL,
+    ddlctl_version VARCHAR(255) NOT NULL,
+    ddl_hash       VARCHAR(255) NOT NULL,
+    ddl            LONGTEXT NOT NULL,
+    duration_ms    BIGINT NOT NULL,
+    success        BOOLEAN NOT NULL,
+    error_message  TEXT NOT NULL,
+    PRIMARY KEY (applied_at, ddl_hash)
+);`,
+	ddlspanner.Dialect: `CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+    applied_at     TIMESTAMP NOT NULL,
+    applied_by     STRING(MAX) NOT NULL,
+    ddlctl_version STRING(MAX) NOT NULL,
+    ddl_hash       STRING(MAX) NOT NULL,
+    ddl            STRING(MAX) NOT NULL,
+    duration_ms    INT64 NOT NULL,
+    success        BOOL NOT NULL,
+    error_message  STRING(MAX) NOT NULL,
+) PRIMARY KEY (applied_at, ddl_hash)`,
+	ddlsqlite3.Dialect: `CREATE TABLE IF NOT EXISTS ` + TableName + ` (
+    applied_at     DATETIME NOT NULL,
+    applied_by     TEXT NOT NULL,
+    ddlctl_version TEXT NOT NULL,
+    ddl_hash       TEXT NOT NULL,
+    ddl            TEXT NOT NULL,
+    duration_ms    INTEGER NOT NULL,
+    success        BOOLEAN NOT NULL,
+    error_message  TEXT NOT NULL,
+    PRIMARY KEY (applied_at, ddl_hash)
+);`,
+}
+
+func insertQuery(dialect string) string {
+	const columns = `applied_at, applied_by, ddlctl_version, ddl_hash, ddl, duration_ms, success, error_message`
+	switch dialect {
+	case ddlpg.Dialect, ddlcrdb.Dialect:
+		return `INSERT INTO ` + TableName + ` (` + columns + `) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+	default:
+		return `INSERT INTO ` + TableName + ` (` + columns + `) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+	}
+}
+
+func selectQuery() string {
+	return `SELECT applied_at, applied_by, ddlctl_version, ddl_hash, ddl, duration_ms, success, error_message FROM ` + TableName + ` ORDER BY applied_at`
+}
+
+func open(ctx context.Context, dialect, dsn string) (*sql.DB, error) {
+	driverName := func() string {
+		switch dialect {
+		case ddlcrdb.Dialect:
+			return ddlcrdb.DriverName
+		default:
+			return dialect
+		}
+	}()
+
+	db, err := sqlz.OpenContext(ctx, driverName, dsn)
+	if err != nil {
+		return nil, apperr.Errorf("sqlz.OpenContext: %w", err)
+	}
+
+	return db, nil
+}
+
+// Save creates the history table if not exists, and inserts the record into it.
+func Save(ctx context.Context, dialect, dsn string, record *Record) (err error) {
+	createTableQuery, ok := createTableQueries[dialect]
+	if !ok {
+		return apperr.Errorf("dialect=%s: %w", dialect, apperr.ErrNotSupported)
+	}
+
+	db, err := open(ctx, dialect, dsn)
+	if err != nil {
+		return apperr.Errorf("open: %w", err)
+	}
+	defer func() {
+		if err2 := db.Close(); err == nil && err2 != nil {
+			err = apperr.Errorf("db.Close: %w", err2)
+		}
+	}()
+
+	if _, err := db.ExecContext(ctx, createTableQuery); err != nil {
+		return apperr.Errorf("db.ExecContext: q=%s: %w", createTableQuery, err)
+	}
+
+	q := insertQuery(dialect)
+	if _, err := db.ExecContext(ctx, q,
+		record.AppliedAt,
+		record.AppliedBy,
+		record.DDLCtlVersion,
+		record.DDLHash,
+		record.DDL,
+		record.Duration.Milliseconds(),
+		record.Success,
+		record.ErrorMessage,
+	); err != nil {
+		return apperr.Errorf("db.ExecContext: q=%s: %w", q, err)
+	}
+
+	return nil
+}
+
+// List returns the records in the history table ordered by the applied time.
+func List(ctx context.Context, dialect, dsn string) (records []*Record, err error) {
+	if _, ok := createTableQueries[dialect]; !ok {
+		return nil, apperr.Errorf("dialect=%s: %w", dialect, apperr.ErrNotSupported)
+	}
+
+	db, err := open(ctx, dialect, dsn)
+	if err != nil {
+		return nil, apperr.Errorf("open: %w", err)
+	}
+	defer func() {
+		if err2 := db.Close(); err == nil && err2 != nil {
+			err = apperr.Errorf("db.Close: %w", err2)
+		}
+	}()
+
+	q := selectQuery()
+	rows, err := db.QueryContext(ctx, q)
+	if err != nil {
+		return nil, apperr.Errorf("db.QueryContext: q=%s: %w", q, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			r          Record
+			appliedAt  timestamp
+			durationMS int64
+		)
+		if err := rows.Scan(&appliedAt, &r.AppliedBy, &r.DDLCtlVersion, &r.DDLHash, &r.DDL, &durationMS, &r.Success, &r.ErrorMessage); err != nil {
+			return nil, apperr.Errorf("rows.Scan: %w", err)
+		}
+		r.AppliedAt = appliedAt.Time
+		r.Duration = time.Duration(durationMS) * time.Millisecond
+		records = append(records, &r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperr.Errorf("rows.Err: %w", err)
+	}
+
+	return records, nil
+}
+
+// timestamp scans the timestamp column, which is returned as a string by some drivers,
+// such as MySQL without parseTime=true.
+type timestamp struct {
+	time.Time
+}
+
+//nolint:gochecknoglobals
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+func (t *timestamp) Scan(src any) error {
+	var s string
+	switch v := src.(type) {
+	case time.Time:
+		t.Time = v
+		return nil
+	case []byte:
+		s = string(v)
+	case string:
+		s = v
+	default:
+		return apperr.Errorf("src=%T: %w", src, apperr.ErrNotSupported)
+	}
+
+	for _, layout := range timestampLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			t.Time = parsed
+			return nil
+		}
+	}
+
+	return apperr.Errorf("timestamp=%s: %w", s, apperr.ErrNotSupported)
+}
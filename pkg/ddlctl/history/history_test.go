@@ -0,0 +1,81 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+	_ "github.com/mattn/go-sqlite3" //nolint:revive
+
+	ddlsqlite3 "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
+)
+
+func TestSaveAndList(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,sqlite3", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		dsn := filepath.Join(t.TempDir(), "history.db")
+		appliedAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		require.NoError(t, Save(ctx, ddlsqlite3.Dialect, dsn, NewRecord(appliedAt, "v0.0.1", "CREATE TABLE a (id INTEGER);\n", 3*time.Millisecond, nil)))
+		require.NoError(t, Save(ctx, ddlsqlite3.Dialect, dsn, NewRecord(appliedAt.Add(time.Second), "v0.0.1", "DROP TABLE b;\n", time.Millisecond, errors.New("no such table: b"))))
+
+		records, err := List(ctx, ddlsqlite3.Dialect, dsn)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(records))
+
+		assert.Equal(t, appliedAt, records[0].AppliedAt.UTC())
+		assert.Equal(t, "v0.0.1", records[0].DDLCtlVersion)
+		assert.Equal(t, Hash("CREATE TABLE a (id INTEGER);\n"), records[0].DDLHash)
+		assert.Equal(t, "CREATE TABLE a (id INTEGER);\n", records[0].DDL)
+		assert.Equal(t, 3*time.Millisecond, records[0].Duration)
+		assert.Equal(t, "success", records[0].Outcome())
+		assert.Equal(t, "failure: no such table: b", records[1].Outcome())
+
+		buf := new(strings.Builder)
+		require.NoError(t, Write(buf, records[:1]))
+		assert.Equal(t, `-- applied_at: 2024-01-02T03:04:05Z
+-- applied_by: `+records[0].AppliedBy+`
+-- ddlctl_version: v0.0.1
+-- ddl_hash: `+Hash("CREATE TABLE a (id INTEGER);\n")+`
+-- duration: 3ms
+-- outcome: success
+CREATE TABLE a (id INTEGER);
+
+`, buf.String())
+	})
+
+	t.Run("failure,ErrNotSupported", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := List(context.Background(), "unknown", "")
+		require.Error(t, err)
+	})
+}
+
+func Test_timestamp_Scan(t *testing.T) {
+	t.Parallel()
+
+	expected := time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	for _, src := range []any{
+		expected,
+		"2024-01-02T03:04:05.123456Z",
+		[]byte("2024-01-02 03:04:05.123456"),
+		"2024-01-02 03:04:05.123456+00:00",
+	} {
+		var actual timestamp
+		require.NoError(t, actual.Scan(src))
+		assert.Equal(t, true, expected.Equal(actual.Time))
+	}
+
+	var actual timestamp
+	require.Error(t, actual.Scan(1))
+}
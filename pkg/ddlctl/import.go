@@ -4,4 +4,5 @@ import (
 	_ "github.com/go-sql-driver/mysql"       //nolint:revive
 	_ "github.com/googleapis/go-sql-spanner" //nolint:revive
 	_ "github.com/lib/pq"                    //nolint:revive
+	_ "github.com/mattn/go-sqlite3"          //nolint:revive
 )
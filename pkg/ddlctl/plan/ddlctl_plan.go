@@ -0,0 +1,155 @@
+package plan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kunitsucom/util.go/version"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/diff"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/show"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/util"
+)
+
+func Command(ctx context.Context, args []string) (err error) {
+	if _, err := config.Load(ctx); err != nil {
+		return apperr.Errorf("config.Load: %w", err)
+	}
+
+	const dsnAndSourceAndPlanFile = 3
+	if len(args) != dsnAndSourceAndPlanFile {
+		return apperr.Errorf("args=%v: %w", args, apperr.ErrThreeArgumentsRequired)
+	}
+
+	dsn, src, planFile := args[0], args[1], args[2]
+
+	plan, err := Create(ctx, config.Dialect(), config.Language(), dsn, src)
+	if err != nil {
+		return apperr.Errorf("Create: %w", err)
+	}
+
+	f, err := os.Create(planFile)
+	if err != nil {
+		return apperr.Errorf("os.Create: %w", err)
+	}
+	defer func() {
+		if err2 := f.Close(); err == nil && err2 != nil {
+			err = apperr.Errorf("f.Close: %w", err2)
+		}
+	}()
+
+	if err := Write(f, plan); err != nil {
+		return apperr.Errorf("Write: %w", err)
+	}
+
+	if config.Output() == config.OutputJSON {
+		if err := util.WriteJSON(os.Stdout, &Output{
+			PlanFile:    planFile,
+			Dialect:     plan.Dialect,
+			Fingerprint: plan.Fingerprint,
+			Statements:  plan.Statements,
+		}); err != nil {
+			return apperr.Errorf("util.WriteJSON: %w", err)
+		}
+		return nil
+	}
+
+	if plan.DDL == "" {
+		_, _ = fmt.Fprintln(os.Stdout, ddl.ErrNoDifference.Error())
+	} else if _, err := os.Stdout.WriteString(Describe(plan.DDL)); err != nil {
+		return apperr.Errorf("os.Stdout.WriteString: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "\nSaved the plan to %s\nTo apply it, run `ddlctl apply --plan %s <DSN to apply>`\n", planFile, planFile)
+
+	return nil
+}
+
+// Output is the document written by `ddlctl plan --output json`.
+//
+//nolint:tagliatelle
+type Output struct {
+	PlanFile    string           `json:"plan_file"`
+	Dialect     string           `json:"dialect"`
+	Fingerprint string           `json:"fingerprint"`
+	Statements  []*ddl.Statement `json:"statements"`
+}
+
+// Create creates the plan to apply the DDL of src to dsn.
+func Create(ctx context.Context, dialect, language, dsn, src string) (*Plan, error) {
+	schema, err := show.Show(ctx, dialect, dsn)
+	if err != nil {
+		return nil, apperr.Errorf("show.Show: %w", err)
+	}
+
+	dstDDL, err := diff.Resolve(ctx, language, dialect, src)
+	if err != nil {
+		return nil, apperr.Errorf("diff.Resolve: %w", err)
+	}
+
+	stmts, err := diff.DiffStatements(dialect, schema, dstDDL)
+	if err != nil {
+		if !errors.Is(err, ddl.ErrNoDifference) {
+			return nil, apperr.Errorf("diff.DiffStatements: %w", err)
+		}
+		stmts = make([]*ddl.Statement, 0)
+	}
+
+	buf := new(strings.Builder)
+	for _, stmt := range stmts {
+		buf.WriteString(stmt.String())
+	}
+
+	filters := Filters{
+		Schema:        config.Schema(),
+		ExcludeSchema: config.ExcludeSchema(),
+		IncludeTable:  config.IncludeTable(),
+		ExcludeTable:  config.ExcludeTable(),
+	}
+	fingerprint, err := Fingerprint(dialect, schema, filters)
+	if err != nil {
+		return nil, apperr.Errorf("Fingerprint: %w", err)
+	}
+
+	renames, err := diff.RenameHints()
+	if err != nil {
+		return nil, apperr.Errorf("diff.RenameHints: %w", err)
+	}
+
+	return &Plan{
+		Version:         Version,
+		DDLCtlVersion:   version.Version(),
+		CreatedAt:       time.Now().UTC(),
+		Dialect:         dialect,
+		Fingerprint:     fingerprint,
+		Filters:         filters,
+		Renames:         renames,
+		RenameHeuristic: config.RenameHeuristic(),
+		DDL:             buf.String(),
+		DesiredDDL:      dstDDL,
+		Statements:      stmts,
+	}, nil
+}
+
+// Describe returns the description of the DDL queries to exec and the number of them for each class.
+func Describe(ddlStr string) string {
+	classes := ddl.ExtractChangeClasses(ddlStr)
+
+	return `
+ddlctl will exec the following DDL queries:
+
+-- 8< --
+
+` + ddlStr + `
+
+-- >8 --
+
+` + fmt.Sprintf("Plan: %d safe, %d locking, %d data-loss.\n", classes[ddl.ChangeClassSafe], classes[ddl.ChangeClassLocking], classes[ddl.ChangeClassDataLoss])
+}
@@ -0,0 +1,124 @@
+package plan
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/history"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/show"
+)
+
+// Version is the version of the plan file format.
+const Version = 2
+
+// Plan is the migration plan persisted by `ddlctl plan` and executed by `ddlctl apply --plan`.
+//
+//nolint:tagliatelle
+type Plan struct {
+	Version int `json:"version"`
+	// DDLCtlVersion is the version of ddlctl that created the plan.
+	DDLCtlVersion string    `json:"ddlctl_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	Dialect       string    `json:"dialect"`
+	// Fingerprint is the fingerprint of the schema that the plan is created from.
+	Fingerprint string `json:"fingerprint"`
+	// Filters is the filters of the schema that the plan is created with, which are also used by `ddlctl apply --plan`.
+	Filters Filters `json:"filters"`
+	// Renames and RenameHeuristic are the rename hints of --rename and --rename-heuristic that the plan is created with,
+	// which are used by `ddlctl apply --plan` to classify DDL again.
+	Renames         string `json:"renames,omitempty"`
+	RenameHeuristic bool   `json:"rename_heuristic,omitempty"`
+	// DDL is the DDL queries to apply. It is empty if there is no difference.
+	DDL string `json:"ddl"`
+	// DesiredDDL is the DDL of the source that the plan is created to, which is used to validate the plan on a shadow database.
+	DesiredDDL string `json:"desired_ddl,omitempty"`
+	// Statements is the statements of DDL for `ddlctl plan --output json`. It is not persisted, since DDL is the source of truth to apply.
+	Statements []*ddl.Statement `json:"-"`
+}
+
+// Filters is the options that filter the schemas and the tables, such as --schema and --exclude-table.
+//
+//nolint:tagliatelle
+type Filters struct {
+	Schema        []string `json:"schema,omitempty"`
+	ExcludeSchema []string `json:"exclude_schema,omitempty"`
+	IncludeTable  []string `json:"include_table,omitempty"`
+	ExcludeTable  []string `json:"exclude_table,omitempty"`
+}
+
+// Fingerprint returns the fingerprint of the schema shown by `ddlctl show`.
+// The statements are parsed and sorted, so that the fingerprint does not depend on the order and the comments of them,
+// and the tables filtered out by filters and the history table are not part of it.
+func Fingerprint(dialect, schema string, filters Filters) (string, error) {
+	tableFilter, err := ddl.NewTableFilter(filters.IncludeTable, filters.ExcludeTable)
+	if err != nil {
+		return "", apperr.Errorf("ddl.NewTableFilter: %w", err)
+	}
+
+	stmts, err := show.Statements(dialect, schema, func(schema, table string) bool {
+		return table != history.TableName && tableFilter.Keep(schema, table)
+	})
+	if err != nil {
+		return "", apperr.Errorf("show.Statements: %w", err)
+	}
+
+	sort.SliceStable(stmts, func(i, j int) bool {
+		if stmts[i].Object != stmts[j].Object {
+			return stmts[i].Object < stmts[j].Object
+		}
+		return stmts[i].SQL < stmts[j].SQL
+	})
+
+	canonical := new(strings.Builder)
+	for _, stmt := range stmts {
+		canonical.WriteString(stmt.SQL + "\n")
+	}
+
+	sum := sha256.Sum256([]byte(canonical.String()))
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+func Write(w io.Writer, plan *Plan) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(plan); err != nil {
+		return apperr.Errorf("enc.Encode: %w", err)
+	}
+	return nil
+}
+
+func Read(r io.Reader) (*Plan, error) {
+	plan := new(Plan)
+	if err := json.NewDecoder(r).Decode(plan); err != nil {
+		return nil, apperr.Errorf("json.Decode: %w", err)
+	}
+
+	if plan.Version != Version {
+		return nil, apperr.Errorf("version=%d: %w", plan.Version, apperr.ErrPlanVersionNotSupported)
+	}
+
+	return plan, nil
+}
+
+func ReadFile(path string) (*Plan, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, apperr.Errorf("os.Open: %w", err)
+	}
+	defer f.Close()
+
+	plan, err := Read(f)
+	if err != nil {
+		return nil, apperr.Errorf("Read: %w", err)
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,88 @@
+package plan
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	ddlpg "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
+)
+
+func TestPlan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		expected := &Plan{
+			Version:       Version,
+			DDLCtlVersion: "v0.0.1",
+			CreatedAt:     time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Dialect:       "postgres",
+			Fingerprint:   "sha256:0123456789abcdef",
+			Filters:       Filters{Schema: []string{"public"}, ExcludeTable: []string{"/^goose_/"}},
+			DDL:           "-- ddlctl:class safe\nALTER TABLE \"users\" ADD COLUMN \"name\" TEXT;\n",
+			DesiredDDL:    "CREATE TABLE \"users\" (\"id\" INTEGER, \"name\" TEXT);\n",
+		}
+
+		buf := new(bytes.Buffer)
+		require.NoError(t, Write(buf, expected))
+
+		actual, err := Read(buf)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("success,Fingerprint", func(t *testing.T) {
+		t.Parallel()
+
+		fingerprint := func(schema string, filters Filters) string {
+			t.Helper()
+			fingerprint, err := Fingerprint(ddlpg.Dialect, schema, filters)
+			require.NoError(t, err)
+			return fingerprint
+		}
+
+		const schema = `CREATE TABLE public.a (id INTEGER);
+CREATE INDEX a_idx_id ON public.a (id);
+CREATE INDEX a_idx_id2 ON public.a (id);
+CREATE TABLE public.b (id INTEGER);
+`
+		assert.Equal(t, fingerprint(schema, Filters{}), fingerprint(schema, Filters{}))
+		assert.Equal(t, fingerprint(schema, Filters{}), fingerprint(`-- the order of the indexes is not stable
+CREATE TABLE public.b (id INTEGER);
+CREATE TABLE public.a (id INTEGER);
+CREATE INDEX a_idx_id2 ON public.a (id);
+CREATE INDEX a_idx_id ON public.a (id);
+`, Filters{}))
+		assert.Equal(t, false, fingerprint(schema, Filters{}) == fingerprint(strings.ReplaceAll(schema, "b (id INTEGER)", "b (id BIGINT)"), Filters{}))
+		assert.Equal(t, fingerprint(schema, Filters{ExcludeTable: []string{"b"}}), fingerprint(strings.ReplaceAll(schema, "b (id INTEGER)", "b (id BIGINT)"), Filters{ExcludeTable: []string{"b"}}))
+	})
+
+	t.Run("failure,Fingerprint", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Fingerprint(ddlpg.Dialect, "CREATE TABLE", Filters{})
+		require.Error(t, err)
+	})
+
+	t.Run("failure,ErrPlanVersionNotSupported", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Read(strings.NewReader(`{"version":999,"dialect":"postgres"}`))
+		assert.Equal(t, true, errors.Is(err, apperr.ErrPlanVersionNotSupported))
+	})
+
+	t.Run("failure,json", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Read(strings.NewReader(`{`))
+		require.Error(t, err)
+	})
+}
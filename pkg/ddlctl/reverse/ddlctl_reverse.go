@@ -0,0 +1,107 @@
+package reverse
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/diff"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/history"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/show"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/logs"
+)
+
+// defaultPackageName is the package name of the generated Go source, if it cannot be derived from the destination directory.
+const defaultPackageName = "model"
+
+func Command(ctx context.Context, args []string) error {
+	if _, err := config.Load(ctx); err != nil {
+		return apperr.Errorf("config.Load: %w", err)
+	}
+
+	dialect := config.Dialect()
+	src := args[0]
+	dst := args[1]
+
+	logs.Info.Printf("dialect: %s", dialect)
+	logs.Info.Printf("source: %s", src)
+	logs.Info.Printf("destination: %s", dst)
+
+	if info, err := os.Stat(dst); err == nil && info.IsDir() {
+		dst = filepath.Join(dst, "ddlctl.gen.go")
+	}
+
+	ddlStr, err := diff.Resolve(ctx, config.Language(), dialect, src)
+	if err != nil {
+		return apperr.Errorf("diff.Resolve: %w", err)
+	}
+
+	tableFilter, err := ddl.NewTableFilter(config.IncludeTable(), config.ExcludeTable())
+	if err != nil {
+		return apperr.Errorf("ddl.NewTableFilter: %w", err)
+	}
+	ddlStr, err = show.FilterTables(dialect, ddlStr, func(schema, table string) bool {
+		// NOTE: The history table recorded by `ddlctl apply` is not a model.
+		return table != history.TableName && tableFilter.Keep(schema, table)
+	})
+	if err != nil {
+		return apperr.Errorf("show.FilterTables: %w", err)
+	}
+
+	goSrc, err := Reverse(dialect, packageNameOf(dst), ddlStr)
+	if err != nil {
+		return apperr.Errorf("Reverse: %w", err)
+	}
+
+	const rw_r__r__ = 0o644 //nolint:revive,stylecheck
+	if err := os.WriteFile(dst, goSrc, rw_r__r__); err != nil {
+		return apperr.Errorf("os.WriteFile: %w", err)
+	}
+
+	return nil
+}
+
+// Reverse returns the Go source that has the structs annotated for `ddlctl generate`, from which the same DDL as ddlStr is generated.
+// The statements other than CREATE TABLE and CREATE INDEX, such as CREATE VIEW, cannot be annotated, so an error that lists them is returned.
+func Reverse(dialect, packageName, ddlStr string) ([]byte, error) {
+	tables, err := parseTables(dialect, ddlStr)
+	if err != nil {
+		return nil, apperr.Errorf("parseTables: %w", err)
+	}
+
+	goSrc, err := fprintGo(dialect, packageName, tables)
+	if err != nil {
+		return nil, apperr.Errorf("fprintGo: %w", err)
+	}
+
+	return goSrc, nil
+}
+
+// packageNameOf returns the package name for the Go file, which is the name of the directory that has the file.
+func packageNameOf(filename string) string {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return defaultPackageName
+	}
+
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r == '-' || r == '.':
+			return '_'
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			return unicode.ToLower(r)
+		default:
+			return -1
+		}
+	}, filepath.Base(filepath.Dir(abs)))
+	if name == "" || !unicode.IsLetter([]rune(name)[0]) {
+		return defaultPackageName
+	}
+
+	return name
+}
@@ -0,0 +1,294 @@
+package reverse
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	crdbddl "github.com/kunitsucom/ddlctl/pkg/ddl/cockroachdb"
+	myddl "github.com/kunitsucom/ddlctl/pkg/ddl/mysql"
+	pgddl "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
+	spanddl "github.com/kunitsucom/ddlctl/pkg/ddl/spanner"
+	sqlite3ddl "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/diff"
+	"github.com/kunitsucom/ddlctl/pkg/ddlctl/generate"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/fixture"
+	ddlctlgo "github.com/kunitsucom/ddlctl/pkg/internal/lang/go"
+)
+
+//nolint:paralleltest
+func TestReverse(t *testing.T) {
+	cmd := fixture.Cmd()
+	_, err := cmd.Parse([]string{"ddlctl"})
+	require.NoError(t, err)
+	ctx := cliz.WithContext(context.Background(), cmd)
+	rollback := config.MustLoad(ctx)
+	t.Cleanup(rollback)
+
+	// roundTrip reverses ddlStr to Go source, and asserts that the DDL generated from it has no difference from ddlStr.
+	roundTrip := func(t *testing.T, dialect, ddlStr string) string {
+		t.Helper()
+
+		goSrc, err := Reverse(dialect, "model", ddlStr)
+		require.NoError(t, err)
+
+		filename := filepath.Join(t.TempDir(), "model.go")
+		require.NoError(t, os.WriteFile(filename, goSrc, 0o600))
+
+		generated := new(strings.Builder)
+		require.NoError(t, generate.Generate(ctx, generated, filename, dialect, ddlctlgo.Language))
+
+		_, err = diff.DiffStatements(dialect, ddlStr, generated.String())
+		require.ErrorIs(t, err, ddl.ErrNoDifference)
+
+		return string(goSrc)
+	}
+
+	t.Run("success,postgres", func(t *testing.T) {
+		const ddlStr = `CREATE TABLE public.users (
+    user_id TEXT NOT NULL,
+    username VARCHAR(64) NOT NULL DEFAULT '',
+    age INT,
+    profile JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    CONSTRAINT users_pkey PRIMARY KEY (user_id),
+    CONSTRAINT users_username_key UNIQUE (username)
+);
+CREATE UNIQUE INDEX users_idx_age ON public.users (age);
+CREATE TABLE public.group_members (
+    group_id TEXT NOT NULL,
+    user_id TEXT NOT NULL REFERENCES public.users (user_id),
+    CONSTRAINT group_members_pkey PRIMARY KEY (user_id, group_id)
+);
+`
+		expected := `package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Users is the model struct of the users table.
+//
+//ddlctl:table CREATE TABLE public.users
+//ddlctl:constraint CONSTRAINT users_username_key UNIQUE (username)
+//ddlctl:index CREATE UNIQUE INDEX users_idx_age ON public.users (age)
+type Users struct {
+	UserID    string          ` + "`" + `db:"user_id" ddlctl:"TEXT NOT NULL" pk:"true"` + "`" + `
+	Username  string          ` + "`" + `db:"username" ddlctl:"VARCHAR(64) DEFAULT '' NOT NULL"` + "`" + `
+	Age       *int32          ` + "`" + `db:"age" ddlctl:"INT"` + "`" + `
+	Profile   json.RawMessage ` + "`" + `db:"profile" ddlctl:"JSONB"` + "`" + `
+	CreatedAt time.Time       ` + "`" + `db:"created_at" ddlctl:"TIMESTAMP WITH TIME ZONE DEFAULT now() NOT NULL"` + "`" + `
+}
+
+// GroupMembers is the model struct of the group_members table.
+//
+//ddlctl:table CREATE TABLE public.group_members
+//ddlctl:constraint CONSTRAINT group_members_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users (user_id)
+//ddlctl:constraint CONSTRAINT group_members_pkey PRIMARY KEY (user_id, group_id)
+type GroupMembers struct {
+	GroupID string ` + "`" + `db:"group_id" ddlctl:"TEXT NOT NULL"` + "`" + `
+	UserID  string ` + "`" + `db:"user_id" ddlctl:"TEXT NOT NULL"` + "`" + `
+}
+`
+		actual := roundTrip(t, pgddl.Dialect, ddlStr)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("success,cockroachdb", func(t *testing.T) {
+		const ddlStr = `CREATE TABLE public.users (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    name STRING NOT NULL,
+    age INT8,
+    CONSTRAINT users_pkey PRIMARY KEY (id ASC),
+    INDEX users_idx_name (name ASC)
+);
+CREATE TABLE public.events (
+    id INT NOT NULL,
+    user_id UUID NOT NULL,
+    CONSTRAINT events_pkey PRIMARY KEY (id DESC)
+);
+`
+		actual := roundTrip(t, crdbddl.Dialect, ddlStr)
+		assert.True(t, strings.Contains(actual, "\tID   string `db:\"id\" ddlctl:\"UUID NOT NULL DEFAULT gen_random_uuid()\" pk:\"true\"`\n"))
+		assert.True(t, strings.Contains(actual, "//ddlctl:constraint CONSTRAINT events_pkey PRIMARY KEY (id DESC)\n"))
+	})
+
+	t.Run("success,mysql", func(t *testing.T) {
+		const ddlStr = "CREATE TABLE `users` (\n" +
+			"    `user_id` VARCHAR(36) NOT NULL,\n" +
+			"    `name` VARCHAR(255) NOT NULL DEFAULT '',\n" +
+			"    `age` INT,\n" +
+			"    `created_at` DATETIME NOT NULL,\n" +
+			"    PRIMARY KEY (`user_id`),\n" +
+			"    UNIQUE KEY `users_name` (`name`)\n" +
+			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;\n" +
+			"CREATE INDEX `idx_age` ON `users` (`age`);\n"
+		actual := roundTrip(t, myddl.Dialect, ddlStr)
+		assert.True(t, strings.Contains(actual, "//ddlctl:option ENGINE=InnoDB DEFAULT CHARSET=utf8mb4\n"))
+		assert.True(t, strings.Contains(actual, "\tUserID    string    `db:\"user_id\" ddlctl:\"VARCHAR(36) NOT NULL\" pk:\"true\"`\n"))
+	})
+
+	t.Run("success,spanner", func(t *testing.T) {
+		const ddlStr = `CREATE TABLE Users (
+    UserId STRING(36) NOT NULL,
+    Name STRING(MAX) NOT NULL,
+    CreatedAt TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp = true),
+) PRIMARY KEY (UserId);
+CREATE TABLE Posts (
+    UserId STRING(36) NOT NULL,
+    PostId INT64 NOT NULL,
+    Body BYTES(MAX),
+) PRIMARY KEY (UserId, PostId DESC),
+INTERLEAVE IN PARENT Users ON DELETE CASCADE;
+CREATE INDEX PostsByBody ON Posts (Body);
+`
+		actual := roundTrip(t, spanddl.Dialect, ddlStr)
+		assert.True(t, strings.Contains(actual, "\tUserId    string    `db:\"UserId\" ddlctl:\"STRING(36) NOT NULL\" pk:\"true\"`\n"))
+		assert.True(t, strings.Contains(actual, "//ddlctl:option PRIMARY KEY (UserId, PostId DESC)\n//ddlctl:option INTERLEAVE IN PARENT Users ON DELETE CASCADE\n"))
+	})
+
+	t.Run("success,sqlite3", func(t *testing.T) {
+		const ddlStr = `CREATE TABLE users (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    score REAL
+);
+CREATE TABLE tags (
+    user_id INTEGER NOT NULL,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (user_id, tag)
+);
+CREATE INDEX idx_tag ON tags (tag);
+`
+		actual := roundTrip(t, sqlite3ddl.Dialect, ddlStr)
+		assert.True(t, strings.Contains(actual, "\tID    int64    `db:\"id\" ddlctl:\"INTEGER PRIMARY KEY AUTOINCREMENT\"`\n"))
+		assert.True(t, strings.Contains(actual, "\tTag    string `db:\"tag\" ddlctl:\"TEXT NOT NULL\" pk:\"true\"`\n"))
+	})
+
+	t.Run("success,array", func(t *testing.T) {
+		pgActual := roundTrip(t, pgddl.Dialect, `CREATE TABLE public.users (id TEXT NOT NULL, tags TEXT[], scores INT8[] NOT NULL, CONSTRAINT users_pkey PRIMARY KEY (id));
+`)
+		assert.True(t, strings.Contains(pgActual, "[]string"))
+		assert.True(t, strings.Contains(pgActual, "[]int64"))
+		spanActual := roundTrip(t, spanddl.Dialect, `CREATE TABLE Users (
+    UserId STRING(36) NOT NULL,
+    Tags ARRAY<STRING(MAX)>,
+) PRIMARY KEY (UserId);
+`)
+		assert.True(t, strings.Contains(spanActual, "[]string"))
+	})
+
+	t.Run("success,skip", func(t *testing.T) {
+		const ddlStr = `CREATE TABLE public.users (id TEXT NOT NULL, CONSTRAINT users_pkey PRIMARY KEY (id));
+CREATE INDEX orphans_idx_id ON public.orphans (id);
+`
+		actual, err := Reverse(pgddl.Dialect, "model", ddlStr)
+		require.NoError(t, err)
+		assert.False(t, strings.Contains(string(actual), "orphans"))
+	})
+
+	t.Run("failure,unsupported", func(t *testing.T) {
+		const ddlStr = `CREATE TYPE public.user_status AS ENUM ('active', 'inactive');
+CREATE SEQUENCE public.users_seq;
+CREATE TABLE public.users (id TEXT NOT NULL, status user_status NOT NULL, CONSTRAINT users_pkey PRIMARY KEY (id));
+CREATE VIEW public.active_users AS SELECT id FROM public.users;
+`
+		_, err := Reverse(pgddl.Dialect, "model", ddlStr)
+		require.ErrorIs(t, err, apperr.ErrNotSupported)
+		require.ErrorContains(t, err, "create_type public.user_status, create_sequence public.users_seq, create_view public.active_users")
+	})
+
+	t.Run("failure,parse", func(t *testing.T) {
+		_, err := Reverse(pgddl.Dialect, "model", "CREATE TABLE")
+		require.ErrorContains(t, err, "pgddl.NewParser")
+	})
+
+	t.Run("failure,dialect", func(t *testing.T) {
+		_, err := Reverse("unknown", "model", "")
+		require.ErrorContains(t, err, "dialect=unknown")
+	})
+}
+
+func Test_goName(t *testing.T) {
+	t.Parallel()
+
+	for name, expected := range map[string]string{
+		"users":         "Users",
+		"user_id":       "UserID",
+		"api_url":       "APIURL",
+		"UserId":        "UserId",
+		"group-members": "GroupMembers",
+		"2fa_secret":    "X2faSecret",
+		"_":             "X",
+	} {
+		assert.Equal(t, expected, goName(name))
+	}
+}
+
+func Test_goType(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,not null", func(t *testing.T) {
+		t.Parallel()
+
+		typ, importPath := goType(pgddl.Dialect, "TIMESTAMP WITH TIME ZONE", true)
+		assert.Equal(t, "time.Time", typ)
+		assert.Equal(t, "time", importPath)
+	})
+
+	t.Run("success,null", func(t *testing.T) {
+		t.Parallel()
+
+		typ, importPath := goType(pgddl.Dialect, "NUMERIC(10, 2)", false)
+		assert.Equal(t, "*string", typ)
+		assert.Equal(t, "", importPath)
+	})
+
+	t.Run("success,nilable", func(t *testing.T) {
+		t.Parallel()
+
+		typ, _ := goType(spanddl.Dialect, "BYTES(MAX)", false)
+		assert.Equal(t, "[]byte", typ)
+	})
+
+	t.Run("success,array", func(t *testing.T) {
+		t.Parallel()
+
+		pgType, _ := goType(pgddl.Dialect, "TEXT[]", false)
+		assert.Equal(t, "[]string", pgType)
+		crdbType, _ := goType(crdbddl.Dialect, "INT8[][]", true)
+		assert.Equal(t, "[][]int64", crdbType)
+		spanType, importPath := goType(spanddl.Dialect, "ARRAY<TIMESTAMP>", false)
+		assert.Equal(t, "[]time.Time", spanType)
+		assert.Equal(t, "time", importPath)
+		bytesType, _ := goType(spanddl.Dialect, "ARRAY<BYTES(MAX)>", true)
+		assert.Equal(t, "[][]byte", bytesType)
+	})
+
+	t.Run("success,dialect", func(t *testing.T) {
+		t.Parallel()
+
+		pgType, _ := goType(pgddl.Dialect, "INT", true)
+		assert.Equal(t, "int32", pgType)
+		crdbType, _ := goType(crdbddl.Dialect, "INT", true)
+		assert.Equal(t, "int64", crdbType)
+	})
+}
+
+func Test_packageNameOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "models", packageNameOf("/path/to/models/ddlctl.gen.go"))
+	assert.Equal(t, "my_models", packageNameOf("/path/to/my-models/ddlctl.gen.go"))
+	assert.Equal(t, defaultPackageName, packageNameOf("/path/to/0models/ddlctl.gen.go"))
+}
@@ -0,0 +1,211 @@
+package reverse
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	crdbddl "github.com/kunitsucom/ddlctl/pkg/ddl/cockroachdb"
+	sqlite3ddl "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+)
+
+// fprintGo writes the tables as the Go structs annotated for `ddlctl generate`, and formats them with gofmt.
+func fprintGo(dialect, packageName string, tables []*table) ([]byte, error) {
+	imports := make(map[string]bool)
+	body := new(bytes.Buffer)
+
+	structNames := newNames()
+	for _, t := range tables {
+		structName := structNames.Add(goName(t.Name))
+		fmt.Fprintf(body, "\n// %s is the model struct of the %s table.\n//\n", structName, t.Name)
+		fprintAnnotation(body, "table", t.CreateTable)
+		for _, constraint := range t.Constraints {
+			fprintAnnotation(body, "constraint", annotation(constraint))
+		}
+		for _, option := range t.Options {
+			fprintAnnotation(body, "option", annotation(option))
+		}
+		for _, index := range t.Indexes {
+			fprintAnnotation(body, "index", index)
+		}
+
+		fmt.Fprintf(body, "type %s struct {\n", structName)
+		fieldNames := newNames()
+		for _, c := range t.Columns {
+			typ, importPath := goType(dialect, c.DataType, c.NotNull || c.PrimaryKey)
+			if importPath != "" {
+				imports[importPath] = true
+			}
+			fmt.Fprintf(body, "\t%s %s %s\n", fieldNames.Add(goName(c.Name)), typ, structTag(c))
+		}
+		fmt.Fprint(body, "}\n")
+	}
+
+	src := new(bytes.Buffer)
+	fmt.Fprintf(src, "package %s\n", packageName)
+	if len(imports) > 0 {
+		paths := make([]string, 0, len(imports))
+		for path := range imports {
+			paths = append(paths, strconv.Quote(path))
+		}
+		sort.Strings(paths)
+		fmt.Fprintf(src, "\nimport (\n\t%s\n)\n", strings.Join(paths, "\n\t"))
+	}
+	src.Write(body.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, apperr.Errorf("format.Source: %w", err)
+	}
+	return formatted, nil
+}
+
+func fprintAnnotation(buf *bytes.Buffer, key, value string) {
+	fmt.Fprintf(buf, "//%s:%s %s\n", config.DDLTagGo(), key, value)
+}
+
+// structTag returns the struct tag literal of the column, such as `db:"id" ddlctl:"TEXT NOT NULL" pk:"true"`.
+func structTag(c *column) string {
+	tag := config.ColumnTagGo() + ":" + strconv.Quote(c.Name) + " " + config.DDLTagGo() + ":" + strconv.Quote(c.TypeConstraint)
+	if c.PrimaryKey {
+		tag += " " + config.PKTagGo() + ":" + strconv.Quote("true")
+	}
+	if strings.Contains(tag, "`") {
+		// NOTE: The raw string literal cannot contain the back quote such as the quoted identifier of MySQL.
+		return strconv.Quote(tag)
+	}
+	return "`" + tag + "`"
+}
+
+// goType returns the Go type of the column, and the import path of the type if required.
+// The column that can be NULL is a pointer, except for the types that can be nil.
+//
+//nolint:cyclop
+func goType(dialect, dataType string, notNull bool) (typ string, importPath string) {
+	typeName := strings.ToUpper(strings.TrimSpace(dataType))
+	if elementType, ok := arrayElementType(typeName); ok {
+		// MEMO: The slice is nilable, so it is not a pointer even if the column is nullable.
+		elementGoType, elementImportPath := goType(dialect, elementType, true)
+		return "[]" + elementGoType, elementImportPath
+	}
+	if i := strings.IndexAny(typeName, "(<"); i >= 0 {
+		typeName = strings.TrimSpace(typeName[:i])
+	}
+
+	switch {
+	case typeName == "BOOL", typeName == "BOOLEAN":
+		typ = "bool"
+	case typeName == "TINYINT":
+		typ = "int8"
+	case typeName == "SMALLINT", typeName == "INT2", typeName == "SMALLSERIAL", typeName == "SERIAL2":
+		typ = "int16"
+	case typeName == "INT", typeName == "INTEGER":
+		// MEMO: INT of CockroachDB and INTEGER of SQLite3 are 64-bit.
+		if dialect == crdbddl.Dialect || dialect == sqlite3ddl.Dialect {
+			typ = "int64"
+		} else {
+			typ = "int32"
+		}
+	case typeName == "INT4", typeName == "MEDIUMINT", typeName == "SERIAL", typeName == "SERIAL4":
+		typ = "int32"
+	case typeName == "BIGINT", typeName == "INT8", typeName == "INT64", typeName == "BIGSERIAL", typeName == "SERIAL8":
+		typ = "int64"
+	case typeName == "REAL", typeName == "FLOAT4":
+		typ = "float32"
+	case typeName == "FLOAT", typeName == "FLOAT8", typeName == "FLOAT64", strings.HasPrefix(typeName, "DOUBLE"):
+		typ = "float64"
+	case strings.HasPrefix(typeName, "TIME"), typeName == "DATE", typeName == "DATETIME":
+		typ, importPath = "time.Time", "time"
+	case typeName == "BYTEA", typeName == "BYTES", strings.HasSuffix(typeName, "BLOB"), strings.HasSuffix(typeName, "BINARY"):
+		return "[]byte", ""
+	case typeName == "JSON", typeName == "JSONB":
+		return "json.RawMessage", "encoding/json"
+	default:
+		// NOTE: The other types such as NUMERIC are string, not to lose the precision.
+		typ = "string"
+	}
+
+	if !notNull {
+		return "*" + typ, importPath
+	}
+	return typ, importPath
+}
+
+// arrayElementType returns the element type of the array type,
+// such as TEXT of TEXT[] for PostgreSQL and CockroachDB, and STRING(MAX) of ARRAY<STRING(MAX)> for Spanner.
+func arrayElementType(typeName string) (elementType string, ok bool) {
+	switch {
+	case strings.HasSuffix(typeName, "]"):
+		if i := strings.LastIndex(typeName, "["); i > 0 {
+			return strings.TrimSpace(typeName[:i]), true
+		}
+	case strings.HasSuffix(typeName, " ARRAY"):
+		return strings.TrimSpace(strings.TrimSuffix(typeName, " ARRAY")), true
+	case strings.HasPrefix(typeName, "ARRAY<") && strings.HasSuffix(typeName, ">"):
+		return strings.TrimSpace(typeName[len("ARRAY<") : len(typeName)-len(">")]), true
+	}
+	return "", false
+}
+
+// goInitialisms are the initialisms written in upper case in Go names.
+//
+//nolint:gochecknoglobals
+var goInitialisms = map[string]bool{
+	"API":  true,
+	"HTML": true,
+	"HTTP": true,
+	"ID":   true,
+	"IP":   true,
+	"JSON": true,
+	"SQL":  true,
+	"URI":  true,
+	"URL":  true,
+	"UUID": true,
+}
+
+// goName returns the exported Go name of the table or column name, such as "UserID" for "user_id".
+func goName(name string) string {
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var b strings.Builder
+	for _, word := range words {
+		if upper := strings.ToUpper(word); goInitialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		runes := []rune(word)
+		b.WriteRune(unicode.ToUpper(runes[0]))
+		b.WriteString(string(runes[1:]))
+	}
+
+	goName := b.String()
+	if goName == "" || !unicode.IsLetter([]rune(goName)[0]) {
+		// MEMO: The Go name must start with a letter.
+		return "X" + goName
+	}
+	return goName
+}
+
+// names makes the names unique by adding the number to the duplicated name.
+type names map[string]bool
+
+func newNames() names {
+	return make(names)
+}
+
+func (n names) Add(name string) string {
+	unique := name
+	for i := 2; n[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	n[unique] = true
+	return unique
+}
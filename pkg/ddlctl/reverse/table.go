@@ -0,0 +1,442 @@
+package reverse
+
+import (
+	"strings"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	crdbddl "github.com/kunitsucom/ddlctl/pkg/ddl/cockroachdb"
+	myddl "github.com/kunitsucom/ddlctl/pkg/ddl/mysql"
+	pgddl "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
+	spanddl "github.com/kunitsucom/ddlctl/pkg/ddl/spanner"
+	sqlite3ddl "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
+	"github.com/kunitsucom/ddlctl/pkg/logs"
+)
+
+// table is a table in DDL, which is written as an annotated Go struct.
+type table struct {
+	// Name is the table name without the schema, which the struct name is derived from.
+	Name        string
+	CreateTable string
+	Columns     []*column
+	Constraints []string
+	Options     []string
+	Indexes     []string
+}
+
+type column struct {
+	Name string
+	// TypeConstraint is the column definition without the column name, such as "TEXT NOT NULL DEFAULT ''".
+	TypeConstraint string
+	DataType       string
+	NotNull        bool
+	PrimaryKey     bool
+}
+
+// setPrimaryKey marks the columns as the primary key, and reports whether the primary key can be annotated with the tags for primary key.
+// The generated PRIMARY KEY has the columns in the order of the fields, so the primary key in the other order cannot be annotated.
+func (t *table) setPrimaryKey(names []string) bool {
+	i := 0
+	for _, c := range t.Columns {
+		if i < len(names) && c.Name == names[i] {
+			i++
+		}
+	}
+	if len(names) == 0 || i != len(names) {
+		return false
+	}
+
+	for _, c := range t.Columns {
+		for _, name := range names {
+			if c.Name == name {
+				c.PrimaryKey = true
+			}
+		}
+	}
+	return true
+}
+
+func createTable(ifNotExists bool, name string) string {
+	if ifNotExists {
+		return "CREATE TABLE IF NOT EXISTS " + name
+	}
+	return "CREATE TABLE " + name
+}
+
+// annotation returns the statement as a line of the annotation comment, without the comments and the semicolon.
+func annotation(stmt string) string {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(stmt, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "--") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.TrimSuffix(strings.Join(lines, " "), ";")
+}
+
+// tableBuilder collects the tables and their indexes in order of the statements.
+type tableBuilder struct {
+	tables      []*table
+	byName      map[string]*table // key: table name for diff
+	indexes     map[string][]string
+	order       []string
+	unsupported []string
+}
+
+func newTableBuilder() *tableBuilder {
+	return &tableBuilder{byName: make(map[string]*table), indexes: make(map[string][]string)}
+}
+
+func (b *tableBuilder) AddTable(nameForDiff string, t *table) {
+	b.tables = append(b.tables, t)
+	b.byName[nameForDiff] = t
+}
+
+func (b *tableBuilder) AddIndex(tableNameForDiff, stmt string) {
+	if _, ok := b.indexes[tableNameForDiff]; !ok {
+		b.order = append(b.order, tableNameForDiff)
+	}
+	b.indexes[tableNameForDiff] = append(b.indexes[tableNameForDiff], annotation(stmt))
+}
+
+// Unsupported records the statement that cannot be annotated in Go source.
+// NOTE: It is not skipped silently, because the diff from the generated DDL would drop the object.
+func (b *tableBuilder) Unsupported(stmt *ddl.Statement) {
+	b.unsupported = append(b.unsupported, stmt.Kind+" "+stmt.Object)
+}
+
+func (b *tableBuilder) Tables() ([]*table, error) {
+	if len(b.unsupported) > 0 {
+		return nil, apperr.Errorf("the statements cannot be annotated in Go source: %s: %w", strings.Join(b.unsupported, ", "), apperr.ErrNotSupported)
+	}
+
+	for _, name := range b.order {
+		t, ok := b.byName[name]
+		if !ok {
+			logs.Warn.Printf("reverse: the indexes of %s are skipped, since the table is not found", name)
+			continue
+		}
+		t.Indexes = append(t.Indexes, b.indexes[name]...)
+	}
+	return b.tables, nil
+}
+
+func unsupported[T interface {
+	String() string
+	GetNameForDiff() string
+}](stmt T,
+) *ddl.Statement {
+	return ddl.NewStatements([]T{stmt}, nil)[0]
+}
+
+//nolint:cyclop
+func parseTables(dialect, ddlStr string) ([]*table, error) {
+	switch dialect {
+	case pgddl.Dialect:
+		return parseTablesPostgres(ddlStr)
+	case crdbddl.Dialect:
+		return parseTablesCockroachDB(ddlStr)
+	case myddl.Dialect:
+		return parseTablesMySQL(ddlStr)
+	case spanddl.Dialect:
+		return parseTablesSpanner(ddlStr)
+	case sqlite3ddl.Dialect:
+		return parseTablesSQLite3(ddlStr)
+	default:
+		return nil, apperr.Errorf("dialect=%s: %w", dialect, apperr.ErrNotSupported)
+	}
+}
+
+//nolint:dupl
+func parseTablesPostgres(ddlStr string) ([]*table, error) {
+	d, err := pgddl.NewParser(pgddl.NewLexer(ddlStr)).Parse()
+	if err != nil {
+		return nil, apperr.Errorf("pgddl.NewParser: %w", err)
+	}
+
+	b := newTableBuilder()
+	for _, stmt := range d.Stmts {
+		switch s := stmt.(type) {
+		case *pgddl.CreateTableStmt:
+			t := &table{Name: s.Name.Name.StringForDiff(), CreateTable: createTable(s.IfNotExists, s.Name.String())}
+			for _, c := range s.Columns {
+				t.Columns = append(t.Columns, &column{
+					Name:           c.Name.StringForDiff(),
+					TypeConstraint: strings.TrimPrefix(c.String(), c.Name.String()+" "),
+					DataType:       c.DataType.String(),
+					NotNull:        c.NotNull,
+				})
+			}
+			for _, c := range s.Constraints {
+				// NOTE: The primary key that has the default name is annotated with the tags, otherwise as the constraint.
+				if pk, ok := c.(*pgddl.PrimaryKeyConstraint); ok && pk.Name.StringForDiff() == s.Name.Name.StringForDiff()+"_pkey" {
+					names := make([]string, 0, len(pk.Columns))
+					for _, col := range pk.Columns {
+						names = append(names, col.StringForDiff())
+					}
+					if t.setPrimaryKey(names) {
+						continue
+					}
+				}
+				t.Constraints = append(t.Constraints, c.String())
+			}
+			for _, o := range s.Options {
+				t.Options = append(t.Options, o.String())
+			}
+			b.AddTable(s.Name.StringForDiff(), t)
+		case *pgddl.CreateIndexStmt:
+			b.AddIndex(s.TableName.StringForDiff(), s.String())
+		default:
+			b.Unsupported(unsupported(stmt))
+		}
+	}
+
+	tables, err := b.Tables()
+	if err != nil {
+		return nil, apperr.Errorf("b.Tables: %w", err)
+	}
+
+	return tables, nil
+}
+
+//nolint:dupl
+func parseTablesCockroachDB(ddlStr string) ([]*table, error) {
+	d, err := crdbddl.NewParser(crdbddl.NewLexer(ddlStr)).Parse()
+	if err != nil {
+		return nil, apperr.Errorf("crdbddl.NewParser: %w", err)
+	}
+
+	b := newTableBuilder()
+	for _, stmt := range d.Stmts {
+		switch s := stmt.(type) {
+		case *crdbddl.CreateTableStmt:
+			t := &table{Name: s.Name.Name.StringForDiff(), CreateTable: createTable(s.IfNotExists, s.Name.String())}
+			for _, c := range s.Columns {
+				t.Columns = append(t.Columns, &column{
+					Name:           c.Name.StringForDiff(),
+					TypeConstraint: strings.TrimPrefix(c.String(), c.Name.String()+" "),
+					DataType:       c.DataType.String(),
+					NotNull:        c.NotNull,
+				})
+			}
+			for _, c := range s.Constraints {
+				// NOTE: The primary key that has the default name and no DESC columns is annotated with the tags, otherwise as the constraint.
+				if pk, ok := c.(*crdbddl.PrimaryKeyConstraint); ok && pk.Name.StringForDiff() == s.Name.Name.StringForDiff()+"_pkey" {
+					names := make([]string, 0, len(pk.Columns))
+					for _, col := range pk.Columns {
+						if col.Order != nil && col.Order.Desc {
+							names = nil
+							break
+						}
+						names = append(names, col.Ident.StringForDiff())
+					}
+					if t.setPrimaryKey(names) {
+						continue
+					}
+				}
+				t.Constraints = append(t.Constraints, c.String())
+			}
+			for _, o := range s.Options {
+				t.Options = append(t.Options, o.String())
+			}
+			b.AddTable(s.Name.StringForDiff(), t)
+		case *crdbddl.CreateIndexStmt:
+			b.AddIndex(s.TableName.StringForDiff(), s.String())
+		default:
+			b.Unsupported(unsupported(stmt))
+		}
+	}
+
+	tables, err := b.Tables()
+	if err != nil {
+		return nil, apperr.Errorf("b.Tables: %w", err)
+	}
+
+	return tables, nil
+}
+
+func parseTablesMySQL(ddlStr string) ([]*table, error) {
+	d, err := myddl.NewParser(myddl.NewLexer(ddlStr)).Parse()
+	if err != nil {
+		return nil, apperr.Errorf("myddl.NewParser: %w", err)
+	}
+
+	b := newTableBuilder()
+	for _, stmt := range d.Stmts {
+		switch s := stmt.(type) {
+		case *myddl.CreateTableStmt:
+			t := &table{Name: s.Name.Name.StringForDiff(), CreateTable: createTable(s.IfNotExists, s.Name.String())}
+			for _, c := range s.Columns {
+				t.Columns = append(t.Columns, &column{
+					Name:           c.Name.StringForDiff(),
+					TypeConstraint: strings.TrimPrefix(c.String(), c.Name.String()+" "),
+					DataType:       c.DataType.String(),
+					NotNull:        c.NotNull,
+				})
+			}
+			for _, c := range s.Constraints {
+				// NOTE: The primary key of MySQL is always named PRIMARY, so the primary key that has no DESC columns is annotated with the tags.
+				if pk, ok := c.(*myddl.PrimaryKeyConstraint); ok {
+					names := make([]string, 0, len(pk.Columns))
+					for _, col := range pk.Columns {
+						if col.Order != nil && col.Order.Desc {
+							names = nil
+							break
+						}
+						names = append(names, col.Ident.StringForDiff())
+					}
+					if t.setPrimaryKey(names) {
+						continue
+					}
+				}
+				t.Constraints = append(t.Constraints, c.String())
+			}
+			if len(s.Options) > 0 {
+				// MEMO: The table options of MySQL are separated by spaces, not by the commas that separate the annotated options, so they are annotated as one option.
+				options := make([]string, 0, len(s.Options))
+				for _, o := range s.Options {
+					options = append(options, o.String())
+				}
+				t.Options = append(t.Options, strings.Join(options, " "))
+			}
+			b.AddTable(s.Name.StringForDiff(), t)
+		case *myddl.CreateIndexStmt:
+			b.AddIndex(s.TableName.StringForDiff(), s.String())
+		default:
+			b.Unsupported(unsupported(stmt))
+		}
+	}
+
+	tables, err := b.Tables()
+	if err != nil {
+		return nil, apperr.Errorf("b.Tables: %w", err)
+	}
+
+	return tables, nil
+}
+
+//nolint:cyclop
+func parseTablesSpanner(ddlStr string) ([]*table, error) {
+	d, err := spanddl.NewParser(spanddl.NewLexer(ddlStr)).Parse()
+	if err != nil {
+		return nil, apperr.Errorf("spanddl.NewParser: %w", err)
+	}
+
+	b := newTableBuilder()
+	for _, stmt := range d.Stmts {
+		switch s := stmt.(type) {
+		case *spanddl.CreateTableStmt:
+			t := &table{Name: s.Name.Name.StringForDiff(), CreateTable: createTable(s.IfNotExists, s.Name.String())}
+			for _, c := range s.Columns {
+				t.Columns = append(t.Columns, &column{
+					Name:           c.Name.StringForDiff(),
+					TypeConstraint: strings.TrimPrefix(c.String(), c.Name.String()+" "),
+					DataType:       c.DataType.String(),
+					NotNull:        c.NotNull,
+				})
+			}
+			for _, c := range s.Constraints {
+				t.Constraints = append(t.Constraints, c.String())
+			}
+			for _, o := range s.Options {
+				// NOTE: The primary key of Spanner is the table option, which is annotated with the tags if it has no sort order.
+				if o.Name == "PRIMARY KEY" && t.setPrimaryKey(spannerPrimaryKeyColumns(o.Value)) {
+					continue
+				}
+				t.Options = append(t.Options, o.String())
+			}
+			if s.RowDeletionPolicy != nil {
+				t.Options = append(t.Options, s.RowDeletionPolicy.String())
+			}
+			b.AddTable(s.Name.StringForDiff(), t)
+		case *spanddl.CreateIndexStmt:
+			b.AddIndex(s.TableName.StringForDiff(), s.String())
+		default:
+			b.Unsupported(unsupported(stmt))
+		}
+	}
+
+	tables, err := b.Tables()
+	if err != nil {
+		return nil, apperr.Errorf("b.Tables: %w", err)
+	}
+
+	return tables, nil
+}
+
+// spannerPrimaryKeyColumns returns the column names of `(A, B)`, or nil if the columns have the sort order such as `(A, B DESC)`.
+func spannerPrimaryKeyColumns(value *spanddl.Expr) []string {
+	if value == nil {
+		return nil
+	}
+	names := make([]string, 0)
+	for _, ident := range value.Idents {
+		switch strings.ToUpper(ident.StringForDiff()) {
+		case "(", ")", ",":
+			continue
+		case "ASC", "DESC":
+			return nil
+		}
+		names = append(names, ident.StringForDiff())
+	}
+	return names
+}
+
+//nolint:dupl
+func parseTablesSQLite3(ddlStr string) ([]*table, error) {
+	d, err := sqlite3ddl.NewParser(sqlite3ddl.NewLexer(ddlStr)).Parse()
+	if err != nil {
+		return nil, apperr.Errorf("sqlite3ddl.NewParser: %w", err)
+	}
+
+	b := newTableBuilder()
+	for _, stmt := range d.Stmts {
+		switch s := stmt.(type) {
+		case *sqlite3ddl.CreateTableStmt:
+			t := &table{Name: s.Name.Name.StringForDiff(), CreateTable: createTable(s.IfNotExists, s.Name.String())}
+			for _, c := range s.Columns {
+				t.Columns = append(t.Columns, &column{
+					Name:           c.Name.StringForDiff(),
+					TypeConstraint: strings.TrimPrefix(c.String(), c.Name.String()+" "),
+					DataType:       c.DataType.String(),
+					// MEMO: The column of INTEGER PRIMARY KEY AUTOINCREMENT is never NULL.
+					NotNull: c.NotNull || c.Autoincrement,
+				})
+			}
+			for _, c := range s.Constraints {
+				// NOTE: The primary key that has the default name and no DESC columns is annotated with the tags, otherwise as the constraint.
+				if pk, ok := c.(*sqlite3ddl.PrimaryKeyConstraint); ok && pk.Name.StringForDiff() == s.Name.Name.StringForDiff()+"_pkey" {
+					names := make([]string, 0, len(pk.Columns))
+					for _, col := range pk.Columns {
+						if col.Order != nil && col.Order.Desc {
+							names = nil
+							break
+						}
+						names = append(names, col.Ident.StringForDiff())
+					}
+					if t.setPrimaryKey(names) {
+						continue
+					}
+				}
+				t.Constraints = append(t.Constraints, c.String())
+			}
+			for _, o := range s.Options {
+				t.Options = append(t.Options, o.String())
+			}
+			b.AddTable(s.Name.StringForDiff(), t)
+		case *sqlite3ddl.CreateIndexStmt:
+			b.AddIndex(s.TableName.StringForDiff(), s.String())
+		default:
+			b.Unsupported(unsupported(stmt))
+		}
+	}
+
+	tables, err := b.Tables()
+	if err != nil {
+		return nil, apperr.Errorf("b.Tables: %w", err)
+	}
+
+	return tables, nil
+}
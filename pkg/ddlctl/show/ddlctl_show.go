@@ -6,19 +6,24 @@ import (
 	"errors"
 	"io"
 	"os"
+	"strings"
 
 	sqlz "github.com/kunitsucom/util.go/database/sql"
 
 	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
 	crdbddl "github.com/kunitsucom/ddlctl/pkg/ddl/cockroachdb"
 	myddl "github.com/kunitsucom/ddlctl/pkg/ddl/mysql"
 	pgddl "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
 	spanddl "github.com/kunitsucom/ddlctl/pkg/ddl/spanner"
+	sqlite3ddl "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
 	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/util"
 	crdbshow "github.com/kunitsucom/ddlctl/pkg/show/cockroachdb"
 	myshow "github.com/kunitsucom/ddlctl/pkg/show/mysql"
 	pgshow "github.com/kunitsucom/ddlctl/pkg/show/postgres"
 	spanshow "github.com/kunitsucom/ddlctl/pkg/show/spanner"
+	sqlite3show "github.com/kunitsucom/ddlctl/pkg/show/sqlite3"
 )
 
 func Command(ctx context.Context, args []string) error {
@@ -26,18 +31,46 @@ func Command(ctx context.Context, args []string) error {
 		return apperr.Errorf("config.Load: %w", err)
 	}
 
-	ddl, err := Show(ctx, config.Dialect(), args[0])
+	ddlStr, err := Show(ctx, config.Dialect(), args[0])
 	if err != nil {
 		return apperr.Errorf("diff: %w", err)
 	}
 
-	if _, err := io.WriteString(os.Stdout, ddl); err != nil {
+	tableFilter, err := ddl.NewTableFilter(config.IncludeTable(), config.ExcludeTable())
+	if err != nil {
+		return apperr.Errorf("ddl.NewTableFilter: %w", err)
+	}
+
+	if config.Output() == config.OutputJSON {
+		stmts, err := Statements(config.Dialect(), ddlStr, tableFilter.Keep)
+		if err != nil {
+			return apperr.Errorf("Statements: %w", err)
+		}
+		if err := util.WriteJSON(os.Stdout, &Output{Statements: stmts}); err != nil {
+			return apperr.Errorf("util.WriteJSON: %w", err)
+		}
+		return nil
+	}
+
+	if len(config.IncludeTable()) > 0 || len(config.ExcludeTable()) > 0 {
+		ddlStr, err = FilterTables(config.Dialect(), ddlStr, tableFilter.Keep)
+		if err != nil {
+			return apperr.Errorf("FilterTables: %w", err)
+		}
+	}
+
+	if _, err := io.WriteString(os.Stdout, ddlStr); err != nil {
 		return apperr.Errorf("io.WriteString: %w", err)
 	}
 
 	return nil
 }
 
+// Output is the document written by `ddlctl show --output json`.
+type Output struct {
+	Statements []*ddl.Statement `json:"statements"`
+}
+
 //nolint:cyclop,funlen,gocognit
 func Show(ctx context.Context, dialect string, dsn string) (ddl string, err error) {
 	driverName := func() string {
@@ -70,13 +103,19 @@ func Show(ctx context.Context, dialect string, dsn string) (ddl string, err erro
 		}
 		return ddl, nil
 	case pgddl.Dialect:
-		ddl, err := pgshow.ShowCreateAllTables(ctx, db)
+		ddl, err := pgshow.ShowCreateAllTables(ctx, db,
+			pgshow.WithShowCreateAllTablesOptionSchemas(config.Schema()...),
+			pgshow.WithShowCreateAllTablesOptionExcludeSchemas(config.ExcludeSchema()...),
+		)
 		if err != nil {
 			return "", apperr.Errorf("pgutil.ShowCreateAllTables: %w", err)
 		}
 		return ddl, nil
 	case crdbddl.Dialect:
-		ddl, err := crdbshow.ShowCreateAllTables(ctx, db)
+		ddl, err := crdbshow.ShowCreateAllTables(ctx, db,
+			crdbshow.WithShowCreateAllTablesOptionSchemas(config.Schema()...),
+			crdbshow.WithShowCreateAllTablesOptionExcludeSchemas(config.ExcludeSchema()...),
+		)
 		if err != nil {
 			return "", apperr.Errorf("crdbutil.ShowCreateAllTables: %w", err)
 		}
@@ -87,7 +126,66 @@ func Show(ctx context.Context, dialect string, dsn string) (ddl string, err erro
 			return "", apperr.Errorf("spanshow.ShowCreateAllTables: %w", err)
 		}
 		return ddl, nil
+	case sqlite3ddl.Dialect:
+		ddl, err := sqlite3show.ShowCreateAllTables(ctx, db)
+		if err != nil {
+			return "", apperr.Errorf("sqlite3show.ShowCreateAllTables: %w", err)
+		}
+		return ddl, nil
 	default:
 		return "", apperr.Errorf("dialect=%s: %w", dialect, apperr.ErrNotSupported)
 	}
 }
+
+// FilterTables returns the DDL that has only the statements of the tables for which keep reports true.
+func FilterTables(dialect string, ddlStr string, keep func(schema, table string) bool) (string, error) {
+	stmts, err := Statements(dialect, ddlStr, keep)
+	if err != nil {
+		return "", apperr.Errorf("Statements: %w", err)
+	}
+
+	filtered := new(strings.Builder)
+	for _, stmt := range stmts {
+		filtered.WriteString(stmt.String())
+	}
+
+	return filtered.String(), nil
+}
+
+// Statements returns the statements of the tables in DDL for which keep reports true.
+func Statements(dialect string, ddlStr string, keep func(schema, table string) bool) ([]*ddl.Statement, error) {
+	switch dialect {
+	case myddl.Dialect:
+		d, err := myddl.NewParser(myddl.NewLexer(ddlStr)).Parse()
+		if err != nil {
+			return nil, apperr.Errorf("myddl.NewParser: %w", err)
+		}
+		return ddl.NewStatements(d.FilterTables(keep).Stmts, nil), nil
+	case pgddl.Dialect:
+		d, err := pgddl.NewParser(pgddl.NewLexer(ddlStr)).Parse()
+		if err != nil {
+			return nil, apperr.Errorf("pgddl.NewParser: %w", err)
+		}
+		return ddl.NewStatements(d.FilterTables(keep).Stmts, nil), nil
+	case crdbddl.Dialect:
+		d, err := crdbddl.NewParser(crdbddl.NewLexer(ddlStr)).Parse()
+		if err != nil {
+			return nil, apperr.Errorf("crdbddl.NewParser: %w", err)
+		}
+		return ddl.NewStatements(d.FilterTables(keep).Stmts, nil), nil
+	case spanddl.Dialect:
+		d, err := spanddl.NewParser(spanddl.NewLexer(ddlStr)).Parse()
+		if err != nil {
+			return nil, apperr.Errorf("spanddl.NewParser: %w", err)
+		}
+		return ddl.NewStatements(d.FilterTables(keep).Stmts, nil), nil
+	case sqlite3ddl.Dialect:
+		d, err := sqlite3ddl.NewParser(sqlite3ddl.NewLexer(ddlStr)).Parse()
+		if err != nil {
+			return nil, apperr.Errorf("sqlite3ddl.NewParser: %w", err)
+		}
+		return ddl.NewStatements(d.FilterTables(keep).Stmts, nil), nil
+	default:
+		return nil, apperr.Errorf("dialect=%s: %w", dialect, apperr.ErrNotSupported)
+	}
+}
@@ -0,0 +1,56 @@
+package show
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	pgddl "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
+)
+
+func TestFilterTables(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,", func(t *testing.T) {
+		t.Parallel()
+
+		tableFilter, err := ddl.NewTableFilter(nil, []string{"schema_migrations"})
+		require.NoError(t, err)
+
+		actual, err := FilterTables(pgddl.Dialect, `CREATE TABLE public.users (id BIGINT NOT NULL);
+CREATE TABLE public.schema_migrations (version BIGINT NOT NULL);
+`, tableFilter.Keep)
+		require.NoError(t, err)
+
+		expected := `CREATE TABLE public.users (
+    id BIGINT NOT NULL
+);
+`
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("failure,ErrNotSupported", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := FilterTables("unknown", "", func(_, _ string) bool { return true })
+		require.ErrorIs(t, err, apperr.ErrNotSupported)
+	})
+	t.Run("success,Statements", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := Statements(pgddl.Dialect, `CREATE TABLE public.users (id BIGINT NOT NULL);
+CREATE INDEX users_idx_id ON public.users (id);
+`, func(_, _ string) bool { return true })
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, len(actual))
+		assert.Equal(t, "create_table", actual[0].Kind)
+		assert.Equal(t, "public.users", actual[0].Object)
+		assert.Equal(t, ddl.ChangeClass(""), actual[0].Class)
+		assert.Equal(t, "create_index", actual[1].Kind)
+		assert.Equal(t, "CREATE INDEX users_idx_id ON public.users (id);", actual[1].SQL)
+	})
+}
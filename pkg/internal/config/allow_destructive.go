@@ -0,0 +1,20 @@
+package config
+
+import (
+	"context"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+)
+
+func loadAllowDestructive(_ context.Context, cmd *cliz.Command) bool {
+	v, _ := cmd.GetOptionBool(consts.OptionAllowDestructive)
+	return v
+}
+
+func AllowDestructive() bool {
+	globalConfigMu.RLock()
+	defer globalConfigMu.RUnlock()
+	return globalConfig.AllowDestructive
+}
@@ -16,16 +16,33 @@ import (
 //
 //nolint:tagliatelle
 type config struct {
-	Version     bool   `json:"version"`
-	Trace       bool   `json:"trace"`
-	Debug       bool   `json:"debug"`
-	Language    string `json:"language"`
-	Dialect     string `json:"dialect"`
-	AutoApprove bool   `json:"auto_approve"`
+	Version          bool     `json:"version"`
+	Trace            bool     `json:"trace"`
+	Debug            bool     `json:"debug"`
+	Language         string   `json:"language"`
+	Dialect          string   `json:"dialect"`
+	AutoApprove      bool     `json:"auto_approve"`
+	AllowDestructive bool     `json:"allow_destructive"`
+	Rename           string   `json:"rename"`
+	RenameHeuristic  bool     `json:"rename_heuristic"`
+	Plan             string   `json:"plan"`
+	RecordHistory    bool     `json:"record_history"`
+	Tx               string   `json:"tx"`
+	ShadowDSN        string   `json:"shadow_dsn"`
+	Schema           []string `json:"schema"`
+	ExcludeSchema    []string `json:"exclude_schema"`
+	IncludeTable     []string `json:"include_table"`
+	ExcludeTable     []string `json:"exclude_table"`
+	Output           string   `json:"output"`
+	ExitCode         bool     `json:"exit_code"`
+	WithDown         bool     `json:"with_down"`
+	DownOutput       string   `json:"down_output"`
 	// Golang
-	ColumnTagGo string `json:"column_tag_go"`
-	DDLTagGo    string `json:"ddl_tag_go"`
-	PKTagGo     string `json:"pk_tag_go"`
+	ColumnTagGo   string `json:"column_tag_go"`
+	DDLTagGo      string `json:"ddl_tag_go"`
+	PKTagGo       string `json:"pk_tag_go"`
+	InferTypesGo  bool   `json:"infer_types_go"`
+	TypeMappingGo string `json:"type_mapping_go"`
 }
 
 //nolint:gochecknoglobals
@@ -64,24 +81,44 @@ func Load(ctx context.Context) (rollback func(), err error) {
 	return rollback, nil
 }
 
-// MEMO: Since there is a possibility of returning some kind of error in the future, the signature is made to return an error.
-//
 //nolint:funlen
-func load(ctx context.Context) (cfg *config, err error) { //nolint:unparam
+func load(ctx context.Context) (cfg *config, err error) {
 	cmd, err := cliz.FromContext(ctx)
 	if err != nil {
 		return nil, apperr.Errorf("cliz.FromContext: %w", err)
 	}
 
+	output, err := loadOutput(ctx, cmd)
+	if err != nil {
+		return nil, apperr.Errorf("loadOutput: %w", err)
+	}
+
 	c := &config{
-		Trace:       loadTrace(ctx, cmd),
-		Debug:       loadDebug(ctx, cmd),
-		Language:    loadLanguage(ctx, cmd),
-		Dialect:     loadDialect(ctx, cmd),
-		AutoApprove: loadAutoApprove(ctx, cmd),
-		ColumnTagGo: loadColumnTagGo(ctx, cmd),
-		DDLTagGo:    loadDDLTagGo(ctx, cmd),
-		PKTagGo:     loadPKTagGo(ctx, cmd),
+		Trace:            loadTrace(ctx, cmd),
+		Debug:            loadDebug(ctx, cmd),
+		Language:         loadLanguage(ctx, cmd),
+		Dialect:          loadDialect(ctx, cmd),
+		AutoApprove:      loadAutoApprove(ctx, cmd),
+		AllowDestructive: loadAllowDestructive(ctx, cmd),
+		Rename:           loadRename(ctx, cmd),
+		RenameHeuristic:  loadRenameHeuristic(ctx, cmd),
+		Plan:             loadPlan(ctx, cmd),
+		RecordHistory:    loadRecordHistory(ctx, cmd),
+		Tx:               loadTx(ctx, cmd),
+		ShadowDSN:        loadShadowDSN(ctx, cmd),
+		Schema:           loadSchema(ctx, cmd),
+		ExcludeSchema:    loadExcludeSchema(ctx, cmd),
+		IncludeTable:     loadIncludeTable(ctx, cmd),
+		ExcludeTable:     loadExcludeTable(ctx, cmd),
+		Output:           output,
+		ExitCode:         loadExitCode(ctx, cmd),
+		WithDown:         loadWithDown(ctx, cmd),
+		DownOutput:       loadDownOutput(ctx, cmd),
+		ColumnTagGo:      loadColumnTagGo(ctx, cmd),
+		DDLTagGo:         loadDDLTagGo(ctx, cmd),
+		PKTagGo:          loadPKTagGo(ctx, cmd),
+		InferTypesGo:     loadInferTypesGo(ctx, cmd),
+		TypeMappingGo:    loadTypeMappingGo(ctx, cmd),
 	}
 
 	switch {
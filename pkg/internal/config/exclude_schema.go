@@ -0,0 +1,20 @@
+package config
+
+import (
+	"context"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+)
+
+func loadExcludeSchema(_ context.Context, cmd *cliz.Command) []string {
+	v, _ := cmd.GetOptionString(consts.OptionExcludeSchema)
+	return splitCommaSeparated(v)
+}
+
+func ExcludeSchema() []string {
+	globalConfigMu.RLock()
+	defer globalConfigMu.RUnlock()
+	return globalConfig.ExcludeSchema
+}
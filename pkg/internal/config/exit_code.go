@@ -0,0 +1,20 @@
+package config
+
+import (
+	"context"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+)
+
+func loadExitCode(_ context.Context, cmd *cliz.Command) bool {
+	v, _ := cmd.GetOptionBool(consts.OptionExitCode)
+	return v
+}
+
+func ExitCode() bool {
+	globalConfigMu.RLock()
+	defer globalConfigMu.RUnlock()
+	return globalConfig.ExitCode
+}
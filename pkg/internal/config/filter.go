@@ -0,0 +1,11 @@
+package config
+
+// SetFilters sets the filters of the schemas and the tables instead of the options, such as the filters recorded in the plan.
+func SetFilters(schema, excludeSchema, includeTable, excludeTable []string) {
+	globalConfigMu.Lock()
+	defer globalConfigMu.Unlock()
+	// NOTE: The config is copied, because the rollback function of Load holds the previous one.
+	c := *globalConfig
+	c.Schema, c.ExcludeSchema, c.IncludeTable, c.ExcludeTable = schema, excludeSchema, includeTable, excludeTable
+	globalConfig = &c
+}
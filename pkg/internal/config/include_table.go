@@ -0,0 +1,46 @@
+package config
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+)
+
+func loadIncludeTable(_ context.Context, cmd *cliz.Command) []string {
+	v, _ := cmd.GetOptionString(consts.OptionIncludeTable)
+	return splitTablePatterns(v)
+}
+
+func IncludeTable() []string {
+	globalConfigMu.RLock()
+	defer globalConfigMu.RUnlock()
+	return globalConfig.IncludeTable
+}
+
+// regexpTablePatternRegex matches the leading regular expression enclosed in slashes, which ends with the slash followed by a comma or the end.
+//
+//nolint:gochecknoglobals
+var regexpTablePatternRegex = regexp.MustCompile(`^\s*(/.*?/)\s*(?:,|$)`)
+
+// splitTablePatterns splits the comma-separated table patterns like splitCommaSeparated,
+// but does not split the regular expression that has commas, such as "/^(a|b){1,3}$/".
+func splitTablePatterns(v string) []string {
+	patterns := make([]string, 0)
+	for v != "" {
+		if matches := regexpTablePatternRegex.FindStringSubmatch(v); matches != nil {
+			patterns = append(patterns, matches[1])
+			v = v[len(matches[0]):]
+			continue
+		}
+		pattern, rest, _ := strings.Cut(v, ",")
+		if pattern = strings.TrimSpace(pattern); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+		v = rest
+	}
+	return patterns
+}
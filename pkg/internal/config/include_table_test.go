@@ -0,0 +1,28 @@
+//nolint:testpackage
+package config
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+)
+
+func Test_splitTablePatterns(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		v        string
+		expected []string
+	}{
+		{"", []string{}},
+		{"users, posts,,", []string{"users", "posts"}},
+		{"schema_migrations,/^goose_/", []string{"schema_migrations", "/^goose_/"}},
+		{"/^(a|b){1,3}$/,users", []string{"/^(a|b){1,3}$/", "users"}},
+		{"users, /^tmp_[0-9]{2,}$/ , /x,y/", []string{"users", "/^tmp_[0-9]{2,}$/", "/x,y/"}},
+		{"/^a/b$/", []string{"/^a/b$/"}},
+	} {
+		if !assert.Equal(t, tt.expected, splitTablePatterns(tt.v)) {
+			t.Errorf("❌: %q", tt.v)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package config
+
+import (
+	"context"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+)
+
+func loadInferTypesGo(_ context.Context, cmd *cliz.Command) bool {
+	v, _ := cmd.GetOptionBool(consts.OptionGoInferTypes)
+	return v
+}
+
+func InferTypesGo() bool {
+	globalConfigMu.RLock()
+	defer globalConfigMu.RUnlock()
+	return globalConfig.InferTypesGo
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"context"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+)
+
+const (
+	// OutputText writes the DDL as it is.
+	OutputText = "text"
+	// OutputJSON writes the DDL statements as JSON for the machines such as CI bots.
+	OutputJSON = "json"
+)
+
+func loadOutput(_ context.Context, cmd *cliz.Command) (string, error) {
+	switch v, _ := cmd.GetOptionString(consts.OptionOutput); v {
+	case "", OutputText:
+		return OutputText, nil
+	case OutputJSON:
+		return v, nil
+	default:
+		return "", apperr.Errorf("output=%s: %w", v, apperr.ErrNotSupported)
+	}
+}
+
+func Output() string {
+	globalConfigMu.RLock()
+	defer globalConfigMu.RUnlock()
+	return globalConfig.Output
+}
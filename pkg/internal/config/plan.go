@@ -0,0 +1,20 @@
+package config
+
+import (
+	"context"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+)
+
+func loadPlan(_ context.Context, cmd *cliz.Command) string {
+	v, _ := cmd.GetOptionString(consts.OptionPlan)
+	return v
+}
+
+func Plan() string {
+	globalConfigMu.RLock()
+	defer globalConfigMu.RUnlock()
+	return globalConfig.Plan
+}
@@ -0,0 +1,20 @@
+package config
+
+import (
+	"context"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+)
+
+func loadRecordHistory(_ context.Context, cmd *cliz.Command) bool {
+	v, _ := cmd.GetOptionBool(consts.OptionRecordHistory)
+	return v
+}
+
+func RecordHistory() bool {
+	globalConfigMu.RLock()
+	defer globalConfigMu.RUnlock()
+	return globalConfig.RecordHistory
+}
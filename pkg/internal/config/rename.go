@@ -0,0 +1,30 @@
+package config
+
+import (
+	"context"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+)
+
+func loadRename(_ context.Context, cmd *cliz.Command) string {
+	v, _ := cmd.GetOptionString(consts.OptionRename)
+	return v
+}
+
+func Rename() string {
+	globalConfigMu.RLock()
+	defer globalConfigMu.RUnlock()
+	return globalConfig.Rename
+}
+
+// SetRenames sets the rename hints and --rename-heuristic instead of the options, such as the ones recorded in the plan.
+func SetRenames(rename string, renameHeuristic bool) {
+	globalConfigMu.Lock()
+	defer globalConfigMu.Unlock()
+	// NOTE: The config is copied, because the rollback function of Load holds the previous one.
+	c := *globalConfig
+	c.Rename, c.RenameHeuristic = rename, renameHeuristic
+	globalConfig = &c
+}
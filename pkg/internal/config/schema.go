@@ -0,0 +1,32 @@
+package config
+
+import (
+	"context"
+	"strings"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
+)
+
+func loadSchema(_ context.Context, cmd *cliz.Command) []string {
+	v, _ := cmd.GetOptionString(consts.OptionSchema)
+	return splitCommaSeparated(v)
+}
+
+func Schema() []string {
+	globalConfigMu.RLock()
+	defer globalConfigMu.RUnlock()
+	return globalConfig.Schema
+}
+
+// splitCommaSeparated splits the comma-separated value, ignoring the empty elements.
+func splitCommaSeparated(v string) []string {
+	values := make([]string, 0)
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -16,6 +16,51 @@ const (
 	OptionAutoApprove = "auto-approve"
 	EnvKeyAutoApprove = "DDLCTL_AUTO_APPROVE"
 
+	OptionAllowDestructive = "allow-destructive"
+	EnvKeyAllowDestructive = "DDLCTL_ALLOW_DESTRUCTIVE"
+
+	OptionRename = "rename"
+	EnvKeyRename = "DDLCTL_RENAME"
+
+	OptionRenameHeuristic = "rename-heuristic"
+	EnvKeyRenameHeuristic = "DDLCTL_RENAME_HEURISTIC"
+
+	OptionPlan = "plan"
+	EnvKeyPlan = "DDLCTL_PLAN"
+
+	OptionRecordHistory = "record-history"
+	EnvKeyRecordHistory = "DDLCTL_RECORD_HISTORY"
+
+	OptionTx = "tx"
+	EnvKeyTx = "DDLCTL_TX"
+
+	OptionShadowDSN = "shadow-dsn"
+	EnvKeyShadowDSN = "DDLCTL_SHADOW_DSN"
+
+	OptionSchema = "schema"
+	EnvKeySchema = "DDLCTL_SCHEMA"
+
+	OptionExcludeSchema = "exclude-schema"
+	EnvKeyExcludeSchema = "DDLCTL_EXCLUDE_SCHEMA"
+
+	OptionIncludeTable = "include-table"
+	EnvKeyIncludeTable = "DDLCTL_INCLUDE_TABLE"
+
+	OptionExcludeTable = "exclude-table"
+	EnvKeyExcludeTable = "DDLCTL_EXCLUDE_TABLE"
+
+	OptionOutput = "output"
+	EnvKeyOutput = "DDLCTL_OUTPUT"
+
+	OptionExitCode = "exit-code"
+	EnvKeyExitCode = "DDLCTL_EXIT_CODE"
+
+	OptionWithDown = "with-down"
+	EnvKeyWithDown = "DDLCTL_WITH_DOWN"
+
+	OptionDownOutput = "down-output"
+	EnvKeyDownOutput = "DDLCTL_DOWN_OUTPUT"
+
 	// Golang
 	OptionGoColumnTag = "go-column-tag"
 	EnvKeyGoColumnTag = "DDLCTL_GO_COLUMN_TAG"
@@ -25,4 +70,10 @@ const (
 
 	OptionGoPKTag = "go-pk-tag"
 	EnvKeyGoPKTag = "DDLCTL_GO_PK_TAG"
+
+	OptionGoInferTypes = "go-infer-types"
+	EnvKeyGoInferTypes = "DDLCTL_GO_INFER_TYPES"
+
+	OptionGoTypeMapping = "go-type-mapping"
+	EnvKeyGoTypeMapping = "DDLCTL_GO_TYPE_MAPPING"
 )
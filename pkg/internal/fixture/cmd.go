@@ -40,6 +40,18 @@ func Cmd() *cliz.Command {
 				Description: "primary key annotation key for Go struct tag",
 				Default:     cliz.Default("pk"),
 			},
+			&cliz.BoolOption{
+				Name:        consts.OptionGoInferTypes,
+				Environment: consts.EnvKeyGoInferTypes,
+				Description: "infer the column type from the Go type of the field",
+				Default:     cliz.Default(false),
+			},
+			&cliz.StringOption{
+				Name:        consts.OptionGoTypeMapping,
+				Environment: consts.EnvKeyGoTypeMapping,
+				Description: "mapping from Go types to column types",
+				Default:     cliz.Default(""),
+			},
 		},
 	}
 }
@@ -40,12 +40,15 @@ func Test_ddlctl_diff(t *testing.T) {
 		}
 		result := closeFunc()
 
-		const expected = `-- -
+		const expected = `-- ddlctl:class safe
+-- -
 -- +description TEXT NOT NULL
 ALTER TABLE public.test_groups ADD COLUMN description TEXT NOT NULL;
+-- ddlctl:class data-loss
 -- -name TEXT NOT NULL
 -- +
 ALTER TABLE public.test_users DROP COLUMN name;
+-- ddlctl:class safe
 -- -
 -- +username TEXT NOT NULL
 ALTER TABLE public.test_users ADD COLUMN username TEXT NOT NULL;
@@ -0,0 +1,316 @@
+package ddlctlgo
+
+import (
+	"go/ast"
+	"go/types"
+	"maps"
+	"os"
+	"strings"
+
+	osz "github.com/kunitsucom/util.go/os"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	crdbddl "github.com/kunitsucom/ddlctl/pkg/ddl/cockroachdb"
+	myddl "github.com/kunitsucom/ddlctl/pkg/ddl/mysql"
+	pgddl "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
+	spanddl "github.com/kunitsucom/ddlctl/pkg/ddl/spanner"
+	sqlite3ddl "github.com/kunitsucom/ddlctl/pkg/ddl/sqlite3"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+)
+
+// TypeMapping is the mapping from Go types, such as "string" or "time.Time", to SQL column types.
+type TypeMapping map[string]string
+
+// defaultTypeMappings is the mapping used by --go-infer-types for each dialect, which is overridden by --go-type-mapping.
+//
+// MEMO: The wrapper types for NULL, such as sql.NullInt64, are mapped to the types of their values,
+// and the columns of them are nullable as the columns of pointer types are.
+// MEMO: uint and uint64 are NUMERIC(20) for postgres and cockroachdb, which have no unsigned integer types,
+// but are the 64-bit signed integers for spanner and sqlite3, which do not have the types that hold the values over math.MaxInt64.
+//
+//nolint:gochecknoglobals
+var defaultTypeMappings = map[string]TypeMapping{
+	pgddl.Dialect: {
+		"string":          "TEXT",
+		"bool":            "BOOLEAN",
+		"int":             "BIGINT",
+		"int8":            "SMALLINT",
+		"int16":           "SMALLINT",
+		"int32":           "INTEGER",
+		"int64":           "BIGINT",
+		"uint":            "NUMERIC(20)",
+		"uint8":           "SMALLINT",
+		"uint16":          "INTEGER",
+		"uint32":          "BIGINT",
+		"uint64":          "NUMERIC(20)",
+		"float32":         "REAL",
+		"float64":         "DOUBLE PRECISION",
+		"[]byte":          "BYTEA",
+		"time.Time":       "TIMESTAMPTZ",
+		"uuid.UUID":       "UUID",
+		"json.RawMessage": "JSONB",
+		"sql.NullString":  "TEXT",
+		"sql.NullBool":    "BOOLEAN",
+		"sql.NullByte":    "SMALLINT",
+		"sql.NullInt16":   "SMALLINT",
+		"sql.NullInt32":   "INTEGER",
+		"sql.NullInt64":   "BIGINT",
+		"sql.NullFloat64": "DOUBLE PRECISION",
+		"sql.NullTime":    "TIMESTAMPTZ",
+		"uuid.NullUUID":   "UUID",
+	},
+	crdbddl.Dialect: {
+		"string":          "STRING",
+		"bool":            "BOOL",
+		"int":             "INT8",
+		"int8":            "INT2",
+		"int16":           "INT2",
+		"int32":           "INT4",
+		"int64":           "INT8",
+		"uint":            "DECIMAL(20)",
+		"uint8":           "INT2",
+		"uint16":          "INT4",
+		"uint32":          "INT8",
+		"uint64":          "DECIMAL(20)",
+		"float32":         "FLOAT4",
+		"float64":         "FLOAT8",
+		"[]byte":          "BYTES",
+		"time.Time":       "TIMESTAMPTZ",
+		"uuid.UUID":       "UUID",
+		"json.RawMessage": "JSONB",
+		"sql.NullString":  "STRING",
+		"sql.NullBool":    "BOOL",
+		"sql.NullByte":    "INT2",
+		"sql.NullInt16":   "INT2",
+		"sql.NullInt32":   "INT4",
+		"sql.NullInt64":   "INT8",
+		"sql.NullFloat64": "FLOAT8",
+		"sql.NullTime":    "TIMESTAMPTZ",
+		"uuid.NullUUID":   "UUID",
+	},
+	myddl.Dialect: {
+		"string":          "VARCHAR(255)",
+		"bool":            "BOOLEAN",
+		"int":             "BIGINT",
+		"int8":            "TINYINT",
+		"int16":           "SMALLINT",
+		"int32":           "INT",
+		"int64":           "BIGINT",
+		"uint":            "BIGINT UNSIGNED",
+		"uint8":           "TINYINT UNSIGNED",
+		"uint16":          "SMALLINT UNSIGNED",
+		"uint32":          "INT UNSIGNED",
+		"uint64":          "BIGINT UNSIGNED",
+		"float32":         "FLOAT",
+		"float64":         "DOUBLE",
+		"[]byte":          "BLOB",
+		"time.Time":       "TIMESTAMP",
+		"uuid.UUID":       "CHAR(36)",
+		"json.RawMessage": "JSON",
+		"sql.NullString":  "VARCHAR(255)",
+		"sql.NullBool":    "BOOLEAN",
+		"sql.NullByte":    "TINYINT UNSIGNED",
+		"sql.NullInt16":   "SMALLINT",
+		"sql.NullInt32":   "INT",
+		"sql.NullInt64":   "BIGINT",
+		"sql.NullFloat64": "DOUBLE",
+		"sql.NullTime":    "TIMESTAMP",
+		"uuid.NullUUID":   "CHAR(36)",
+	},
+	spanddl.Dialect: {
+		"string":              "STRING(MAX)",
+		"bool":                "BOOL",
+		"int":                 "INT64",
+		"int8":                "INT64",
+		"int16":               "INT64",
+		"int32":               "INT64",
+		"int64":               "INT64",
+		"uint":                "INT64", // MEMO: overflows over math.MaxInt64
+		"uint8":               "INT64",
+		"uint16":              "INT64",
+		"uint32":              "INT64",
+		"uint64":              "INT64", // MEMO: overflows over math.MaxInt64
+		"float32":             "FLOAT32",
+		"float64":             "FLOAT64",
+		"[]byte":              "BYTES(MAX)",
+		"time.Time":           "TIMESTAMP",
+		"civil.Date":          "DATE",
+		"big.Rat":             "NUMERIC",
+		"uuid.UUID":           "STRING(36)",
+		"json.RawMessage":     "JSON",
+		"sql.NullString":      "STRING(MAX)",
+		"sql.NullBool":        "BOOL",
+		"sql.NullByte":        "INT64",
+		"sql.NullInt16":       "INT64",
+		"sql.NullInt32":       "INT64",
+		"sql.NullInt64":       "INT64",
+		"sql.NullFloat64":     "FLOAT64",
+		"sql.NullTime":        "TIMESTAMP",
+		"spanner.NullString":  "STRING(MAX)",
+		"spanner.NullBool":    "BOOL",
+		"spanner.NullInt64":   "INT64",
+		"spanner.NullFloat32": "FLOAT32",
+		"spanner.NullFloat64": "FLOAT64",
+		"spanner.NullTime":    "TIMESTAMP",
+		"spanner.NullDate":    "DATE",
+		"spanner.NullNumeric": "NUMERIC",
+		"spanner.NullJSON":    "JSON",
+		"uuid.NullUUID":       "STRING(36)",
+	},
+	sqlite3ddl.Dialect: {
+		"string":          "TEXT",
+		"bool":            "BOOLEAN",
+		"int":             "INTEGER",
+		"int8":            "INTEGER",
+		"int16":           "INTEGER",
+		"int32":           "INTEGER",
+		"int64":           "INTEGER",
+		"uint":            "INTEGER", // MEMO: overflows over math.MaxInt64
+		"uint8":           "INTEGER",
+		"uint16":          "INTEGER",
+		"uint32":          "INTEGER",
+		"uint64":          "INTEGER", // MEMO: overflows over math.MaxInt64
+		"float32":         "REAL",
+		"float64":         "REAL",
+		"[]byte":          "BLOB",
+		"time.Time":       "TIMESTAMP",
+		"uuid.UUID":       "TEXT",
+		"json.RawMessage": "TEXT",
+		"sql.NullString":  "TEXT",
+		"sql.NullBool":    "BOOLEAN",
+		"sql.NullByte":    "INTEGER",
+		"sql.NullInt16":   "INTEGER",
+		"sql.NullInt32":   "INTEGER",
+		"sql.NullInt64":   "INTEGER",
+		"sql.NullFloat64": "REAL",
+		"sql.NullTime":    "TIMESTAMP",
+		"uuid.NullUUID":   "TEXT",
+	},
+}
+
+// loadTypeMapping returns the default mapping of the dialect overridden by --go-type-mapping.
+func loadTypeMapping(dialect string) (TypeMapping, error) {
+	mapping := make(TypeMapping)
+	maps.Copy(mapping, defaultTypeMappings[dialect])
+
+	overrides := config.TypeMappingGo()
+	if osz.IsFile(overrides) { // NOTE: expect type mapping file
+		b, err := os.ReadFile(overrides)
+		if err != nil {
+			return nil, apperr.Errorf("os.ReadFile: %w", err)
+		}
+		overrides = string(b)
+	}
+
+	parsed, err := ParseTypeMapping(overrides)
+	if err != nil {
+		return nil, apperr.Errorf("ParseTypeMapping: %w", err)
+	}
+	maps.Copy(mapping, parsed)
+
+	return mapping, nil
+}
+
+// ParseTypeMapping parses the mapping in the form of "GoType=SQL TYPE" separated by commas or new lines.
+// The commas in parentheses, such as "decimal.Decimal=NUMERIC(10, 2)", do not separate the mapping.
+// Empty lines and lines starting with "#" are ignored.
+func ParseTypeMapping(s string) (TypeMapping, error) {
+	mapping := make(TypeMapping)
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, entry := range splitOutsideParentheses(line, ',') {
+			entry = strings.TrimSpace(entry)
+			if entry == "" {
+				continue
+			}
+			goType, sqlType, ok := strings.Cut(entry, "=")
+			goType, sqlType = strings.TrimSpace(goType), strings.TrimSpace(sqlType)
+			if !ok || goType == "" || sqlType == "" {
+				return nil, apperr.Errorf("mapping=%q: %w", entry, apperr.ErrInvalidTypeMapping)
+			}
+			mapping[goType] = sqlType
+		}
+	}
+	return mapping, nil
+}
+
+func splitOutsideParentheses(s string, sep rune) []string {
+	elems := make([]string, 0)
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case sep:
+			if depth == 0 {
+				elems = append(elems, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(elems, s[start:])
+}
+
+// inferTypeConstraint returns the column type and constraint inferred from the type of the field.
+// The column is nullable if the field is a pointer, such as *string, or a wrapper type for NULL, such as sql.NullInt64.
+// The named types that are not in the mapping, such as `type Status string`, are inferred from their underlying types.
+// The type expression is used instead if the type cannot be resolved, such as the type in a module not downloaded.
+func inferTypeConstraint(mapping TypeMapping, typ types.Type, expr ast.Expr) (typeConstraint string, ok bool) {
+	if typ == nil || typ == types.Typ[types.Invalid] {
+		if expr == nil {
+			return "", false
+		}
+		return inferTypeConstraintFromExpr(mapping, expr)
+	}
+
+	nullable := false
+	if ptr, isPointer := types.Unalias(typ).(*types.Pointer); isPointer {
+		typ, nullable = ptr.Elem(), true
+	}
+	typ = types.Unalias(typ)
+
+	notNull := " NOT NULL"
+	if named, isNamed := typ.(*types.Named); nullable || (isNamed && strings.HasPrefix(named.Obj().Name(), "Null")) {
+		notNull = ""
+	}
+
+	for _, t := range []types.Type{typ, typ.Underlying()} {
+		if sqlType := mapping[types.TypeString(t, packageName)]; sqlType != "" {
+			return sqlType + notNull, true
+		}
+	}
+
+	return "", false
+}
+
+func inferTypeConstraintFromExpr(mapping TypeMapping, expr ast.Expr) (typeConstraint string, ok bool) {
+	goType := types.ExprString(expr)
+	if sqlType := mapping[goType]; sqlType != "" {
+		return sqlType + notNullUnlessNullable(expr), true
+	}
+
+	if star, isPointer := expr.(*ast.StarExpr); isPointer {
+		if sqlType := mapping[types.ExprString(star.X)]; sqlType != "" {
+			return sqlType, true
+		}
+	}
+
+	return "", false
+}
+
+func notNullUnlessNullable(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return ""
+	case *ast.SelectorExpr:
+		if strings.HasPrefix(t.Sel.Name, "Null") {
+			return ""
+		}
+	}
+	return " NOT NULL"
+}
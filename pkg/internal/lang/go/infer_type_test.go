@@ -0,0 +1,139 @@
+//nolint:testpackage
+package ddlctlgo
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/apperr"
+	pgddl "github.com/kunitsucom/ddlctl/pkg/ddl/postgres"
+)
+
+func TestParseTypeMapping(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := ParseTypeMapping("# comment\nstring=VARCHAR(64), decimal.Decimal = NUMERIC(10, 2)\n\n")
+		require.NoError(t, err)
+		assert.Equal(t, TypeMapping{"string": "VARCHAR(64)", "decimal.Decimal": "NUMERIC(10, 2)"}, actual)
+	})
+
+	t.Run("failure,ErrInvalidTypeMapping", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseTypeMapping("string")
+		require.ErrorIs(t, err, apperr.ErrInvalidTypeMapping)
+	})
+}
+
+func Test_inferTypeConstraint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		goType   string
+		expected string
+		ok       bool
+	}{
+		{goType: "string", expected: "TEXT NOT NULL", ok: true},
+		{goType: "*string", expected: "TEXT", ok: true},
+		{goType: "[]byte", expected: "BYTEA NOT NULL", ok: true},
+		{goType: "time.Time", expected: "TIMESTAMPTZ NOT NULL", ok: true},
+		{goType: "*time.Time", expected: "TIMESTAMPTZ", ok: true},
+		{goType: "sql.NullInt64", expected: "BIGINT", ok: true},
+		{goType: "uint64", expected: "NUMERIC(20) NOT NULL", ok: true},
+		{goType: "uuid.UUID", expected: "UUID NOT NULL", ok: true},
+		{goType: "chan int", expected: "", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run("success,expr,"+tt.goType, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := parser.ParseExpr(tt.goType)
+			require.NoError(t, err)
+
+			actual, ok := inferTypeConstraint(defaultTypeMappings[pgddl.Dialect], nil, expr)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
+
+func Test_defaultTypeMappings(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,integers", func(t *testing.T) {
+		t.Parallel()
+
+		for dialect, mapping := range defaultTypeMappings {
+			for _, goType := range []string{"int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64"} {
+				if _, ok := mapping[goType]; !ok {
+					t.Errorf("❌: dialect=%s: %s is not mapped", dialect, goType)
+				}
+			}
+		}
+	})
+}
+
+func Test_inferTypeConstraint_types(t *testing.T) {
+	t.Parallel()
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "model.go", `package model
+
+type Status string
+
+type NullStatus struct{ Status Status }
+
+type Alias = Status
+
+type Model struct {
+	Status     Status
+	Nullable   *Status
+	Alias      Alias
+	NullStatus NullStatus
+	Count      int32
+	Bytes      []byte
+	Chan       chan int
+}
+`, 0)
+	require.NoError(t, err)
+	pkg, err := (&types.Config{}).Check("model", fset, []*ast.File{f}, nil)
+	require.NoError(t, err)
+	model, ok := pkg.Scope().Lookup("Model").Type().Underlying().(*types.Struct)
+	require.True(t, ok)
+
+	mapping := TypeMapping{"string": "TEXT", "int32": "INTEGER", "[]byte": "BYTEA", "model.NullStatus": "TEXT"}
+	tests := []struct {
+		field    string
+		expected string
+		ok       bool
+	}{
+		{field: "Status", expected: "TEXT NOT NULL", ok: true},
+		{field: "Nullable", expected: "TEXT", ok: true},
+		{field: "Alias", expected: "TEXT NOT NULL", ok: true},
+		{field: "NullStatus", expected: "TEXT", ok: true},
+		{field: "Count", expected: "INTEGER NOT NULL", ok: true},
+		{field: "Bytes", expected: "BYTEA NOT NULL", ok: true},
+		{field: "Chan", expected: "", ok: false},
+	}
+	for i, tt := range tests {
+		t.Run("success,types,"+tt.field, func(t *testing.T) {
+			t.Parallel()
+
+			field := model.Field(i)
+			require.Equal(t, tt.field, field.Name())
+
+			actual, ok := inferTypeConstraint(mapping, field.Type(), nil)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package ddlctlgo
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/logs"
+)
+
+// loadMode loads the dependencies from the source, not from the export data built by the go command,
+// so that the structs embedded across packages have the doc comments of their fields,
+// and the packages that fail to build, such as the packages in the modules not downloaded, do not hide the other types.
+const loadMode = packages.NeedName | packages.NeedFiles | packages.NeedImports | packages.NeedDeps | packages.NeedSyntax | packages.NeedTypes | packages.NeedTypesInfo
+
+// errPackagesLoad is the error of packages.Load itself, such as the go command not found, rather than the errors in the loaded packages.
+var errPackagesLoad = errors.New("failed to load packages")
+
+// sourceFile is a Go file with the package that has the type information of it.
+type sourceFile struct {
+	Package *packages.Package
+	File    *ast.File
+	// Syntax is the syntax of all the loaded files including the dependencies, to find the struct fields declared in them.
+	Syntax map[*token.File]*ast.File
+}
+
+func (f *sourceFile) Filename() string {
+	return f.Package.Fset.Position(f.File.Package).Filename
+}
+
+// loadPackages loads the packages matched by patterns in dir, and returns their Go files in order of the file names.
+// The build constraints are respected, and the test files are not loaded.
+func loadPackages(ctx context.Context, dir string, patterns ...string) ([]*sourceFile, error) {
+	cfg := &packages.Config{
+		Context: ctx,
+		Mode:    loadMode,
+		Fset:    token.NewFileSet(),
+		Dir:     dir,
+		Env:     os.Environ(),
+		ParseFile: func(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+			f, err := parser.ParseFile(fset, filename, src, parser.ParseComments|parser.SkipObjectResolution)
+			if f != nil {
+				// NOTE: Only the declarations are required, so the function bodies are dropped not to type-check them.
+				for _, decl := range f.Decls {
+					if funcDecl, ok := decl.(*ast.FuncDecl); ok {
+						funcDecl.Body = nil
+					}
+				}
+			}
+			return f, err //nolint:wrapcheck
+		},
+	}
+	if !inGoModule(ctx, dir) {
+		// MEMO: The directory that is not in any module, such as a directory of loose Go files, is loaded in GOPATH mode.
+		cfg.Env = append(cfg.Env, "GO111MODULE=off")
+	}
+
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, apperr.Errorf("packages.Load: %w: %w", errPackagesLoad, err)
+	}
+
+	syntax := make(map[*token.File]*ast.File)
+	packages.Visit(pkgs, nil, func(pkg *packages.Package) {
+		for _, f := range pkg.Syntax {
+			syntax[cfg.Fset.File(f.Package)] = f
+		}
+	})
+
+	files := make([]*sourceFile, 0)
+	for _, pkg := range pkgs {
+		for _, e := range pkg.Errors {
+			// NOTE: The types that cannot be resolved, such as the types in the modules not downloaded, are not fatal, because only the tags are required for the annotated fields.
+			if e.Kind == packages.ParseError {
+				return nil, apperr.Errorf("packages.Load: %s: %w", pkg.PkgPath, e)
+			}
+			logs.Debug.Printf("packages.Load: %s: %v", pkg.PkgPath, e)
+		}
+		for _, f := range pkg.Syntax {
+			files = append(files, &sourceFile{Package: pkg, File: f, Syntax: syntax})
+		}
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Filename() < files[j].Filename()
+	})
+
+	return files, nil
+}
+
+// loadFile loads the Go file. The package of the file is loaded for the type information if the file name ends with ".go".
+func loadFile(ctx context.Context, filename string) (*sourceFile, error) {
+	if !strings.HasSuffix(filename, fileSuffix) {
+		return checkFile(filename)
+	}
+
+	files, err := loadPackages(ctx, filepath.Dir(filename), "file="+filename)
+	if err != nil {
+		if errors.Is(err, errPackagesLoad) {
+			// NOTE: The file can be parsed without the go command, only without the types of the other packages.
+			logs.Debug.Printf("loadPackages: %v", err)
+			return checkFile(filename)
+		}
+		return nil, apperr.Errorf("loadPackages: %w", err)
+	}
+	for _, f := range files {
+		if f.Filename() == filename {
+			return f, nil
+		}
+	}
+
+	// NOTE: the file excluded by the build constraints
+	return checkFile(filename)
+}
+
+// checkDir parses and type-checks the Go files in dir and its subdirectories by themselves, without the go command.
+// The test files are skipped, but the build constraints are not respected.
+func checkDir(dir string) ([]*sourceFile, error) {
+	files := make([]*sourceFile, 0)
+	if err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+		if d.IsDir() || !strings.HasSuffix(path, fileSuffix) || strings.HasSuffix(path, "_test"+fileSuffix) {
+			return nil
+		}
+		f, err := checkFile(path)
+		if err != nil {
+			return apperr.Errorf("checkFile: %w", err)
+		}
+		files = append(files, f)
+		return nil
+	}); err != nil {
+		return nil, apperr.Errorf("filepath.WalkDir: %w", err)
+	}
+
+	return files, nil
+}
+
+// checkFile parses and type-checks the file by itself, such as the file that does not end with ".go".
+func checkFile(filename string) (*sourceFile, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
+	if err != nil {
+		return nil, apperr.Errorf("parser.ParseFile: %w", err)
+	}
+
+	pkg := &packages.Package{
+		Name:      f.Name.Name,
+		PkgPath:   f.Name.Name,
+		Fset:      fset,
+		GoFiles:   []string{filename},
+		Syntax:    []*ast.File{f},
+		TypesInfo: &types.Info{Defs: make(map[*ast.Ident]types.Object), Types: make(map[ast.Expr]types.TypeAndValue)},
+	}
+	conf := &types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(err error) { logs.Debug.Printf("types.Config.Check: %v", err) },
+	}
+	// NOTE: The type errors are not fatal, the same as loadPackages.
+	pkg.Types, _ = conf.Check(pkg.PkgPath, fset, pkg.Syntax, pkg.TypesInfo)
+
+	return &sourceFile{Package: pkg, File: f, Syntax: map[*token.File]*ast.File{fset.File(f.Package): f}}, nil
+}
+
+// inGoModule reports whether dir is in a Go module, or the go command cannot tell it.
+func inGoModule(ctx context.Context, dir string) bool {
+	cmd := exec.CommandContext(ctx, "go", "env", "GOMOD")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		logs.Debug.Printf("go env GOMOD: %v", err)
+		return true
+	}
+	gomod := strings.TrimSpace(string(out))
+	return gomod != "" && gomod != os.DevNull
+}
@@ -5,20 +5,22 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
-	"go/parser"
-	"go/token"
+	"go/types"
 	"os"
-	"path/filepath"
-	"reflect"
+	"regexp"
 	"sort"
 	"strings"
 	"unicode"
 
+	osz "github.com/kunitsucom/util.go/os"
 	filepathz "github.com/kunitsucom/util.go/path/filepath"
 	slicez "github.com/kunitsucom/util.go/slices"
+	"golang.org/x/tools/go/packages"
 
 	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
 	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/consts"
 	"github.com/kunitsucom/ddlctl/pkg/internal/generator"
 	langutil "github.com/kunitsucom/ddlctl/pkg/internal/lang/util"
 	"github.com/kunitsucom/ddlctl/pkg/internal/util"
@@ -30,9 +32,30 @@ const (
 	DDLCTL_ERROR_STRUCT_FIELD_TAG_NOT_FOUND = "DDLCTL_ERROR_STRUCT_FIELD_TAG_NOT_FOUND" //nolint:revive,stylecheck
 )
 
-//nolint:cyclop
+// Parse parses the Go source, which is a file, a directory, or a package pattern such as "./models/...".
+// The files in the directory and the packages matched by the pattern are loaded with the type information,
+// and the files that do not have the DDL annotation are skipped.
 func Parse(ctx context.Context, src string) (*generator.DDL, error) {
-	// MEMO: get absolute path for parser.ParseFile()
+	ddl := generator.NewDDL(ctx)
+
+	// MEMO: The pattern of a directory, such as "./models/...", is loaded in the module of the directory, the same as the directory.
+	if dir := strings.TrimSuffix(src, "/"+packagePatternSuffix); dir != src && osz.IsDir(dir) {
+		src = dir
+	}
+
+	if strings.HasSuffix(src, packagePatternSuffix) {
+		files, err := loadPackages(ctx, "", src)
+		if err != nil {
+			return nil, apperr.Errorf("loadPackages: %w", err)
+		}
+		if err := parseFiles(ctx, ddl, files); err != nil {
+			return nil, apperr.Errorf("parseFiles: %w", err)
+		}
+
+		return ddl, nil
+	}
+
+	// MEMO: get absolute path for packages.Load()
 	sourceAbs := util.Abs(src)
 
 	info, err := os.Stat(sourceAbs)
@@ -40,60 +63,62 @@ func Parse(ctx context.Context, src string) (*generator.DDL, error) {
 		return nil, apperr.Errorf("os.Stat: %w", err)
 	}
 
-	ddl := generator.NewDDL(ctx)
-
 	if info.IsDir() {
-		if err := filepath.WalkDir(sourceAbs, walkDirFn(ctx, ddl)); err != nil {
-			return nil, apperr.Errorf("filepath.WalkDir: %w", err)
+		files, err := loadPackages(ctx, sourceAbs, "./"+packagePatternSuffix)
+		if errors.Is(err, errPackagesLoad) {
+			// NOTE: The files can be parsed without the go command, only without the types of the other files.
+			logs.Debug.Printf("loadPackages: %v", err)
+			files, err = checkDir(sourceAbs)
+		}
+		if err != nil {
+			return nil, apperr.Errorf("loadPackages: %w", err)
+		}
+		if err := parseFiles(ctx, ddl, files); err != nil {
+			return nil, apperr.Errorf("parseFiles: %w", err)
 		}
 
 		return ddl, nil
 	}
 
-	stmts, err := parseFile(ctx, sourceAbs)
+	file, err := loadFile(ctx, sourceAbs)
+	if err != nil {
+		return nil, apperr.Errorf("loadFile: %w", err)
+	}
+
+	stmts, err := parseFile(ctx, file)
 	if err != nil {
-		return nil, apperr.Errorf("Parse: %w", err)
+		return nil, apperr.Errorf("parseFile: %w", err)
 	}
 	ddl.Stmts = append(ddl.Stmts, stmts...)
 
 	return ddl, nil
 }
 
-//nolint:gochecknoglobals
-var fileSuffix = ".go"
-
-func walkDirFn(ctx context.Context, ddl *generator.DDL) func(path string, d os.DirEntry, err error) error {
-	return func(path string, d os.DirEntry, err error) error {
-		if err != nil {
-			return err //nolint:wrapcheck
-		}
-
-		if d.IsDir() || !strings.HasSuffix(path, fileSuffix) || strings.HasSuffix(path, "_test.go") {
-			return nil
-		}
+const (
+	fileSuffix           = ".go"
+	packagePatternSuffix = "..."
+)
 
-		stmts, err := parseFile(ctx, path)
+func parseFiles(ctx context.Context, ddl *generator.DDL, files []*sourceFile) error {
+	for _, file := range files {
+		stmts, err := parseFile(ctx, file)
 		if err != nil {
 			if errors.Is(err, apperr.ErrDDLTagGoAnnotationNotFoundInSource) {
-				logs.Debug.Printf("parseFile: %s: %v", path, err)
-				return nil
+				logs.Debug.Printf("parseFile: %s: %v", file.Filename(), err)
+				continue
 			}
 			return apperr.Errorf("parseFile: %w", err)
 		}
 
 		ddl.Stmts = append(ddl.Stmts, stmts...)
-
-		return nil
 	}
+
+	return nil
 }
 
 //nolint:cyclop,funlen,gocognit
-func parseFile(ctx context.Context, filename string) ([]generator.Stmt, error) {
-	fset := token.NewFileSet()
-	f, err := parser.ParseFile(fset, filename, nil, parser.ParseComments)
-	if err != nil {
-		return nil, apperr.Errorf("parser.ParseFile: %w", err)
-	}
+func parseFile(ctx context.Context, file *sourceFile) ([]generator.Stmt, error) {
+	fset, f := file.Package.Fset, file.File
 
 	ddlSrc, err := extractDDLSourceFromDDLTagGo(ctx, fset, f)
 	if err != nil {
@@ -102,6 +127,15 @@ func parseFile(ctx context.Context, filename string) ([]generator.Stmt, error) {
 
 	dumpDDLSource(fset, ddlSrc)
 
+	var typeMapping TypeMapping
+	if config.InferTypesGo() {
+		typeMapping, err = loadTypeMapping(config.Dialect())
+		if err != nil {
+			return nil, apperr.Errorf("loadTypeMapping: %w", err)
+		}
+	}
+
+	index := newFieldIndex(file)
 	stmts := make([]generator.Stmt, 0)
 	for _, r := range ddlSrc {
 		createTableStmt := &generator.CreateTableStmt{}
@@ -156,19 +190,25 @@ func parseFile(ctx context.Context, filename string) ([]generator.Stmt, error) {
 
 		// columns
 		if r.StructType != nil {
-			for _, field := range r.StructType.Fields.List {
+			fields, unresolved, err := structFieldsOf(file, index, r.TypeSpec)
+			if err != nil {
+				return nil, apperr.Errorf("structFieldsOf: %w", err)
+			}
+			for _, typ := range unresolved {
+				createTableStmt.Comments = append(createTableStmt.Comments, fmt.Sprintf("WARN: the \"%s\" struct's embedded \"%s\" field cannot be resolved as a struct, so the field is ignored.", r.TypeSpec.Name, typ))
+			}
+			for _, field := range fields {
+				fieldName, tag := field.Var.Name(), field.Tag
 				column := &generator.CreateTableColumn{}
 
-				tag := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
-
 				// column name
 				switch columnName := tag.Get(config.ColumnTagGo()); columnName {
 				case "-":
-					createTableStmt.Comments = append(createTableStmt.Comments, fmt.Sprintf("NOTE: the \"%s\" struct's \"%s\" field has a tag for column name (`%s:\"-\"`), so the field is ignored.", r.TypeSpec.Name, field.Names[0], config.ColumnTagGo()))
+					createTableStmt.Comments = append(createTableStmt.Comments, fmt.Sprintf("NOTE: the \"%s\" struct's \"%s\" field has a tag for column name (`%s:\"-\"`), so the field is ignored.", r.TypeSpec.Name, fieldName, config.ColumnTagGo()))
 					continue
 				case "":
-					name := field.Names[0].Name
-					column.Comments = append(column.Comments, fmt.Sprintf("WARN: the \"%s\" struct's \"%s\" field does not have a tag for column name (`%s:\"<ColumnName>\"`), so the field name \"%s\" is used as the column name.", r.TypeSpec.Name, field.Names[0], config.ColumnTagGo(), name))
+					name := fieldName
+					column.Comments = append(column.Comments, fmt.Sprintf("WARN: the \"%s\" struct's \"%s\" field does not have a tag for column name (`%s:\"<ColumnName>\"`), so the field name \"%s\" is used as the column name.", r.TypeSpec.Name, fieldName, config.ColumnTagGo(), name))
 					column.ColumnName = name
 				default:
 					column.ColumnName = columnName
@@ -176,13 +216,33 @@ func parseFile(ctx context.Context, filename string) ([]generator.Stmt, error) {
 
 				// column type and constraint
 				switch columnTypeConstraint := tag.Get(config.DDLTagGo()); columnTypeConstraint {
-				case "", "-":
+				case "-":
+					continue
+				case "":
+					// NOTE: The unexported fields are not mapped to columns by the database libraries, so they are not inferred.
+					if typeMapping != nil && field.Var.Exported() {
+						var expr ast.Expr
+						if field.Field != nil {
+							expr = field.Field.Type
+						}
+						if typeConstraint, ok := inferTypeConstraint(typeMapping, field.Var.Type(), expr); ok {
+							column.TypeConstraint = typeConstraint
+							break
+						}
+						createTableStmt.Comments = append(createTableStmt.Comments, fmt.Sprintf("WARN: the \"%s\" struct's \"%s\" field has the type \"%s\" that cannot be inferred as a column type (--%s), so the field is ignored.", r.TypeSpec.Name, fieldName, field.TypeString(), consts.OptionGoTypeMapping))
+						continue
+					}
 					// NOTE: ignore no-annotation fields
-					// column.Comments = append(column.Comments, fmt.Sprintf("ERROR: the \"%s\" struct's \"%s\" field does not have a tag for column type and constraint (`%s:\"<TYPE> [CONSTRAINT]\"`)", r.TypeSpec.Name, field.Names[0], config.DDLTagGo()))
+					// column.Comments = append(column.Comments, fmt.Sprintf("ERROR: the \"%s\" struct's \"%s\" field does not have a tag for column type and constraint (`%s:\"<TYPE> [CONSTRAINT]\"`)", r.TypeSpec.Name, fieldName, config.DDLTagGo()))
 					// column.TypeConstraint = DDLCTL_ERROR_STRUCT_FIELD_TAG_NOT_FOUND
 					continue
 				default:
-					column.TypeConstraint = columnTypeConstraint
+					typeConstraint, renamedFrom := extractRenamedFrom(columnTypeConstraint)
+					column.TypeConstraint = typeConstraint
+					if renamedFrom != "" {
+						table := tableNameFromCreateTable(createTableStmt.CreateTable)
+						column.Comments = append(column.Comments, fmt.Sprintf("%s%s.%s=%s.%s", ddl.RenameHintPrefix, table, renamedFrom, table, column.ColumnName))
+					}
 				}
 
 				// primary key
@@ -192,12 +252,14 @@ func parseFile(ctx context.Context, filename string) ([]generator.Stmt, error) {
 				case "", "-":
 					// do nothing
 				default:
-					column.Comments = append(column.Comments, fmt.Sprintf("WARN: the field \"%s\" does not have valid primary key tag (`%s:\"true\"`), so the column is not used as primary key.", field.Names[0], config.PKTagGo()))
+					column.Comments = append(column.Comments, fmt.Sprintf("WARN: the field \"%s\" does not have valid primary key tag (`%s:\"true\"`), so the column is not used as primary key.", fieldName, config.PKTagGo()))
 				}
 
 				// comments
-				comments := strings.Split(strings.Trim(field.Doc.Text(), "\n"), "\n")
-				column.Comments = append(column.Comments, langutil.TrimCommentElementTailEmpty(langutil.TrimCommentElementHasPrefix(comments, config.DDLTagGo()))...)
+				if field.Field != nil {
+					comments := strings.Split(strings.Trim(field.Field.Doc.Text(), "\n"), "\n")
+					column.Comments = append(column.Comments, langutil.TrimCommentElementTailEmpty(langutil.TrimCommentElementHasPrefix(comments, config.DDLTagGo()))...)
+				}
 
 				createTableStmt.Columns = append(createTableStmt.Columns, column)
 			}
@@ -232,6 +294,45 @@ func parseFile(ctx context.Context, filename string) ([]generator.Stmt, error) {
 	return stmts, nil
 }
 
+// structFieldsOf returns the fields of the struct declared by typeSpec, and the embedded types that cannot be resolved.
+// If the package of file does not have the type of the struct, such as the file in the directory that has several packages,
+// the file is type-checked by itself, so that the table of the struct is not dropped.
+func structFieldsOf(file *sourceFile, index *fieldIndex, typeSpec *ast.TypeSpec) ([]*structField, []string, error) {
+	if structType, ok := structTypeOf(file.Package, typeSpec); ok {
+		fields, unresolved := index.Fields(structType)
+		return fields, unresolved, nil
+	}
+
+	logs.Debug.Printf("structFieldsOf: %s: the type of the \"%s\" struct is not found in the package, so the file is type-checked by itself", file.Filename(), typeSpec.Name)
+	checked, err := checkFile(file.Filename())
+	if err != nil {
+		return nil, nil, apperr.Errorf("checkFile: %w", err)
+	}
+	if checked.Package.Types != nil {
+		if obj := checked.Package.Types.Scope().Lookup(typeSpec.Name.Name); obj != nil {
+			if structType, ok := obj.Type().Underlying().(*types.Struct); ok {
+				fields, unresolved := newFieldIndex(checked).Fields(structType)
+				return fields, unresolved, nil
+			}
+		}
+	}
+
+	return nil, nil, apperr.Errorf("%s: the type of the \"%s\" struct is not found", filepathz.Short(file.Filename()), typeSpec.Name)
+}
+
+// structTypeOf returns the struct type declared by typeSpec.
+func structTypeOf(pkg *packages.Package, typeSpec *ast.TypeSpec) (*types.Struct, bool) {
+	if pkg.TypesInfo == nil {
+		return nil, false
+	}
+	obj := pkg.TypesInfo.Defs[typeSpec.Name]
+	if obj == nil {
+		return nil, false
+	}
+	structType, ok := obj.Type().Underlying().(*types.Struct)
+	return structType, ok
+}
+
 func extractContainingCommentFromCommentGroup(commentGroup *ast.CommentGroup, sub string) *ast.Comment {
 	for _, commentLine := range commentGroup.List {
 		if strings.Contains(commentLine.Text, sub) {
@@ -240,3 +341,54 @@ func extractContainingCommentFromCommentGroup(commentGroup *ast.CommentGroup, su
 	}
 	return nil
 }
+
+// renamedFromRegex matches the `renamed_from=<old_column>` annotation, where <old_column> can be quoted such as `renamed_from="Old Name"`.
+//
+//nolint:gochecknoglobals
+var renamedFromRegex = regexp.MustCompile("(^|\\s+)renamed_from=(\"[^\"]*\"|`[^`]*`|\\S+)")
+
+// extractRenamedFrom removes the `renamed_from=<old_column>` annotation from the column type and constraint,
+// and returns the column name before renamed. The rest of the column type and constraint is kept as it is.
+// The `renamed_from=` in the quoted spans, such as `DEFAULT 'x renamed_from=y'`, is not the annotation.
+func extractRenamedFrom(typeConstraint string) (string, string) {
+	const renamedFromIndex = 2
+	for _, matches := range renamedFromRegex.FindAllStringSubmatchIndex(typeConstraint, -1) {
+		if isInQuotedSpan(typeConstraint, matches[3]) { // MEMO: matches[3] is the end of the leading spaces, that is the start of `renamed_from=`.
+			continue
+		}
+		renamedFrom := typeConstraint[matches[2*renamedFromIndex]:matches[2*renamedFromIndex+1]]
+		return strings.TrimSpace(typeConstraint[:matches[0]] + typeConstraint[matches[1]:]), renamedFrom
+	}
+	return typeConstraint, ""
+}
+
+// isInQuotedSpan reports whether s[pos] is in the span quoted by ', " or `.
+func isInQuotedSpan(s string, pos int) bool {
+	var quote rune
+	for _, r := range s[:pos] {
+		switch {
+		case quote == 0 && (r == '\'' || r == '"' || r == '`'):
+			quote = r
+		case r == quote:
+			quote = 0
+		}
+	}
+	return quote != 0
+}
+
+// tableNameFromCreateTable returns the table name of `CREATE TABLE [IF NOT EXISTS] <Table>`.
+func tableNameFromCreateTable(createTable string) string {
+	fields := strings.Fields(createTable)
+	for len(fields) > 1 {
+		switch strings.ToUpper(fields[0]) {
+		case "CREATE", "TABLE", "IF", "NOT", "EXISTS":
+			fields = fields[1:]
+			continue
+		}
+		break
+	}
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
@@ -6,6 +6,8 @@ import (
 	"go/ast"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
 
 	cliz "github.com/kunitsucom/util.go/exp/cli"
@@ -58,7 +60,7 @@ func TestParse(t *testing.T) {
 			"--go-column-tag=dbtest",
 			"--go-ddl-tag=spanddl",
 			"--go-pk-tag=pkey",
-			"tests",
+			copySourcesAsPackages(t, "tests"),
 			"dummy",
 		})
 		require.NoError(t, err)
@@ -69,10 +71,6 @@ func TestParse(t *testing.T) {
 			require.NoError(t, err)
 		}
 
-		backup := fileSuffix
-		t.Cleanup(func() { fileSuffix = backup })
-		fileSuffix = ".source"
-
 		{
 			ddl, err := Parse(ctx, args[1])
 			require.NoError(t, err)
@@ -84,6 +82,265 @@ func TestParse(t *testing.T) {
 		}
 	})
 
+	t.Run("success,go-infer-types", func(t *testing.T) {
+		source := filepath.Join(t.TempDir(), "model.go")
+		require.NoError(t, os.WriteFile(source, []byte(`package model
+
+// User is a user.
+//
+// spanddl: table: CREATE TABLE users
+type User struct {
+	ID        uuid.UUID       `+"`"+`dbtest:"id" pkey:"true"`+"`"+`
+	Name      string          `+"`"+`dbtest:"name" spanddl:"VARCHAR(100) NOT NULL"`+"`"+`
+	Nickname  *string         `+"`"+`dbtest:"nickname"`+"`"+`
+	Age       sql.NullInt64   `+"`"+`dbtest:"age"`+"`"+`
+	Balance   decimal.Decimal `+"`"+`dbtest:"balance"`+"`"+`
+	Ignored   string          `+"`"+`dbtest:"ignored" spanddl:"-"`+"`"+`
+	Unknown   chan int
+	CreatedAt time.Time
+	hits      int
+}
+`), 0o600))
+
+		cmd := fixture.Cmd()
+		args, err := cmd.Parse([]string{
+			"ddlctl",
+			"--lang=go",
+			"--dialect=postgres",
+			"--go-column-tag=dbtest",
+			"--go-ddl-tag=spanddl",
+			"--go-pk-tag=pkey",
+			"--go-infer-types",
+			"--go-type-mapping=decimal.Decimal=NUMERIC(10, 2)",
+			source,
+			"dummy",
+		})
+		require.NoError(t, err)
+		ctx := cliz.WithContext(context.Background(), cmd)
+
+		{
+			_, err := config.Load(ctx)
+			require.NoError(t, err)
+		}
+
+		ddl, err := Parse(ctx, args[1])
+		require.NoError(t, err)
+		require.Equal(t, 1, len(ddl.Stmts))
+		createTableStmt, ok := ddl.Stmts[0].(*generator.CreateTableStmt)
+		require.True(t, ok)
+
+		actual := make([]string, 0)
+		for _, column := range createTableStmt.Columns {
+			actual = append(actual, column.ColumnName+" "+column.TypeConstraint)
+		}
+		assert.Equal(t, []string{
+			"id UUID NOT NULL",
+			"name VARCHAR(100) NOT NULL",
+			"nickname TEXT",
+			"age BIGINT",
+			"balance NUMERIC(10, 2) NOT NULL",
+			"CreatedAt TIMESTAMPTZ NOT NULL",
+		}, actual)
+		assert.Equal(t, []string{"id"}, createTableStmt.PrimaryKey)
+	})
+
+	t.Run("success,go command not found", func(t *testing.T) {
+		source := filepath.Join(t.TempDir(), "model.go")
+		require.NoError(t, os.WriteFile(source, []byte(`package model
+
+// spanddl: table: CREATE TABLE users
+type User struct {
+	ID   string `+"`"+`dbtest:"id" spanddl:"TEXT NOT NULL" pkey:"true"`+"`"+`
+	Name string `+"`"+`dbtest:"name" spanddl:"TEXT NOT NULL"`+"`"+`
+}
+`), 0o600))
+		t.Setenv("PATH", "")
+
+		cmd := fixture.Cmd()
+		args, err := cmd.Parse([]string{
+			"ddlctl",
+			"--lang=go",
+			"--dialect=postgres",
+			"--go-column-tag=dbtest",
+			"--go-ddl-tag=spanddl",
+			"--go-pk-tag=pkey",
+			source,
+			"dummy",
+		})
+		require.NoError(t, err)
+		ctx := cliz.WithContext(context.Background(), cmd)
+
+		{
+			_, err := config.Load(ctx)
+			require.NoError(t, err)
+		}
+
+		ddl, err := Parse(ctx, args[1])
+		require.NoError(t, err)
+		require.Equal(t, 1, len(ddl.Stmts))
+		createTableStmt, ok := ddl.Stmts[0].(*generator.CreateTableStmt)
+		require.True(t, ok)
+		assert.Equal(t, 2, len(createTableStmt.Columns))
+		assert.Equal(t, []string{"id"}, createTableStmt.PrimaryKey)
+	})
+
+	t.Run("success,go command not found,info.IsDir", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "user.go"), []byte(`package model
+
+// spanddl: table: CREATE TABLE users
+type User struct {
+	ID string `+"`"+`dbtest:"id" spanddl:"TEXT NOT NULL" pkey:"true"`+"`"+`
+}
+`), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "group.go"), []byte(`package sub
+
+// spanddl: table: CREATE TABLE groups
+type Group struct {
+	ID string `+"`"+`dbtest:"id" spanddl:"TEXT NOT NULL" pkey:"true"`+"`"+`
+}
+`), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "user_test.go"), []byte("package model\n"), 0o600))
+		t.Setenv("PATH", "")
+
+		cmd := fixture.Cmd()
+		_, err := cmd.Parse([]string{"ddlctl", "--lang=go", "--dialect=postgres", "--go-column-tag=dbtest", "--go-ddl-tag=spanddl", "--go-pk-tag=pkey"})
+		require.NoError(t, err)
+		ctx := cliz.WithContext(context.Background(), cmd)
+		{
+			_, err := config.Load(ctx)
+			require.NoError(t, err)
+		}
+
+		ddl, err := Parse(ctx, dir)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(ddl.Stmts))
+		assert.Equal(t, "CREATE TABLE groups", ddl.Stmts[0].(*generator.CreateTableStmt).CreateTable) //nolint:forcetypeassert
+		assert.Equal(t, "CREATE TABLE users", ddl.Stmts[1].(*generator.CreateTableStmt).CreateTable)  //nolint:forcetypeassert
+	})
+
+	t.Run("success,several packages in a directory", func(t *testing.T) {
+		dir := t.TempDir()
+		for _, name := range []string{"a", "b"} {
+			require.NoError(t, os.WriteFile(filepath.Join(dir, name+".go"), []byte(`package `+name+`
+
+// spanddl: table: CREATE TABLE `+name+`
+type T struct {
+	ID string `+"`"+`dbtest:"id" spanddl:"TEXT NOT NULL" pkey:"true"`+"`"+`
+}
+`), 0o600))
+		}
+
+		cmd := fixture.Cmd()
+		_, err := cmd.Parse([]string{"ddlctl", "--lang=go", "--dialect=postgres", "--go-column-tag=dbtest", "--go-ddl-tag=spanddl", "--go-pk-tag=pkey"})
+		require.NoError(t, err)
+		ctx := cliz.WithContext(context.Background(), cmd)
+		{
+			_, err := config.Load(ctx)
+			require.NoError(t, err)
+		}
+
+		ddl, err := Parse(ctx, dir)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(ddl.Stmts))
+		for i, name := range []string{"a", "b"} {
+			createTableStmt, ok := ddl.Stmts[i].(*generator.CreateTableStmt)
+			require.True(t, ok)
+			assert.Equal(t, "CREATE TABLE "+name, createTableStmt.CreateTable)
+			assert.Equal(t, 1, len(createTableStmt.Columns))
+		}
+	})
+
+	t.Run("success,embedded", func(t *testing.T) {
+		moduleDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "go.mod"), []byte("module example.com/app\n\ngo 1.21\n"), 0o600))
+		require.NoError(t, os.MkdirAll(filepath.Join(moduleDir, "common"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "common", "common.go"), []byte(`package common
+
+import "time"
+
+type Timestamps struct {
+	// CreatedAt is the time when the row is created.
+	CreatedAt time.Time `+"`"+`dbtest:"created_at" spanddl:"TIMESTAMPTZ NOT NULL"`+"`"+`
+	UpdatedAt time.Time `+"`"+`dbtest:"updated_at" spanddl:"TIMESTAMPTZ NOT NULL"`+"`"+`
+}
+`), 0o600))
+		require.NoError(t, os.MkdirAll(filepath.Join(moduleDir, "model"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(moduleDir, "model", "mixin.go"), []byte(`package model
+
+import "example.com/app/common"
+
+type Auditable = auditable
+
+type auditable struct {
+	CreatedBy, UpdatedBy string `+"`"+`spanddl:"TEXT NOT NULL"`+"`"+`
+	*common.Timestamps
+}
+`), 0o600))
+		source := filepath.Join(moduleDir, "model", "model.go")
+		require.NoError(t, os.WriteFile(source, []byte(`package model
+
+// spanddl: table: CREATE TABLE users
+type User struct {
+	ID      string `+"`"+`dbtest:"id" pkey:"true" spanddl:"UUID NOT NULL"`+"`"+`
+	Auditable
+	Ignored `+"`"+`dbtest:"-"`+"`"+`
+	Unknown
+}
+
+type Ignored struct {
+	Ignored string `+"`"+`dbtest:"ignored" spanddl:"TEXT"`+"`"+`
+}
+`), 0o600))
+
+		cmd := fixture.Cmd()
+		args, err := cmd.Parse([]string{
+			"ddlctl",
+			"--lang=go",
+			"--dialect=postgres",
+			"--go-column-tag=dbtest",
+			"--go-ddl-tag=spanddl",
+			"--go-pk-tag=pkey",
+			source,
+			"dummy",
+		})
+		require.NoError(t, err)
+		ctx := cliz.WithContext(context.Background(), cmd)
+
+		{
+			_, err := config.Load(ctx)
+			require.NoError(t, err)
+		}
+
+		ddl, err := Parse(ctx, args[1])
+		require.NoError(t, err)
+		require.Equal(t, 1, len(ddl.Stmts))
+		createTableStmt, ok := ddl.Stmts[0].(*generator.CreateTableStmt)
+		require.True(t, ok)
+
+		actual := make([]string, 0)
+		for _, column := range createTableStmt.Columns {
+			actual = append(actual, column.ColumnName+" "+column.TypeConstraint)
+		}
+		assert.Equal(t, []string{
+			"id UUID NOT NULL",
+			"CreatedBy TEXT NOT NULL",
+			"UpdatedBy TEXT NOT NULL",
+			"created_at TIMESTAMPTZ NOT NULL",
+			"updated_at TIMESTAMPTZ NOT NULL",
+		}, actual)
+		assert.True(t, slices.Contains(createTableStmt.Comments, `WARN: the "User" struct's embedded "Unknown" field cannot be resolved as a struct, so the field is ignored.`))
+		assert.Equal(t, []string{"CreatedAt is the time when the row is created."}, createTableStmt.Columns[3].Comments)
+
+		t.Run("success,pattern", func(t *testing.T) {
+			ddl, err := Parse(ctx, filepath.Join(moduleDir, "..."))
+			require.NoError(t, err)
+			require.Equal(t, 1, len(ddl.Stmts))
+			assert.Equal(t, source, ddl.Stmts[0].GetSourceFile())
+		})
+	})
+
 	t.Run("failure,info.IsDir", func(t *testing.T) {
 		tempDir := t.TempDir()
 		{
@@ -114,7 +371,7 @@ func TestParse(t *testing.T) {
 
 		{
 			_, err := Parse(ctx, args[1])
-			require.ErrorContains(t, err, "expected 'package', found 'EOF'")
+			require.ErrorContains(t, err, "found 'EOF'")
 		}
 	})
 
@@ -201,37 +458,54 @@ func TestParse(t *testing.T) {
 	})
 }
 
-func Test_walkDirFn(t *testing.T) {
+// copySourcesAsPackages copies each *.source file in dir to a package of its own in a directory that is not in any module.
+func copySourcesAsPackages(t *testing.T, dir string) string {
+	t.Helper()
+
+	tempDir := t.TempDir()
+	sources, err := filepath.Glob(filepath.Join(dir, "*.source"))
+	require.NoError(t, err)
+	for _, source := range sources {
+		b, err := os.ReadFile(source)
+		require.NoError(t, err)
+		name := strings.TrimSuffix(filepath.Base(source), ".source")
+		require.NoError(t, os.MkdirAll(filepath.Join(tempDir, name), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(tempDir, name, name+".go"), b, 0o600))
+	}
+	return tempDir
+}
+
+func Test_loadPackages(t *testing.T) {
 	t.Parallel()
 
-	t.Run("failure,err", func(t *testing.T) {
+	t.Run("success,no-go.mod", func(t *testing.T) {
 		t.Parallel()
 
-		cmd := fixture.Cmd()
-		_, err := cmd.Parse([]string{
-			"ddlctl",
-			"--lang=go",
-			"--dialect=spanner",
-			"--go-column-tag=dbtest",
-			"--go-ddl-tag=spanddl",
-			"--go-pk-tag=pkey",
-			"tests",
-			"dummy",
-		})
-		require.NoError(t, err)
-		ctx := cliz.WithContext(context.Background(), cmd)
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o700))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "b.go"), []byte("package sub\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.go"), []byte("package a\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a_test.go"), []byte("package a\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "ignored.go"), []byte("//go:build ignore\n\npackage a\n"), 0o600))
 
-		{
-			_, err := config.Load(ctx)
-			require.NoError(t, err)
+		files, err := loadPackages(context.Background(), dir, "./...")
+		require.NoError(t, err)
+		actual := make([]string, 0)
+		for _, f := range files {
+			actual = append(actual, filepath.Base(f.Filename()))
 		}
+		assert.Equal(t, []string{"a.go", "b.go"}, actual)
+	})
 
-		ddl := generator.NewDDL(ctx)
-		fn := walkDirFn(ctx, ddl)
-		{
-			err := fn("", nil, os.ErrPermission)
-			require.Error(t, err)
-		}
+	t.Run("failure,packages.ParseError", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/app\n"), 0o600))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.go"), []byte("package a\n\ntype A struct {\n"), 0o600))
+
+		_, err := loadPackages(context.Background(), dir, "./...")
+		require.ErrorContains(t, err, "expected")
 	})
 }
 
@@ -251,3 +525,48 @@ func Test_extractContainingCommentFromCommentGroup(t *testing.T) {
 		assert.Nil(t, actual)
 	})
 }
+
+func Test_extractRenamedFrom(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,renamed_from", func(t *testing.T) {
+		t.Parallel()
+
+		typeConstraint, renamedFrom := extractRenamedFrom("TEXT NOT NULL renamed_from=name")
+		assert.Equal(t, "TEXT NOT NULL", typeConstraint)
+		assert.Equal(t, "name", renamedFrom)
+		assert.Equal(t, `"users"`, tableNameFromCreateTable(`CREATE TABLE IF NOT EXISTS "users"`))
+	})
+
+	t.Run("success,quoted", func(t *testing.T) {
+		t.Parallel()
+
+		typeConstraint, renamedFrom := extractRenamedFrom("TEXT NOT NULL DEFAULT 'a  b' renamed_from=\"Old Name\" CHECK (length(x) > 0)")
+		assert.Equal(t, "TEXT NOT NULL DEFAULT 'a  b' CHECK (length(x) > 0)", typeConstraint)
+		assert.Equal(t, `"Old Name"`, renamedFrom)
+
+		typeConstraint, renamedFrom = extractRenamedFrom("renamed_from=`old` VARCHAR(10)  DEFAULT 'renamed_from=x'")
+		assert.Equal(t, "VARCHAR(10)  DEFAULT 'renamed_from=x'", typeConstraint)
+		assert.Equal(t, "`old`", renamedFrom)
+	})
+
+	t.Run("success,in quoted literal", func(t *testing.T) {
+		t.Parallel()
+
+		typeConstraint, renamedFrom := extractRenamedFrom("TEXT NOT NULL DEFAULT 'x renamed_from=y'")
+		assert.Equal(t, "TEXT NOT NULL DEFAULT 'x renamed_from=y'", typeConstraint)
+		assert.Equal(t, "", renamedFrom)
+
+		typeConstraint, renamedFrom = extractRenamedFrom("TEXT DEFAULT 'it''s renamed_from=y' CHECK (\"x renamed_from=z\" <> '') renamed_from=old")
+		assert.Equal(t, "TEXT DEFAULT 'it''s renamed_from=y' CHECK (\"x renamed_from=z\" <> '')", typeConstraint)
+		assert.Equal(t, "old", renamedFrom)
+	})
+
+	t.Run("success,no-renamed_from", func(t *testing.T) {
+		t.Parallel()
+
+		typeConstraint, renamedFrom := extractRenamedFrom("TEXT  NOT NULL")
+		assert.Equal(t, "TEXT  NOT NULL", typeConstraint)
+		assert.Equal(t, "", renamedFrom)
+	})
+}
@@ -0,0 +1,148 @@
+package ddlctlgo
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+)
+
+// structField is a field of a struct, or of a struct embedded in it.
+type structField struct {
+	Var *types.Var
+	Tag reflect.StructTag
+	// Field is the syntax of the field, which is nil if the source of the field is not found.
+	Field *ast.Field
+}
+
+// TypeString returns the type of the field as written in the source if possible.
+func (f *structField) TypeString() string {
+	if f.Field != nil {
+		return types.ExprString(f.Field.Type)
+	}
+	return types.TypeString(f.Var.Type(), packageName)
+}
+
+func packageName(pkg *types.Package) string {
+	return pkg.Name()
+}
+
+// fieldIndex finds the syntax of the struct fields by their positions, to read their doc comments and type expressions.
+// The files are indexed only when their fields are looked up.
+type fieldIndex struct {
+	fset    *token.FileSet
+	syntax  map[*token.File]*ast.File
+	fields  map[token.Pos]*ast.Field // key: position of the field name
+	indexed map[*token.File]bool
+}
+
+func newFieldIndex(file *sourceFile) *fieldIndex {
+	return &fieldIndex{
+		fset:    file.Package.Fset,
+		syntax:  file.Syntax,
+		fields:  make(map[token.Pos]*ast.Field),
+		indexed: make(map[*token.File]bool),
+	}
+}
+
+func (idx *fieldIndex) lookup(v *types.Var) *ast.Field {
+	tokenFile := idx.fset.File(v.Pos())
+	if tokenFile != nil && !idx.indexed[tokenFile] {
+		idx.indexed[tokenFile] = true
+		if f, ok := idx.syntax[tokenFile]; ok {
+			idx.add(f)
+		}
+	}
+	return idx.fields[v.Pos()]
+}
+
+func (idx *fieldIndex) add(f *ast.File) {
+	ast.Inspect(f, func(node ast.Node) bool {
+		structType, ok := node.(*ast.StructType)
+		if !ok {
+			return true
+		}
+		for _, field := range structType.Fields.List {
+			names := field.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{embeddedFieldIdent(field.Type)}
+			}
+			for _, name := range names {
+				if name != nil {
+					idx.fields[name.Pos()] = field
+				}
+			}
+		}
+		return true
+	})
+}
+
+// embeddedFieldIdent returns the identifier of the embedded field, which is the field name.
+func embeddedFieldIdent(expr ast.Expr) *ast.Ident {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return embeddedFieldIdent(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel
+	case *ast.IndexExpr:
+		return embeddedFieldIdent(t.X)
+	case *ast.IndexListExpr:
+		return embeddedFieldIdent(t.X)
+	case *ast.Ident:
+		return t
+	default:
+		return nil
+	}
+}
+
+// Fields returns the fields of structType. The fields of the embedded structs, in the same package or across packages,
+// are flattened in order, and the field that declares several names, such as `A, B string`, is split into each name.
+// The embedded types that cannot be resolved as structs are returned as unresolved.
+func (idx *fieldIndex) Fields(structType *types.Struct) (fields []*structField, unresolved []string) {
+	return idx.flatten(structType, map[*types.Struct]bool{structType: true})
+}
+
+func (idx *fieldIndex) flatten(structType *types.Struct, visited map[*types.Struct]bool) (fields []*structField, unresolved []string) {
+	for i := 0; i < structType.NumFields(); i++ {
+		field := &structField{Var: structType.Field(i), Tag: reflect.StructTag(structType.Tag(i))}
+		field.Field = idx.lookup(field.Var)
+		if !field.Var.Embedded() {
+			fields = append(fields, field)
+			continue
+		}
+
+		// NOTE: embedded field
+		if field.Tag.Get(config.ColumnTagGo()) == "-" {
+			continue
+		}
+		if field.Tag.Get(config.DDLTagGo()) != "" {
+			// MEMO: The embedded field that has the DDL annotation is a column named after its type, as Go promotes it.
+			fields = append(fields, field)
+			continue
+		}
+
+		embedded, ok := underlyingStruct(field.Var.Type())
+		if !ok || visited[embedded] {
+			unresolved = append(unresolved, field.TypeString())
+			continue
+		}
+		visited[embedded] = true
+		embeddedFields, embeddedUnresolved := idx.flatten(embedded, visited)
+		delete(visited, embedded)
+		fields = append(fields, embeddedFields...)
+		unresolved = append(unresolved, embeddedUnresolved...)
+	}
+
+	return fields, unresolved
+}
+
+// underlyingStruct returns the struct type of typ, following the pointer, the type aliases, and the defined types.
+func underlyingStruct(typ types.Type) (*types.Struct, bool) {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	structType, ok := typ.Underlying().(*types.Struct)
+	return structType, ok
+}
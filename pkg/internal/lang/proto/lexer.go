@@ -0,0 +1,192 @@
+package ddlctlproto
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	// tokenIdent is an identifier or a keyword, including the full identifier such as "google.protobuf.Timestamp".
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenSymbol
+)
+
+type token struct {
+	Kind tokenKind
+	// Text is the unquoted value for tokenString, otherwise the token as written.
+	Text string
+	Line int
+	// Leading is the comment group just before the token, which is not separated by a blank line.
+	Leading []*comment
+	// Trailing is the comments after the token on the same line.
+	Trailing []*comment
+}
+
+// comment is a line of comment without the comment markers such as "//" and "/*".
+type comment struct {
+	Text string
+	Line int
+}
+
+// lexer splits the proto source into tokens, and attaches the comments to them.
+type lexer struct {
+	src  []rune
+	pos  int
+	line int
+
+	tokens []*token
+	// pending is the comment group that is attached to the next token.
+	pending []*comment
+}
+
+func tokenize(src string) ([]*token, error) {
+	l := &lexer{src: []rune(src), line: 1}
+	if err := l.run(); err != nil {
+		return nil, apperr.Errorf("lexer.run: %w", err)
+	}
+	return l.tokens, nil
+}
+
+//nolint:cyclop,funlen
+func (l *lexer) run() error {
+	for {
+		l.skipSpaces()
+		if l.pos >= len(l.src) {
+			l.emit(&token{Kind: tokenEOF, Line: l.line})
+			return nil
+		}
+
+		r := l.src[l.pos]
+		switch {
+		case r == '/' && l.peek(1) == '/':
+			line := l.line
+			start := l.pos + 2
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+			l.addComment(line, []string{string(l.src[start:l.pos])})
+		case r == '/' && l.peek(1) == '*':
+			line := l.line
+			end := strings.Index(string(l.src[l.pos+2:]), "*/")
+			if end < 0 {
+				return apperr.Errorf("line %d: unterminated comment", line)
+			}
+			body := []rune(string(l.src[l.pos+2:])[:end])
+			l.pos += 2 + len(body) + 2
+			lines := strings.Split(string(body), "\n")
+			l.line += len(lines) - 1
+			for i := range lines {
+				lines[i] = strings.TrimPrefix(strings.TrimLeftFunc(lines[i], unicode.IsSpace), "*")
+			}
+			l.addComment(line, lines)
+		case r == '"' || r == '\'':
+			line := l.line
+			value, err := l.readString(r)
+			if err != nil {
+				return apperr.Errorf("line %d: %w", line, err)
+			}
+			l.emit(&token{Kind: tokenString, Text: value, Line: line})
+		case unicode.IsDigit(r) || (r == '.' && unicode.IsDigit(l.peek(1))):
+			start := l.pos
+			for l.pos < len(l.src) && (isIdentRune(l.src[l.pos]) || l.src[l.pos] == '.' ||
+				((l.src[l.pos] == '+' || l.src[l.pos] == '-') && (l.src[l.pos-1] == 'e' || l.src[l.pos-1] == 'E'))) {
+				l.pos++
+			}
+			l.emit(&token{Kind: tokenNumber, Text: string(l.src[start:l.pos]), Line: l.line})
+		case isIdentRune(r) || (r == '.' && isIdentRune(l.peek(1))):
+			start := l.pos
+			for l.pos < len(l.src) && (isIdentRune(l.src[l.pos]) || l.src[l.pos] == '.') {
+				l.pos++
+			}
+			l.emit(&token{Kind: tokenIdent, Text: string(l.src[start:l.pos]), Line: l.line})
+		default:
+			l.pos++
+			l.emit(&token{Kind: tokenSymbol, Text: string(r), Line: l.line})
+		}
+	}
+}
+
+func (l *lexer) peek(n int) rune {
+	if l.pos+n >= len(l.src) {
+		return 0
+	}
+	return l.src[l.pos+n]
+}
+
+// skipSpaces skips the white spaces, and discards the pending comment group if it is followed by a blank line.
+func (l *lexer) skipSpaces() {
+	newlines := 0
+	for l.pos < len(l.src) && unicode.IsSpace(l.src[l.pos]) {
+		if l.src[l.pos] == '\n' {
+			l.line++
+			newlines++
+		}
+		l.pos++
+	}
+	if newlines > 1 {
+		l.pending = nil
+	}
+}
+
+func (l *lexer) addComment(line int, lines []string) {
+	comments := make([]*comment, 0, len(lines))
+	for i, text := range lines {
+		comments = append(comments, &comment{Text: strings.TrimSpace(text), Line: line + i})
+	}
+
+	// NOTE: The comment on the same line as the previous token is the trailing comment of it, such as `string id = 1; // comment`.
+	if len(l.pending) == 0 && len(l.tokens) > 0 {
+		if last := l.tokens[len(l.tokens)-1]; last.Line == line {
+			last.Trailing = append(last.Trailing, comments...)
+			return
+		}
+	}
+	l.pending = append(l.pending, comments...)
+}
+
+func (l *lexer) emit(t *token) {
+	t.Leading, l.pending = l.pending, nil
+	l.tokens = append(l.tokens, t)
+}
+
+func (l *lexer) readString(quote rune) (string, error) {
+	l.pos++ // opening quote
+	start := l.pos
+	for l.pos < len(l.src) && l.src[l.pos] != quote {
+		if l.src[l.pos] == '\n' {
+			return "", apperr.Errorf("unterminated string")
+		}
+		if l.src[l.pos] == '\\' {
+			l.pos++
+		}
+		l.pos++
+	}
+	if l.pos >= len(l.src) {
+		return "", apperr.Errorf("unterminated string")
+	}
+	raw := string(l.src[start:l.pos])
+	l.pos++ // closing quote
+
+	var b strings.Builder
+	for len(raw) > 0 {
+		r, _, tail, err := strconv.UnquoteChar(raw, byte(quote))
+		if err != nil {
+			return "", apperr.Errorf("strconv.UnquoteChar: %w", err)
+		}
+		b.WriteRune(r)
+		raw = tail
+	}
+	return b.String(), nil
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,267 @@
+package ddlctlproto
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	osz "github.com/kunitsucom/util.go/os"
+	filepathz "github.com/kunitsucom/util.go/path/filepath"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/generator"
+	langutil "github.com/kunitsucom/ddlctl/pkg/internal/lang/util"
+	"github.com/kunitsucom/ddlctl/pkg/internal/util"
+	"github.com/kunitsucom/ddlctl/pkg/logs"
+)
+
+const Language = "proto"
+
+const (
+	fileSuffix           = ".proto"
+	packagePatternSuffix = "..."
+)
+
+// Parse parses the proto source, which is a file, or a directory that has the proto files in it and its subdirectories.
+// The annotation keys are the same as the Go source, which are specified by --go-ddl-tag, --go-column-tag and --go-pk-tag,
+// and the files that do not have the DDL annotation are skipped in the directory.
+func Parse(ctx context.Context, src string) (*generator.DDL, error) {
+	ddl := generator.NewDDL(ctx)
+
+	// MEMO: "./protos/..." is the same as the directory, for the consistency with the Go source.
+	if dir := strings.TrimSuffix(src, "/"+packagePatternSuffix); dir != src && osz.IsDir(dir) {
+		src = dir
+	}
+
+	sourceAbs := util.Abs(src)
+
+	info, err := os.Stat(sourceAbs)
+	if err != nil {
+		return nil, apperr.Errorf("os.Stat: %w", err)
+	}
+
+	if info.IsDir() {
+		if err := filepath.WalkDir(sourceAbs, walkDirFn(ctx, ddl)); err != nil {
+			return nil, apperr.Errorf("filepath.WalkDir: %w", err)
+		}
+
+		return ddl, nil
+	}
+
+	stmts, err := parseFile(ctx, sourceAbs)
+	if err != nil {
+		return nil, apperr.Errorf("parseFile: %w", err)
+	}
+	ddl.Stmts = append(ddl.Stmts, stmts...)
+
+	return ddl, nil
+}
+
+func walkDirFn(ctx context.Context, ddl *generator.DDL) func(path string, d fs.DirEntry, err error) error {
+	return func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err //nolint:wrapcheck
+		}
+
+		if d.IsDir() || !strings.HasSuffix(path, fileSuffix) {
+			return nil
+		}
+
+		stmts, err := parseFile(ctx, path)
+		if err != nil {
+			if errors.Is(err, apperr.ErrDDLTagGoAnnotationNotFoundInSource) {
+				logs.Debug.Printf("parseFile: %s: %v", path, err)
+				return nil
+			}
+			return apperr.Errorf("parseFile: %w", err)
+		}
+
+		ddl.Stmts = append(ddl.Stmts, stmts...)
+
+		return nil
+	}
+}
+
+func parseFile(_ context.Context, filename string) ([]generator.Stmt, error) {
+	src, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, apperr.Errorf("os.ReadFile: %w", err)
+	}
+
+	f, err := parse(string(src))
+	if err != nil {
+		return nil, apperr.Errorf("%s: parse: %w", filepathz.Short(filename), err)
+	}
+
+	stmts := make([]generator.Stmt, 0)
+	found := false
+	var walk func(messages []*message)
+	walk = func(messages []*message) {
+		for _, m := range messages {
+			if hasAnnotation(m.Comments) {
+				found = true
+				stmts = append(stmts, parseMessage(filename, m)...)
+			}
+			walk(m.Messages)
+		}
+	}
+	walk(f.Messages)
+
+	if !found {
+		return nil, apperr.Errorf("go-ddl-tag=%s: %w", config.DDLTagGo(), apperr.ErrDDLTagGoAnnotationNotFoundInSource)
+	}
+
+	sort.Slice(stmts, func(i, j int) bool {
+		return stmts[i].GetSourceLine() < stmts[j].GetSourceLine()
+	})
+
+	return stmts, nil
+}
+
+// isAnnotation reports whether the comment is the DDL annotation such as "ddlctl:table CREATE TABLE users".
+func isAnnotation(c *comment) bool {
+	rest, ok := strings.CutPrefix(c.Text, config.DDLTagGo())
+	return ok && strings.HasPrefix(strings.TrimLeftFunc(rest, unicode.IsSpace), ":")
+}
+
+func hasAnnotation(comments []*comment) bool {
+	for _, c := range comments {
+		if isAnnotation(c) {
+			return true
+		}
+	}
+	return false
+}
+
+//nolint:cyclop,funlen
+func parseMessage(filename string, m *message) []generator.Stmt {
+	createTableStmt := &generator.CreateTableStmt{SourceFile: filename, SourceLine: m.Line}
+	stmts := make([]generator.Stmt, 0)
+
+	// CREATE TABLE (or INDEX) / CONSTRAINT / OPTIONS (from comments)
+	sourceLineFound := false
+	for _, c := range m.Comments {
+		if !isAnnotation(c) {
+			createTableStmt.Comments = append(createTableStmt.Comments, c.Text)
+			continue
+		}
+		if !sourceLineFound {
+			// NOTE: The source line is the first annotation, the same as the Go source.
+			createTableStmt.SourceLine, sourceLineFound = c.Line, true
+		}
+
+		if /* CREATE INDEX */ matches := langutil.StmtRegexCreateIndex.Regex.FindStringSubmatch(c.Text); len(matches) > langutil.StmtRegexCreateIndex.Index {
+			createIndexStmt := &generator.CreateIndexStmt{
+				Comments:   []string{c.Text},
+				SourceFile: filename,
+				SourceLine: c.Line,
+			}
+			createIndexStmt.SetCreateIndex(matches[langutil.StmtRegexCreateIndex.Index])
+			stmts = append(stmts, createIndexStmt)
+			continue
+		}
+
+		if /* CREATE TABLE */ matches := langutil.StmtRegexCreateTable.Regex.FindStringSubmatch(c.Text); len(matches) > langutil.StmtRegexCreateTable.Index {
+			createTableStmt.SetCreateTable(matches[langutil.StmtRegexCreateTable.Index])
+		} else if /* CONSTRAINT */ matches := langutil.StmtRegexCreateTableConstraint.Regex.FindStringSubmatch(c.Text); len(matches) > langutil.StmtRegexCreateTableConstraint.Index {
+			createTableStmt.Constraints = append(createTableStmt.Constraints, &generator.CreateTableConstraint{
+				Constraint: matches[langutil.StmtRegexCreateTableConstraint.Index],
+			})
+		} else if /* OPTIONS */ matches := langutil.StmtRegexCreateTableOptions.Regex.FindStringSubmatch(c.Text); len(matches) > langutil.StmtRegexCreateTableOptions.Index {
+			createTableStmt.Options = append(createTableStmt.Options, &generator.CreateTableOption{
+				Option: matches[langutil.StmtRegexCreateTableOptions.Index],
+			})
+		}
+		createTableStmt.Comments = append(createTableStmt.Comments, c.Text)
+	}
+
+	// CREATE TABLE (default: message name)
+	if createTableStmt.CreateTable == "" {
+		createTableStmt.Comments = append(createTableStmt.Comments, fmt.Sprintf("WARN: the comment (%s:%d) does not have a key for table (%s: table: CREATE TABLE <table>), so the message name \"%s\" is used as the table name.", filepathz.Short(filename), createTableStmt.SourceLine, config.DDLTagGo(), m.Name))
+		createTableStmt.SetCreateTable(m.Name)
+	}
+
+	// columns
+	for _, f := range m.Fields {
+		columnName, typeConstraint, primaryKey, comments := fieldAnnotation(f)
+		if columnName == "-" {
+			createTableStmt.Comments = append(createTableStmt.Comments, fmt.Sprintf("NOTE: the \"%s\" message's \"%s\" field has a tag for column name (`%s:\"-\"`), so the field is ignored.", m.Name, f.Name, config.ColumnTagGo()))
+			continue
+		}
+		if typeConstraint == "" || typeConstraint == "-" {
+			// NOTE: ignore no-annotation fields
+			continue
+		}
+
+		column := &generator.CreateTableColumn{ColumnName: columnName, TypeConstraint: typeConstraint}
+		if column.ColumnName == "" {
+			// MEMO: The field name of proto is snake_case by the style guide, so it is used as the column name without warnings.
+			column.ColumnName = f.Name
+		}
+
+		// primary key
+		switch primaryKey {
+		case "true", "1":
+			createTableStmt.PrimaryKey = append(createTableStmt.PrimaryKey, column.ColumnName)
+		case "", "-", "false", "0":
+			// do nothing
+		default:
+			column.Comments = append(column.Comments, fmt.Sprintf("WARN: the field \"%s\" does not have valid primary key tag (`%s:\"true\"`), so the column is not used as primary key.", f.Name, config.PKTagGo()))
+		}
+
+		// comments
+		column.Comments = append(column.Comments, langutil.TrimCommentElementTailEmpty(comments)...)
+
+		createTableStmt.Columns = append(createTableStmt.Columns, column)
+	}
+
+	if len(createTableStmt.Columns) == 0 {
+		// NOTE: append only if there are columns
+		logs.Warn.Printf("parseMessage: %s:%d: %s", createTableStmt.SourceFile, createTableStmt.SourceLine, "no columns")
+		return stmts
+	}
+
+	return append(stmts, createTableStmt)
+}
+
+// fieldAnnotation returns the annotations of the field, and the comments of the field other than the annotations.
+// The annotations are the struct-tag-like comment such as `db:"user_id" ddlctl:"TEXT NOT NULL" pk:"true"`,
+// and the field options such as `[(ddlctl.db) = "user_id", (ddlctl) = "TEXT NOT NULL", (ddlctl.pk) = true]`, which take precedence.
+func fieldAnnotation(f *field) (columnName, typeConstraint, primaryKey string, comments []string) {
+	for _, c := range f.Comments {
+		tag := reflect.StructTag(c.Text)
+		if v, ok := tag.Lookup(config.DDLTagGo()); ok {
+			typeConstraint = v
+			columnName = tag.Get(config.ColumnTagGo())
+			primaryKey = tag.Get(config.PKTagGo())
+			continue
+		}
+		comments = append(comments, c.Text)
+	}
+
+	for _, o := range f.Options {
+		// MEMO: The custom option is matched by the last part of its name, such as "db" of "(ddlctl.db)".
+		name := strings.Trim(o.Name, "()")
+		if i := strings.LastIndexAny(name, ".)"); i >= 0 {
+			name = name[i+1:]
+		}
+		switch name {
+		case config.ColumnTagGo():
+			columnName = o.Value
+		case config.DDLTagGo():
+			typeConstraint = o.Value
+		case config.PKTagGo():
+			primaryKey = o.Value
+		}
+	}
+
+	return columnName, typeConstraint, primaryKey, comments
+}
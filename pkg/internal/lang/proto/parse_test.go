@@ -0,0 +1,130 @@
+//nolint:testpackage
+package ddlctlproto
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cliz "github.com/kunitsucom/util.go/exp/cli"
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+	"github.com/kunitsucom/ddlctl/pkg/internal/config"
+	"github.com/kunitsucom/ddlctl/pkg/internal/fixture"
+	"github.com/kunitsucom/ddlctl/pkg/internal/generator"
+)
+
+//nolint:paralleltest
+func TestParse(t *testing.T) {
+	cmd := fixture.Cmd()
+	_, err := cmd.Parse([]string{"ddlctl", "--lang=proto", "--dialect=postgres"})
+	require.NoError(t, err)
+	ctx := cliz.WithContext(context.Background(), cmd)
+	rollback := config.MustLoad(ctx)
+	t.Cleanup(rollback)
+
+	columnsOf := func(stmt *generator.CreateTableStmt) []string {
+		columns := make([]string, 0, len(stmt.Columns))
+		for _, column := range stmt.Columns {
+			columns = append(columns, column.ColumnName+" "+column.TypeConstraint)
+		}
+		return columns
+	}
+
+	t.Run("success,user.proto", func(t *testing.T) {
+		ddl, err := Parse(ctx, "tests/user.proto")
+		require.NoError(t, err)
+		require.Equal(t, 3, len(ddl.Stmts))
+
+		users, ok := ddl.Stmts[0].(*generator.CreateTableStmt)
+		require.True(t, ok)
+		assert.Equal(t, "CREATE TABLE IF NOT EXISTS public.users", users.CreateTable)
+		assert.Equal(t, 15, users.SourceLine)
+		assert.Equal(t, []string{
+			"user_id TEXT NOT NULL",
+			"username TEXT NOT NULL",
+			"age_years INT",
+			"labels JSONB NOT NULL DEFAULT '{}'",
+			"created_at TIMESTAMPTZ NOT NULL DEFAULT now()",
+			"email TEXT",
+		}, columnsOf(users))
+		assert.Equal(t, []string{"user_id"}, users.PrimaryKey)
+		require.Equal(t, 1, len(users.Constraints))
+		assert.Equal(t, `UNIQUE ("username")`, users.Constraints[0].Constraint)
+		assert.Equal(t, []string{"the user id"}, users.Columns[0].Comments)
+		assert.Equal(t, []string{"login name"}, users.Columns[1].Comments)
+
+		index, ok := ddl.Stmts[1].(*generator.CreateIndexStmt)
+		require.True(t, ok)
+		assert.Equal(t, `CREATE UNIQUE INDEX users_idx_name ON public.users ("username")`, index.CreateIndex)
+
+		events, ok := ddl.Stmts[2].(*generator.CreateTableStmt)
+		require.True(t, ok)
+		assert.Equal(t, "CREATE TABLE public.user_events", events.CreateTable)
+		assert.Equal(t, []string{"user_id TEXT NOT NULL", "seq BIGINT NOT NULL"}, columnsOf(events))
+		assert.Equal(t, []string{"user_id", "seq"}, events.PrimaryKey)
+	})
+
+	t.Run("success,info.IsDir", func(t *testing.T) {
+		ddl, err := Parse(ctx, "tests")
+		require.NoError(t, err)
+		assert.Equal(t, 3, len(ddl.Stmts))
+	})
+
+	t.Run("success,pattern", func(t *testing.T) {
+		ddl, err := Parse(ctx, "tests/...")
+		require.NoError(t, err)
+		assert.Equal(t, 3, len(ddl.Stmts))
+	})
+
+	t.Run("success,message name", func(t *testing.T) {
+		source := filepath.Join(t.TempDir(), "item.proto")
+		require.NoError(t, os.WriteFile(source, []byte(`syntax = "proto3";
+
+// ddlctl:constraint CHECK (price >= 0)
+message Item {
+  /* db:"item_id" ddlctl:"BIGINT NOT NULL" pk:"yes" */
+  int64 id = 1;
+  int64 price = 2 [(ddlctl) = "BIGINT NOT NULL"];
+  string memo = 3 [(ddlctl.db) = "-", (ddlctl) = "TEXT"];
+}
+`), 0o600))
+
+		ddl, err := Parse(ctx, source)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(ddl.Stmts))
+		item, ok := ddl.Stmts[0].(*generator.CreateTableStmt)
+		require.True(t, ok)
+		assert.Equal(t, "CREATE TABLE Item", item.CreateTable)
+		assert.Equal(t, []string{"item_id BIGINT NOT NULL", "price BIGINT NOT NULL"}, columnsOf(item))
+		assert.Equal(t, 0, len(item.PrimaryKey))
+		assert.Equal(t, []string{`WARN: the field "id" does not have valid primary key tag (` + "`" + `pk:"true"` + "`" + `), so the column is not used as primary key.`}, item.Columns[0].Comments)
+	})
+
+	t.Run("failure,no-ddl-tag.proto", func(t *testing.T) {
+		_, err := Parse(ctx, "tests/no-ddl-tag.proto")
+		require.ErrorIs(t, err, apperr.ErrDDLTagGoAnnotationNotFoundInSource)
+	})
+
+	t.Run("failure,parse", func(t *testing.T) {
+		source := filepath.Join(t.TempDir(), "broken.proto")
+		require.NoError(t, os.WriteFile(source, []byte(`// ddlctl:table users
+message User {
+  string id = ;
+}
+`), 0o600))
+
+		_, err := Parse(ctx, source)
+		require.ErrorIs(t, err, ddl.ErrUnexpectedCurrentToken)
+		assert.ErrorContains(t, err, "line 3")
+	})
+
+	t.Run("failure,os.Stat", func(t *testing.T) {
+		_, err := Parse(ctx, "tests/not-found.proto")
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
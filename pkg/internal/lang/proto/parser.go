@@ -0,0 +1,378 @@
+package ddlctlproto
+
+import (
+	"strings"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+// MEMO: https://protobuf.dev/reference/protobuf/proto3-spec/
+
+// protoFile is the messages in a proto file. The other definitions such as enums and services are skipped.
+type protoFile struct {
+	Messages []*message
+}
+
+type message struct {
+	Name     string
+	Line     int
+	Comments []*comment
+	Fields   []*field
+	// Messages are the nested messages.
+	Messages []*message
+}
+
+type field struct {
+	Name string
+	// Type is the type as written, such as "string", "google.protobuf.Timestamp" or "map<string, int64>".
+	Type     string
+	Line     int
+	Comments []*comment
+	Options  []*fieldOption
+}
+
+type fieldOption struct {
+	// Name is the option name as written, such as "deprecated" or "(ddlctl.db)".
+	Name string
+	// Value is the unquoted value for a string, otherwise the value as written.
+	Value string
+}
+
+// parser is a hand-written parser of the proto files, which reads the messages and their fields only.
+type parser struct {
+	tokens []*token
+	pos    int
+}
+
+func parse(src string) (*protoFile, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, apperr.Errorf("tokenize: %w", err)
+	}
+
+	p := &parser{tokens: tokens}
+	f := &protoFile{}
+	for p.current().Kind != tokenEOF {
+		switch p.current().Text {
+		case "message":
+			m, err := p.parseMessage()
+			if err != nil {
+				return nil, apperr.Errorf("parseMessage: %w", err)
+			}
+			f.Messages = append(f.Messages, m)
+		case "enum", "service", "extend":
+			if err := p.skipDefinition(); err != nil {
+				return nil, apperr.Errorf("skipDefinition: %w", err)
+			}
+		case ";":
+			p.next()
+		default: // syntax, edition, package, import, option
+			if err := p.skipStatement(); err != nil {
+				return nil, apperr.Errorf("skipStatement: %w", err)
+			}
+		}
+	}
+
+	return f, nil
+}
+
+func (p *parser) current() *token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() *token {
+	t := p.tokens[p.pos]
+	if t.Kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokenKind, text string) (*token, error) {
+	t := p.current()
+	if t.Kind != kind || (text != "" && t.Text != text) {
+		return nil, apperr.Errorf("line %d: expected=%q, current=%q: %w", t.Line, text, t.Text, ddl.ErrUnexpectedCurrentToken)
+	}
+	return p.next(), nil
+}
+
+//nolint:cyclop
+func (p *parser) parseMessage() (*message, error) {
+	keyword := p.next() // message
+	name, err := p.expect(tokenIdent, "")
+	if err != nil {
+		return nil, apperr.Errorf("expect: %w", err)
+	}
+	if _, err := p.expect(tokenSymbol, "{"); err != nil {
+		return nil, apperr.Errorf("expect: %w", err)
+	}
+
+	m := &message{Name: name.Text, Line: keyword.Line, Comments: keyword.Leading}
+	for {
+		switch t := p.current(); {
+		case t.Kind == tokenEOF:
+			return nil, apperr.Errorf("line %d: message %s is not closed: %w", m.Line, m.Name, ddl.ErrUnexpectedCurrentToken)
+		case t.Text == "}":
+			p.next()
+			return m, nil
+		case t.Text == "message":
+			nested, err := p.parseMessage()
+			if err != nil {
+				return nil, apperr.Errorf("parseMessage: %w", err)
+			}
+			m.Messages = append(m.Messages, nested)
+		case t.Text == "oneof":
+			fields, err := p.parseOneof()
+			if err != nil {
+				return nil, apperr.Errorf("parseOneof: %w", err)
+			}
+			m.Fields = append(m.Fields, fields...)
+		case t.Text == "enum", t.Text == "extend":
+			if err := p.skipDefinition(); err != nil {
+				return nil, apperr.Errorf("skipDefinition: %w", err)
+			}
+		case t.Text == "option", t.Text == "reserved", t.Text == "extensions":
+			if err := p.skipStatement(); err != nil {
+				return nil, apperr.Errorf("skipStatement: %w", err)
+			}
+		case t.Text == ";":
+			p.next()
+		default:
+			f, err := p.parseField()
+			if err != nil {
+				return nil, apperr.Errorf("parseField: %w", err)
+			}
+			if f != nil {
+				m.Fields = append(m.Fields, f)
+			}
+		}
+	}
+}
+
+// parseOneof returns the fields in the oneof, which are the fields of the message.
+func (p *parser) parseOneof() ([]*field, error) {
+	p.next() // oneof
+	if _, err := p.expect(tokenIdent, ""); err != nil {
+		return nil, apperr.Errorf("expect: %w", err)
+	}
+	if _, err := p.expect(tokenSymbol, "{"); err != nil {
+		return nil, apperr.Errorf("expect: %w", err)
+	}
+
+	fields := make([]*field, 0)
+	for {
+		switch t := p.current(); {
+		case t.Kind == tokenEOF:
+			return nil, apperr.Errorf("line %d: oneof is not closed: %w", t.Line, ddl.ErrUnexpectedCurrentToken)
+		case t.Text == "}":
+			p.next()
+			return fields, nil
+		case t.Text == "option":
+			if err := p.skipStatement(); err != nil {
+				return nil, apperr.Errorf("skipStatement: %w", err)
+			}
+		case t.Text == ";":
+			p.next()
+		default:
+			f, err := p.parseField()
+			if err != nil {
+				return nil, apperr.Errorf("parseField: %w", err)
+			}
+			if f != nil {
+				fields = append(fields, f)
+			}
+		}
+	}
+}
+
+// parseField parses the field such as `repeated string tags = 1 [(ddlctl) = "TEXT"];`.
+// The group of proto2 is skipped, and nil is returned for it.
+//
+//nolint:cyclop
+func (p *parser) parseField() (*field, error) {
+	first := p.current()
+	f := &field{Line: first.Line, Comments: first.Leading}
+
+	if t := p.current(); t.Text == "optional" || t.Text == "required" || t.Text == "repeated" {
+		p.next()
+	}
+	if p.current().Text == "group" {
+		if err := p.skipDefinition(); err != nil {
+			return nil, apperr.Errorf("skipDefinition: %w", err)
+		}
+		return nil, nil //nolint:nilnil
+	}
+
+	typ, err := p.expect(tokenIdent, "")
+	if err != nil {
+		return nil, apperr.Errorf("expect: %w", err)
+	}
+	f.Type = typ.Text
+	if typ.Text == "map" && p.current().Text == "<" {
+		// map<KeyType, ValueType>
+		var params []string
+		for p.next().Text != ">" {
+			t, err := p.expect(tokenIdent, "")
+			if err != nil {
+				return nil, apperr.Errorf("expect: %w", err)
+			}
+			params = append(params, t.Text)
+			if p.current().Text != "," && p.current().Text != ">" {
+				return nil, apperr.Errorf("line %d: current=%q: %w", p.current().Line, p.current().Text, ddl.ErrUnexpectedCurrentToken)
+			}
+		}
+		f.Type = "map<" + strings.Join(params, ", ") + ">"
+	}
+
+	name, err := p.expect(tokenIdent, "")
+	if err != nil {
+		return nil, apperr.Errorf("expect: %w", err)
+	}
+	f.Name = name.Text
+	if _, err := p.expect(tokenSymbol, "="); err != nil {
+		return nil, apperr.Errorf("expect: %w", err)
+	}
+	if _, err := p.expect(tokenNumber, ""); err != nil {
+		return nil, apperr.Errorf("expect: %w", err)
+	}
+
+	if p.current().Text == "[" {
+		p.next()
+		for {
+			option, err := p.parseFieldOption()
+			if err != nil {
+				return nil, apperr.Errorf("parseFieldOption: %w", err)
+			}
+			f.Options = append(f.Options, option)
+			if p.current().Text != "," {
+				break
+			}
+			p.next()
+		}
+		if _, err := p.expect(tokenSymbol, "]"); err != nil {
+			return nil, apperr.Errorf("expect: %w", err)
+		}
+	}
+
+	end, err := p.expect(tokenSymbol, ";")
+	if err != nil {
+		return nil, apperr.Errorf("expect: %w", err)
+	}
+	f.Comments = append(f.Comments, end.Trailing...)
+
+	return f, nil
+}
+
+// parseFieldOption parses the option such as `(ddlctl.db) = "user_id"` or `deprecated = true`.
+//
+//nolint:cyclop
+func (p *parser) parseFieldOption() (*fieldOption, error) {
+	var name strings.Builder
+	if p.current().Text == "(" {
+		name.WriteString(p.next().Text)
+		for t := p.next(); t.Text != ")"; t = p.next() {
+			if t.Kind == tokenEOF {
+				return nil, apperr.Errorf("line %d: option name is not closed: %w", t.Line, ddl.ErrUnexpectedCurrentToken)
+			}
+			name.WriteString(t.Text)
+		}
+		name.WriteString(")")
+	}
+	if t := p.current(); t.Kind == tokenIdent {
+		name.WriteString(p.next().Text)
+	}
+	if name.Len() == 0 {
+		return nil, apperr.Errorf("line %d: current=%q: %w", p.current().Line, p.current().Text, ddl.ErrUnexpectedCurrentToken)
+	}
+	option := &fieldOption{Name: name.String()}
+
+	if _, err := p.expect(tokenSymbol, "="); err != nil {
+		return nil, apperr.Errorf("expect: %w", err)
+	}
+
+	switch t := p.current(); {
+	case t.Kind == tokenString:
+		// MEMO: The adjacent strings are concatenated.
+		var value strings.Builder
+		for p.current().Kind == tokenString {
+			value.WriteString(p.next().Text)
+		}
+		option.Value = value.String()
+	case t.Text == "{":
+		start := p.pos
+		if err := p.skipBlock(); err != nil {
+			return nil, apperr.Errorf("skipBlock: %w", err)
+		}
+		option.Value = joinTokens(p.tokens[start:p.pos])
+	case t.Text == "-" || t.Text == "+":
+		p.next()
+		number := p.next()
+		option.Value = t.Text + number.Text
+	case t.Kind == tokenIdent || t.Kind == tokenNumber:
+		option.Value = p.next().Text
+	default:
+		return nil, apperr.Errorf("line %d: current=%q: %w", t.Line, t.Text, ddl.ErrUnexpectedCurrentToken)
+	}
+
+	return option, nil
+}
+
+// skipDefinition skips the definition that has a body, such as `enum Status { ... }`.
+func (p *parser) skipDefinition() error {
+	for t := p.current(); t.Kind != tokenSymbol || t.Text != "{"; t = p.current() {
+		if t := p.next(); t.Kind == tokenEOF {
+			return apperr.Errorf("line %d: %w", t.Line, ddl.ErrUnexpectedCurrentToken)
+		}
+	}
+	if err := p.skipBlock(); err != nil {
+		return apperr.Errorf("skipBlock: %w", err)
+	}
+	return nil
+}
+
+// skipStatement skips the statement until the semicolon, such as `option go_package = "...";`.
+func (p *parser) skipStatement() error {
+	for {
+		switch t := p.current(); {
+		case t.Kind == tokenEOF:
+			return apperr.Errorf("line %d: %w", t.Line, ddl.ErrUnexpectedCurrentToken)
+		case t.Kind == tokenSymbol && t.Text == ";":
+			p.next()
+			return nil
+		case t.Kind == tokenSymbol && t.Text == "{":
+			if err := p.skipBlock(); err != nil {
+				return apperr.Errorf("skipBlock: %w", err)
+			}
+		default:
+			p.next()
+		}
+	}
+}
+
+// skipBlock skips the block from the current "{" to the corresponding "}".
+func (p *parser) skipBlock() error {
+	depth := 0
+	for {
+		t := p.next()
+		switch {
+		case t.Kind == tokenEOF:
+			return apperr.Errorf("line %d: block is not closed: %w", t.Line, ddl.ErrUnexpectedCurrentToken)
+		case t.Kind == tokenSymbol && t.Text == "{":
+			depth++
+		case t.Kind == tokenSymbol && t.Text == "}":
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+func joinTokens(tokens []*token) string {
+	texts := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		texts = append(texts, t.Text)
+	}
+	return strings.Join(texts, " ")
+}
@@ -0,0 +1,119 @@
+//nolint:testpackage
+package ddlctlproto
+
+import (
+	"testing"
+
+	"github.com/kunitsucom/util.go/testing/assert"
+	"github.com/kunitsucom/util.go/testing/require"
+
+	"github.com/kunitsucom/ddlctl/pkg/ddl"
+)
+
+func commentTexts(comments []*comment) []string {
+	texts := make([]string, 0, len(comments))
+	for _, c := range comments {
+		texts = append(texts, c.Text)
+	}
+	return texts
+}
+
+func Test_tokenize(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success,comments", func(t *testing.T) {
+		t.Parallel()
+
+		tokens, err := tokenize(`// detached
+
+// leading 1
+/* leading 2
+ * leading 3 */
+message A { // trailing
+  string s = 1 [(x) = 'it\'s'];
+}
+`)
+		require.NoError(t, err)
+
+		message := tokens[0]
+		assert.Equal(t, "message", message.Text)
+		assert.Equal(t, 6, message.Line)
+		assert.Equal(t, []string{"leading 1", "leading 2", "leading 3"}, commentTexts(message.Leading))
+		assert.Equal(t, 4, message.Leading[1].Line)
+		assert.Equal(t, []string{"trailing"}, commentTexts(tokens[2].Trailing))
+
+		var str *token
+		for _, tok := range tokens {
+			if tok.Kind == tokenString {
+				str = tok
+			}
+		}
+		require.NotNil(t, str)
+		assert.Equal(t, "it's", str.Text)
+	})
+
+	t.Run("failure,unterminated", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := tokenize(`/* comment`)
+		require.ErrorContains(t, err, "unterminated comment")
+		_, err = tokenize(`option x = "string;`)
+		require.ErrorContains(t, err, "unterminated string")
+	})
+}
+
+func Test_parse(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		f, err := parse(`syntax = "proto2";
+import public "other.proto";
+option (my.option) = { a: 1 b: { c: "}" } };
+enum E { E_UNSPECIFIED = 0; }
+message A {
+  option (my.message) = true;
+  reserved 2, 15, 9 to 11;
+  extensions 100 to 199;
+  optional .pkg.B b = 1 [default = -1.5e-3, (my.field).sub = "x" "y"];
+  repeated group Result = 3 { required string url = 4; }
+  map<string, B> bs = 5;
+  oneof o { int32 i = 6; option (my.oneof) = 1; }
+  message B { ; }
+};
+service S { rpc M(A) returns (A); }
+`)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(f.Messages))
+
+		a := f.Messages[0]
+		assert.Equal(t, "A", a.Name)
+		require.Equal(t, 3, len(a.Fields))
+		assert.Equal(t, ".pkg.B", a.Fields[0].Type)
+		assert.Equal(t, []*fieldOption{{Name: "default", Value: "-1.5e-3"}, {Name: "(my.field).sub", Value: "xy"}}, a.Fields[0].Options)
+		assert.Equal(t, "map<string, B>", a.Fields[1].Type)
+		assert.Equal(t, "bs", a.Fields[1].Name)
+		assert.Equal(t, "i", a.Fields[2].Name)
+		require.Equal(t, 1, len(a.Messages))
+		assert.Equal(t, "B", a.Messages[0].Name)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		for _, src := range []string{
+			`message {`,
+			`message A {`,
+			`message A { string = 1; }`,
+			`message A { map<string string> m = 1; }`,
+			`message A { string s = 1 [deprecated] ; }`,
+			`message A { oneof o { string s = 1; }`,
+			`enum E {`,
+			`syntax = "proto3"`,
+		} {
+			_, err := parse(src)
+			assert.ErrorIs(t, err, ddl.ErrUnexpectedCurrentToken)
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package util
+
+import (
+	"encoding/json"
+	"io"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+)
+
+// WriteJSON writes v as the indented JSON for `--output json`.
+func WriteJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return apperr.Errorf("enc.Encode: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package cockroachdb
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 
 	sqlz "github.com/kunitsucom/util.go/database/sql"
 
@@ -14,21 +16,138 @@ type sqlQueryerContext = interface {
 }
 
 const (
+	// MEMO: SHOW CREATE ALL TABLES also returns CREATE SEQUENCE and CREATE VIEW statements in dependency order,
+	// so information_schema.sequences does not need to be read separately.
 	queryShowCreateAllTables = `-- CREATE TABLE
 SHOW CREATE ALL TABLES
 ;
+`
+	// MEMO: The public schema exists by default.
+	formatShowCreateAllSchemas = `-- CREATE SCHEMA
+SELECT
+    'CREATE SCHEMA ' || quote_ident(schema_name) || ';' AS create_statement
+FROM
+    information_schema.schemata
+WHERE
+    catalog_name = current_database() AND %s AND schema_name <> 'public'
+ORDER BY
+    schema_name
+;
+`
+	// MEMO: SHOW CREATE ALL TABLES cannot be filtered by schema, so crdb_internal.create_statements which it is built on is read instead.
+	// As SHOW CREATE ALL TABLES does, the foreign keys are added and validated after all the tables are created.
+	formatShowCreateAllTablesInSchemas = `-- CREATE TABLE
+SELECT
+    s.create_statement
+FROM
+    (
+        SELECT 0 AS stmt_order, c.descriptor_id, c.create_nofks || ';' AS create_statement
+        FROM crdb_internal.create_statements c
+        WHERE c.database_name = current_database() AND NOT c.is_temporary AND %[1]s
+        UNION ALL
+        SELECT 1 AS stmt_order, c.descriptor_id, a || ';' AS create_statement
+        FROM crdb_internal.create_statements c, unnest(c.alter_statements) AS a
+        WHERE c.database_name = current_database() AND NOT c.is_temporary AND %[1]s
+        UNION ALL
+        SELECT 2 AS stmt_order, c.descriptor_id, v || ';' AS create_statement
+        FROM crdb_internal.create_statements c, unnest(c.validate_statements) AS v
+        WHERE c.database_name = current_database() AND NOT c.is_temporary AND %[1]s
+    ) s
+ORDER BY
+    s.stmt_order, s.descriptor_id
+;
 `
 )
 
-func ShowCreateAllTables(ctx context.Context, db sqlQueryerContext) (query string, err error) {
+type showCreateAllTablesConfig struct {
+	schemas        []string
+	excludeSchemas []string
+}
+
+// schemaCondition returns the SQL condition that column is one of the target schemas.
+// If only the excluded schemas are specified, all the schemas except them and the system schemas are the target.
+func (cfg *showCreateAllTablesConfig) schemaCondition(column string) string {
+	conditions := make([]string, 0)
+	if len(cfg.schemas) > 0 {
+		conditions = append(conditions, column+" IN ("+quoteLiterals(cfg.schemas)+")")
+	} else {
+		conditions = append(conditions, column+" NOT IN ('crdb_internal', 'information_schema', 'pg_catalog', 'pg_extension') AND "+column+" NOT LIKE 'pg\\_temp%'")
+	}
+	if len(cfg.excludeSchemas) > 0 {
+		conditions = append(conditions, column+" NOT IN ("+quoteLiterals(cfg.excludeSchemas)+")")
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+func quoteLiterals(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, "'"+strings.ReplaceAll(v, "'", "''")+"'")
+	}
+	return strings.Join(quoted, ", ")
+}
+
+type ShowCreateAllTablesOption interface {
+	apply(cfg *showCreateAllTablesConfig)
+}
+
+type showCreateAllTablesOptionSchemas struct{ schemas []string }
+
+func (o *showCreateAllTablesOptionSchemas) apply(config *showCreateAllTablesConfig) {
+	config.schemas = append(config.schemas, o.schemas...)
+}
+
+// WithShowCreateAllTablesOptionSchemas shows only the tables in the schemas.
+func WithShowCreateAllTablesOptionSchemas(schemas ...string) ShowCreateAllTablesOption { //nolint:ireturn
+	return &showCreateAllTablesOptionSchemas{schemas: schemas}
+}
+
+type showCreateAllTablesOptionExcludeSchemas struct{ schemas []string }
+
+func (o *showCreateAllTablesOptionExcludeSchemas) apply(config *showCreateAllTablesConfig) {
+	config.excludeSchemas = append(config.excludeSchemas, o.schemas...)
+}
+
+// WithShowCreateAllTablesOptionExcludeSchemas does not show the tables in the schemas.
+func WithShowCreateAllTablesOptionExcludeSchemas(schemas ...string) ShowCreateAllTablesOption { //nolint:ireturn
+	return &showCreateAllTablesOptionExcludeSchemas{schemas: schemas}
+}
+
+func ShowCreateAllTables(ctx context.Context, db sqlQueryerContext, opts ...ShowCreateAllTablesOption) (query string, err error) {
 	dbz := sqlz.NewDB(db)
 
+	cfg := &showCreateAllTablesConfig{}
+	for _, opt := range opts {
+		opt.apply(cfg)
+	}
+
 	type CreateStatement struct {
 		CreateStatement string `db:"create_statement"`
 	}
 
+	if len(cfg.schemas) == 0 && len(cfg.excludeSchemas) == 0 {
+		createTableStmts := new([]*CreateStatement)
+		if err := dbz.QueryContext(ctx, createTableStmts, queryShowCreateAllTables); err != nil {
+			return "", apperr.Errorf("dbz.QueryContext: %w", err)
+		}
+		for _, stmt := range *createTableStmts {
+			query += stmt.CreateStatement + "\n"
+		}
+
+		return query, nil
+	}
+
+	// MEMO: Schemas must be created before the objects in them.
+	createSchemaStmts := new([]*CreateStatement)
+	if err := dbz.QueryContext(ctx, createSchemaStmts, fmt.Sprintf(formatShowCreateAllSchemas, cfg.schemaCondition("schema_name"))); err != nil {
+		return "", apperr.Errorf("dbz.QueryContext: %w", err)
+	}
+	for _, stmt := range *createSchemaStmts {
+		query += stmt.CreateStatement + "\n"
+	}
+
 	createTableStmts := new([]*CreateStatement)
-	if err := dbz.QueryContext(ctx, createTableStmts, queryShowCreateAllTables); err != nil {
+	if err := dbz.QueryContext(ctx, createTableStmts, fmt.Sprintf(formatShowCreateAllTablesInSchemas, cfg.schemaCondition("c.schema_name"))); err != nil {
 		return "", apperr.Errorf("dbz.QueryContext: %w", err)
 	}
 	for _, stmt := range *createTableStmts {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 
 	sqlz "github.com/kunitsucom/util.go/database/sql"
 
@@ -15,67 +16,138 @@ type sqlQueryerContext = interface {
 }
 
 const (
+	// MEMO: The public schema exists by default.
+	formatShowCreateAllSchemas = `-- CREATE SCHEMA
+SELECT
+    'CREATE SCHEMA ' || quote_ident(n.nspname) || ';' AS create_statement
+FROM
+    pg_namespace n
+WHERE
+    %s AND n.nspname <> 'public'
+ORDER BY
+    n.nspname
+;
+`
+	formatShowCreateAllTypes = `-- CREATE TYPE
+SELECT
+    'CREATE TYPE ' || quote_ident(n.nspname) || '.' || quote_ident(t.typname) || ' AS ENUM (' ||
+    string_agg(quote_literal(e.enumlabel), ', ' ORDER BY e.enumsortorder) || ');' AS create_statement
+FROM
+    pg_type t
+JOIN
+    pg_enum e ON t.oid = e.enumtypid
+JOIN
+    pg_namespace n ON t.typnamespace = n.oid
+WHERE
+    %s
+GROUP BY
+    n.nspname, t.typname, t.oid
+ORDER BY
+    t.oid
+;
+`
+	formatShowCreateAllSequences = `-- CREATE SEQUENCE
+SELECT
+    'CREATE SEQUENCE ' || quote_ident(s.sequence_schema) || '.' || quote_ident(s.sequence_name) ||
+    ' AS ' || s.data_type ||
+    ' INCREMENT ' || s.increment ||
+    ' MINVALUE ' || s.minimum_value ||
+    ' MAXVALUE ' || s.maximum_value ||
+    ' START ' || s.start_value ||
+    ' CACHE ' || ps.cache_size ||
+    (CASE WHEN s.cycle_option = 'YES' THEN ' CYCLE' ELSE ' NO CYCLE' END) || ';' AS create_statement
+FROM
+    information_schema.sequences s
+JOIN
+    pg_sequences ps ON s.sequence_schema = ps.schemaname AND s.sequence_name = ps.sequencename
+WHERE
+    %s
+    -- MEMO: Sequences owned by a column such as SERIAL are a part of the table definition.
+    AND NOT EXISTS (
+        SELECT 1 FROM pg_depend d
+        WHERE d.classid = 'pg_class'::regclass
+            AND d.objid = (quote_ident(s.sequence_schema) || '.' || quote_ident(s.sequence_name))::regclass
+            AND d.deptype IN ('a', 'i')
+    )
+ORDER BY
+    s.sequence_schema, s.sequence_name
+;
+`
+	// MEMO: Column and constraint definitions are rebuilt from pg_catalog because information_schema loses
+	// array types, numeric precision and scale, collations, generated and identity columns, CHECK constraints and FK options.
+	// The expressions are printed as PostgreSQL deparses them (e.g. CHECK ((price > (0)::numeric))),
+	// and the type casts and the redundant parentheses are ignored when they are compared with the source.
 	formatShowCreateAllTables = `-- CREATE TABLE
 SELECT
-    'CREATE TABLE ' || clmn.table_schema || '.' || clmn.table_name || ' (' || E'\n' || '  ' ||
+    'CREATE TABLE ' || quote_ident(n.nspname) || '.' || quote_ident(c.relname) || ' (' || E'\n' || '  ' ||
     clmn.column_defs ||
     (CASE WHEN cnst.constraint_defs IS NOT NULL THEN ',' || E'\n' || '  ' || cnst.constraint_defs ELSE '' END) || E'\n' || ');' AS create_statement
 FROM
+    pg_class c
+JOIN
+    pg_namespace n ON c.relnamespace = n.oid
+JOIN
     (
         -- COLUMN DEFINITIONS
         SELECT
-            c.table_schema,
-            c.table_name,
+            a.attrelid,
             string_agg(
-                c.column_name || ' ' || c.data_type ||
-                (CASE WHEN c.character_maximum_length IS NOT NULL THEN '(' || c.character_maximum_length || ')' ELSE '' END) ||
-                (CASE WHEN c.is_nullable = 'NO' THEN ' NOT NULL' ELSE '' END) ||
-                (CASE WHEN c.column_default IS NOT NULL THEN ' DEFAULT ' || c.column_default ELSE '' END),
-                ',' || E'\n' || '  ' ORDER BY c.ordinal_position
+                quote_ident(a.attname) || ' ' || format_type(a.atttypid, a.atttypmod) ||
+                (CASE WHEN a.attcollation <> 0 AND a.attcollation <> t.typcollation THEN ' COLLATE ' || quote_ident(co.collname) ELSE '' END) ||
+                (CASE WHEN ad.adbin IS NOT NULL AND a.attgenerated = '' THEN ' DEFAULT ' || pg_get_expr(ad.adbin, ad.adrelid) ELSE '' END) ||
+                (CASE WHEN a.attnotnull THEN ' NOT NULL' ELSE '' END) ||
+                (CASE WHEN a.attgenerated = 's' THEN ' GENERATED ALWAYS AS (' || pg_get_expr(ad.adbin, ad.adrelid) || ') STORED' ELSE '' END) ||
+                (CASE a.attidentity WHEN 'a' THEN ' GENERATED ALWAYS AS IDENTITY' WHEN 'd' THEN ' GENERATED BY DEFAULT AS IDENTITY' ELSE '' END),
+                ',' || E'\n' || '  ' ORDER BY a.attnum
             ) AS column_defs
         FROM
-            information_schema.columns c
+            pg_attribute a
+        JOIN
+            pg_type t ON a.atttypid = t.oid
+        LEFT JOIN
+            pg_collation co ON a.attcollation = co.oid
+        LEFT JOIN
+            pg_attrdef ad ON a.attrelid = ad.adrelid AND a.attnum = ad.adnum
         WHERE
-            c.table_schema = '%s'
+            a.attnum > 0 AND NOT a.attisdropped
         GROUP BY
-            c.table_schema, c.table_name
-    ) clmn
+            a.attrelid
+    ) clmn ON c.oid = clmn.attrelid
 LEFT JOIN
     (
         -- CONSTRAINT DEFINITIONS
         SELECT
-            tc.table_schema,
-            tc.table_name,
+            con.conrelid,
             string_agg(
-                'CONSTRAINT ' || tc.constraint_name || ' ' || tc.constraint_type || ' (' || kcu.column_names || ')' ||
-                (CASE WHEN rc.update_rule IS NOT NULL THEN ' ON UPDATE ' || rc.update_rule ELSE '' END) ||
-                (CASE WHEN rc.delete_rule IS NOT NULL THEN ' ON DELETE ' || rc.delete_rule ELSE '' END),
-                ',' || E'\n' || '  '
+                'CONSTRAINT ' || quote_ident(con.conname) || ' ' || pg_get_constraintdef(con.oid),
+                ',' || E'\n' || '  ' ORDER BY (CASE con.contype WHEN 'p' THEN 0 WHEN 'u' THEN 1 WHEN 'f' THEN 2 ELSE 3 END), con.conname
             ) AS constraint_defs
         FROM
-            information_schema.table_constraints tc
-        JOIN
-            (
-                -- CONSTRAINT COLUMN NAMES
-                SELECT
-                    kcu.table_schema,
-                    kcu.table_name,
-                    kcu.constraint_name,
-                    string_agg(kcu.column_name, ', ' ORDER BY kcu.ordinal_position) AS column_names
-                FROM
-                    information_schema.key_column_usage kcu
-                GROUP BY
-                    kcu.table_schema, kcu.table_name, kcu.constraint_name
-            ) kcu ON tc.table_schema = kcu.table_schema AND tc.table_name = kcu.table_name AND tc.constraint_name = kcu.constraint_name
-        LEFT JOIN
-            information_schema.referential_constraints rc ON tc.constraint_name = rc.constraint_name
+            pg_constraint con
         WHERE
-            tc.constraint_type IN ('PRIMARY KEY', 'FOREIGN KEY', 'UNIQUE')
+            con.contype IN ('p', 'u', 'f', 'c')
         GROUP BY
-            tc.table_schema, tc.table_name
-    ) cnst ON clmn.table_schema = cnst.table_schema AND clmn.table_name = cnst.table_name
+            con.conrelid
+    ) cnst ON c.oid = cnst.conrelid
+WHERE
+    %s AND c.relkind IN ('r', 'p')
 ORDER BY
-    clmn.table_schema, clmn.table_name
+    n.nspname, c.relname
+;
+`
+	// MEMO: pg_get_viewdef returns the query with a trailing semicolon.
+	formatShowCreateAllViews = `-- CREATE VIEW
+SELECT
+    'CREATE ' || (CASE WHEN c.relkind = 'm' THEN 'MATERIALIZED ' ELSE '' END) || 'VIEW ' || quote_ident(n.nspname) || '.' || quote_ident(c.relname) || ' AS' || E'\n' ||
+    pg_get_viewdef(c.oid) AS create_statement
+FROM
+    pg_class c
+JOIN
+    pg_namespace n ON c.relnamespace = n.oid
+WHERE
+    %s AND c.relkind IN ('v', 'm')
+ORDER BY
+    c.oid
 ;
 `
 	// 	formatShowCreateAllIndexes = `-- CREATE INDEX
@@ -94,21 +166,51 @@ ORDER BY
 	// MEMO: PRIMARY KEY 以外にも UNIQUE 制約も INDEX として扱われるため PRIMARY KEY 以外も除外するようにした
 	formatShowCreateAllIndexes = `-- CREATE INDEX
 SELECT
-    indexdef AS create_statement
+    i.indexdef AS create_statement
 FROM
-    pg_indexes
+    pg_indexes i
 WHERE
-    schemaname = '%s' AND indexname NOT IN (
-        SELECT constraint_name
-        FROM information_schema.table_constraints
-        WHERE table_schema = '%s'
+    %s AND NOT EXISTS (
+        SELECT 1
+        FROM information_schema.table_constraints tc
+        WHERE tc.table_schema = i.schemaname AND tc.constraint_name = i.indexname
     )
+ORDER BY
+    i.schemaname, i.tablename, i.indexname
 ;
 `
 )
 
 type showCreateAllTablesConfig struct {
-	schema string
+	schemas        []string
+	excludeSchemas []string
+}
+
+// schemaCondition returns the SQL condition that column is one of the target schemas.
+// If no schema is specified, only the public schema is the target.
+// If only the excluded schemas are specified, all the schemas except them and the system schemas are the target.
+func (cfg *showCreateAllTablesConfig) schemaCondition(column string) string {
+	conditions := make([]string, 0)
+	switch {
+	case len(cfg.schemas) > 0:
+		conditions = append(conditions, column+" IN ("+quoteLiterals(cfg.schemas)+")")
+	case len(cfg.excludeSchemas) > 0:
+		conditions = append(conditions, column+" NOT IN ('pg_catalog', 'information_schema') AND "+column+" NOT LIKE 'pg\\_%'")
+	default:
+		conditions = append(conditions, column+" = 'public'")
+	}
+	if len(cfg.excludeSchemas) > 0 {
+		conditions = append(conditions, column+" NOT IN ("+quoteLiterals(cfg.excludeSchemas)+")")
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+func quoteLiterals(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, "'"+strings.ReplaceAll(v, "'", "''")+"'")
+	}
+	return strings.Join(quoted, ", ")
 }
 
 type ShowCreateAllTablesOption interface {
@@ -118,19 +220,40 @@ type ShowCreateAllTablesOption interface {
 type showCreateAllTablesOptionSchema struct{ schema string }
 
 func (o *showCreateAllTablesOptionSchema) apply(config *showCreateAllTablesConfig) {
-	config.schema = o.schema
+	config.schemas = []string{o.schema}
 }
 
 func WithShowCreateAllTablesOptionSchema(schema string) ShowCreateAllTablesOption { //nolint:ireturn
 	return &showCreateAllTablesOptionSchema{schema: schema}
 }
 
+type showCreateAllTablesOptionSchemas struct{ schemas []string }
+
+func (o *showCreateAllTablesOptionSchemas) apply(config *showCreateAllTablesConfig) {
+	config.schemas = append(config.schemas, o.schemas...)
+}
+
+// WithShowCreateAllTablesOptionSchemas shows the tables in the schemas instead of the public schema.
+func WithShowCreateAllTablesOptionSchemas(schemas ...string) ShowCreateAllTablesOption { //nolint:ireturn
+	return &showCreateAllTablesOptionSchemas{schemas: schemas}
+}
+
+type showCreateAllTablesOptionExcludeSchemas struct{ schemas []string }
+
+func (o *showCreateAllTablesOptionExcludeSchemas) apply(config *showCreateAllTablesConfig) {
+	config.excludeSchemas = append(config.excludeSchemas, o.schemas...)
+}
+
+// WithShowCreateAllTablesOptionExcludeSchemas does not show the tables in the schemas.
+// If it is specified without WithShowCreateAllTablesOptionSchemas, the tables in all the schemas except them are shown.
+func WithShowCreateAllTablesOptionExcludeSchemas(schemas ...string) ShowCreateAllTablesOption { //nolint:ireturn
+	return &showCreateAllTablesOptionExcludeSchemas{schemas: schemas}
+}
+
 func ShowCreateAllTables(ctx context.Context, db sqlQueryerContext, opts ...ShowCreateAllTablesOption) (query string, err error) {
 	dbz := sqlz.NewDB(db)
 
-	cfg := &showCreateAllTablesConfig{
-		schema: "public",
-	}
+	cfg := &showCreateAllTablesConfig{}
 	for _, opt := range opts {
 		opt.apply(cfg)
 	}
@@ -139,8 +262,35 @@ func ShowCreateAllTables(ctx context.Context, db sqlQueryerContext, opts ...Show
 		CreateStatement string `db:"create_statement"`
 	}
 
+	// MEMO: Schemas must be created before the objects in them.
+	createSchemaStmts := new([]*CreateStatement)
+	if err := dbz.QueryContext(ctx, createSchemaStmts, fmt.Sprintf(formatShowCreateAllSchemas, cfg.schemaCondition("n.nspname"))); err != nil {
+		return "", apperr.Errorf("dbz.QueryContext: %w", err)
+	}
+	for _, stmt := range *createSchemaStmts {
+		query += stmt.CreateStatement + "\n"
+	}
+
+	// MEMO: Types must be created before the tables that use them.
+	createTypeStmts := new([]*CreateStatement)
+	if err := dbz.QueryContext(ctx, createTypeStmts, fmt.Sprintf(formatShowCreateAllTypes, cfg.schemaCondition("n.nspname"))); err != nil {
+		return "", apperr.Errorf("dbz.QueryContext: %w", err)
+	}
+	for _, stmt := range *createTypeStmts {
+		query += stmt.CreateStatement + "\n"
+	}
+
+	// MEMO: Sequences must be created before the tables that use them.
+	createSequenceStmts := new([]*CreateStatement)
+	if err := dbz.QueryContext(ctx, createSequenceStmts, fmt.Sprintf(formatShowCreateAllSequences, cfg.schemaCondition("s.sequence_schema"))); err != nil {
+		return "", apperr.Errorf("dbz.QueryContext: %w", err)
+	}
+	for _, stmt := range *createSequenceStmts {
+		query += stmt.CreateStatement + "\n"
+	}
+
 	createTableStmts := new([]*CreateStatement)
-	if err := dbz.QueryContext(ctx, createTableStmts, fmt.Sprintf(formatShowCreateAllTables, cfg.schema)); err != nil {
+	if err := dbz.QueryContext(ctx, createTableStmts, fmt.Sprintf(formatShowCreateAllTables, cfg.schemaCondition("n.nspname"))); err != nil {
 		return "", apperr.Errorf("dbz.QueryContext: %w", err)
 	}
 	for _, stmt := range *createTableStmts {
@@ -148,12 +298,20 @@ func ShowCreateAllTables(ctx context.Context, db sqlQueryerContext, opts ...Show
 	}
 
 	createIndexStmts := new([]*CreateStatement)
-	if err := dbz.QueryContext(ctx, createIndexStmts, fmt.Sprintf(formatShowCreateAllIndexes, cfg.schema, cfg.schema)); err != nil {
+	if err := dbz.QueryContext(ctx, createIndexStmts, fmt.Sprintf(formatShowCreateAllIndexes, cfg.schemaCondition("i.schemaname"))); err != nil {
 		return "", apperr.Errorf("dbz.QueryContext: %w", err)
 	}
 	for _, stmt := range *createIndexStmts {
 		query += stmt.CreateStatement + ";\n"
 	}
 
+	createViewStmts := new([]*CreateStatement)
+	if err := dbz.QueryContext(ctx, createViewStmts, fmt.Sprintf(formatShowCreateAllViews, cfg.schemaCondition("n.nspname"))); err != nil {
+		return "", apperr.Errorf("dbz.QueryContext: %w", err)
+	}
+	for _, stmt := range *createViewStmts {
+		query += stmt.CreateStatement + "\n"
+	}
+
 	return query, nil
 }
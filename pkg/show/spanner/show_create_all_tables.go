@@ -4,6 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"regexp"
+	"sort"
+	"strings"
 
 	sqlz "github.com/kunitsucom/util.go/database/sql"
 
@@ -18,7 +21,8 @@ type sqlQueryerContext = interface {
 }
 
 const (
-	querySelectTableName = `SELECT TABLE_NAME FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = '';`
+	// MEMO: INFORMATION_SCHEMA.TABLES also has the views, so only the base tables are selected.
+	querySelectTableName = `SELECT TABLE_NAME FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA = '' AND TABLE_TYPE = 'BASE TABLE';`
 )
 
 type informationSchemaTable struct {
@@ -35,15 +39,18 @@ type informationSchemaTableOptionRowDeletionPolicy struct {
 }
 
 const (
-	queryShowCreateAllTables = `SELECT TABLE_NAME, COLUMN_NAME, COLUMN_DEFAULT, IS_NULLABLE, SPANNER_TYPE FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_NAME = ? ORDER BY TABLE_NAME, ORDINAL_POSITION;`
+	queryShowCreateAllTables = `SELECT TABLE_NAME, COLUMN_NAME, COLUMN_DEFAULT, IS_NULLABLE, SPANNER_TYPE, GENERATION_EXPRESSION, IS_STORED, IS_HIDDEN FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_NAME = ? ORDER BY TABLE_NAME, ORDINAL_POSITION;`
 )
 
 type informationSchemaColumn struct {
-	TableName     string  `db:"TABLE_NAME"`
-	ColumnName    string  `db:"COLUMN_NAME"`
-	ColumnDefault *string `db:"COLUMN_DEFAULT"`
-	IsNullable    string  `db:"IS_NULLABLE"`
-	SpannerType   string  `db:"SPANNER_TYPE"`
+	TableName            string  `db:"TABLE_NAME"`
+	ColumnName           string  `db:"COLUMN_NAME"`
+	ColumnDefault        *string `db:"COLUMN_DEFAULT"`
+	IsNullable           string  `db:"IS_NULLABLE"`
+	SpannerType          string  `db:"SPANNER_TYPE"`
+	GenerationExpression *string `db:"GENERATION_EXPRESSION"`
+	IsStored             *string `db:"IS_STORED"`
+	IsHidden             bool    `db:"IS_HIDDEN"`
 }
 
 func (c *informationSchemaColumn) String() string {
@@ -54,6 +61,15 @@ func (c *informationSchemaColumn) String() string {
 	if c.IsNullable == "NO" {
 		d += " NOT NULL"
 	}
+	if c.GenerationExpression != nil {
+		d += fmt.Sprintf(" AS (%s)", *c.GenerationExpression)
+		if c.IsStored != nil && *c.IsStored == "YES" {
+			d += " STORED"
+		}
+	}
+	if c.IsHidden {
+		d += " HIDDEN"
+	}
 	return d
 }
 
@@ -106,46 +122,168 @@ type informationSchemaPrimaryKey struct {
 }
 
 const (
-	querySelectIndexes = `SELECT DISTINCT INDEX_NAME, IS_UNIQUE FROM INFORMATION_SCHEMA.INDEXES WHERE TABLE_NAME = ? AND INDEX_TYPE != "PRIMARY_KEY";`
+	querySelectIndexes = `SELECT INDEX_NAME, IS_UNIQUE, IS_NULL_FILTERED, PARENT_TABLE_NAME FROM INFORMATION_SCHEMA.INDEXES WHERE TABLE_SCHEMA = '' AND TABLE_NAME = ? AND INDEX_TYPE = "INDEX" ORDER BY INDEX_NAME;`
 )
 
 type informationSchemaIndexName struct {
-	IndexName string `db:"INDEX_NAME"`
-	IsUnique  bool   `db:"IS_UNIQUE"`
+	// INDEXES https://cloud.google.com/spanner/docs/information-schema?hl=ja#indexes
+	IndexName       string `db:"INDEX_NAME"`
+	IsUnique        bool   `db:"IS_UNIQUE"`
+	IsNullFiltered  bool   `db:"IS_NULL_FILTERED"`
+	ParentTableName string `db:"PARENT_TABLE_NAME"`
 }
 
 const (
+	// MEMO: The stored columns have a NULL ORDINAL_POSITION and COLUMN_ORDERING, and they are sorted first.
 	queryShowIndexes = `-- SHOW INDEXES
 SELECT
-    ic.INDEX_NAME,
-    i.INDEX_TYPE,
-    ic.COLUMN_NAME,
-    ic.COLUMN_ORDERING,
-    ic.ORDINAL_POSITION
+    COLUMN_NAME,
+    COLUMN_ORDERING,
+    ORDINAL_POSITION
 FROM
-    INFORMATION_SCHEMA.INDEXES AS i
-INNER JOIN
-    INFORMATION_SCHEMA.INDEX_COLUMNS AS ic
-ON
-    i.TABLE_NAME = ic.TABLE_NAME
+    INFORMATION_SCHEMA.INDEX_COLUMNS
 WHERE
-    i.TABLE_NAME = ? 
-    AND i.INDEX_TYPE != "PRIMARY_KEY" 
-    AND ic.INDEX_NAME != "PRIMARY_KEY" 
+    TABLE_SCHEMA = ''
+    AND TABLE_NAME = ?
+    AND INDEX_NAME = ?
 ORDER BY
-    i.TABLE_NAME, ic.INDEX_NAME, ic.ORDINAL_POSITION
+    ORDINAL_POSITION, COLUMN_NAME
 ;
 `
 )
 
 type informationSchemaIndex struct {
-	// INDEXES https://cloud.google.com/spanner/docs/information-schema?hl=ja#indexes
 	// INDEX_COLUMNS https://cloud.google.com/spanner/docs/information-schema?hl=ja#index_columns
-	IndexName       string `db:"INDEX_NAME"`
-	IndexType       string `db:"INDEX_TYPE"`
-	ColumnName      string `db:"COLUMN_NAME"`
-	ColumnOrdering  string `db:"COLUMN_ORDERING"`
-	OrdinalPosition int    `db:"ORDINAL_POSITION"`
+	ColumnName      string  `db:"COLUMN_NAME"`
+	ColumnOrdering  *string `db:"COLUMN_ORDERING"`
+	OrdinalPosition *int64  `db:"ORDINAL_POSITION"`
+}
+
+const (
+	querySelectProtoTypes = `SELECT DISTINCT SPANNER_TYPE FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA = '' AND REGEXP_CONTAINS(SPANNER_TYPE, r'(PROTO|ENUM)<') ORDER BY SPANNER_TYPE;`
+)
+
+type informationSchemaProtoType struct {
+	SpannerType string `db:"SPANNER_TYPE"`
+}
+
+// protoTypeNameRegex extracts the type name from the data type such as PROTO<my.package.Message> or ARRAY<ENUM<my.package.Enum>>.
+//
+//nolint:gochecknoglobals
+var protoTypeNameRegex = regexp.MustCompile(`(?:PROTO|ENUM)<([^<>]+)>`)
+
+const (
+	querySelectSequences = `SELECT NAME FROM INFORMATION_SCHEMA.SEQUENCES WHERE SCHEMA = '' ORDER BY NAME;`
+)
+
+type informationSchemaSequence struct {
+	// SEQUENCES https://cloud.google.com/spanner/docs/information-schema?hl=ja#sequences
+	Name string `db:"NAME"`
+}
+
+const (
+	queryShowSequenceOptions = `SELECT OPTION_NAME, OPTION_TYPE, OPTION_VALUE FROM INFORMATION_SCHEMA.SEQUENCE_OPTIONS WHERE SCHEMA = '' AND NAME = ? ORDER BY OPTION_NAME;`
+)
+
+type informationSchemaOption struct {
+	// SEQUENCE_OPTIONS https://cloud.google.com/spanner/docs/information-schema?hl=ja#sequence_options
+	// CHANGE_STREAM_OPTIONS https://cloud.google.com/spanner/docs/information-schema?hl=ja#change_stream_options
+	// MODEL_OPTIONS https://cloud.google.com/spanner/docs/information-schema?hl=ja#model_options
+	OptionName  string `db:"OPTION_NAME"`
+	OptionType  string `db:"OPTION_TYPE"`
+	OptionValue string `db:"OPTION_VALUE"`
+}
+
+func (o *informationSchemaOption) String() string {
+	if o.OptionType == "STRING" {
+		return fmt.Sprintf("%s = '%s'", o.OptionName, o.OptionValue)
+	}
+	return fmt.Sprintf("%s = %s", o.OptionName, o.OptionValue)
+}
+
+// optionsString returns the options such as " OPTIONS (name = value, ...)", or "" if there is no option.
+func optionsString(options []*informationSchemaOption) string {
+	if len(options) == 0 {
+		return ""
+	}
+	d := " OPTIONS ("
+	optionsLastIndex := len(options) - 1
+	for i, opt := range options {
+		d += opt.String()
+		if i != optionsLastIndex {
+			d += ", "
+		}
+	}
+	d += ")"
+	return d
+}
+
+const (
+	// MEMO: INFORMATION_SCHEMA does not have PARTITION BY, ORDER BY and OPTIONS of the search index,
+	// so only the TOKENLIST columns and the stored columns are shown.
+	querySelectSearchIndexes = `SELECT INDEX_NAME, PARENT_TABLE_NAME FROM INFORMATION_SCHEMA.INDEXES WHERE TABLE_SCHEMA = '' AND TABLE_NAME = ? AND INDEX_TYPE = "SEARCH" ORDER BY INDEX_NAME;`
+	queryShowSearchIndexes   = `SELECT COLUMN_NAME, ORDINAL_POSITION, SPANNER_TYPE FROM INFORMATION_SCHEMA.INDEX_COLUMNS WHERE TABLE_SCHEMA = '' AND TABLE_NAME = ? AND INDEX_NAME = ? ORDER BY ORDINAL_POSITION, COLUMN_NAME;`
+)
+
+type informationSchemaSearchIndexColumn struct {
+	// INDEX_COLUMNS https://cloud.google.com/spanner/docs/information-schema?hl=ja#index_columns
+	ColumnName      string  `db:"COLUMN_NAME"`
+	OrdinalPosition *int64  `db:"ORDINAL_POSITION"`
+	SpannerType     *string `db:"SPANNER_TYPE"`
+}
+
+const (
+	querySelectViews = `SELECT TABLE_NAME, VIEW_DEFINITION, SECURITY_TYPE FROM INFORMATION_SCHEMA.VIEWS WHERE TABLE_SCHEMA = '' ORDER BY TABLE_NAME;`
+)
+
+type informationSchemaView struct {
+	// VIEWS https://cloud.google.com/spanner/docs/information-schema?hl=ja#views
+	TableName      string `db:"TABLE_NAME"`
+	ViewDefinition string `db:"VIEW_DEFINITION"`
+	SecurityType   string `db:"SECURITY_TYPE"`
+}
+
+const (
+	querySelectChangeStreams     = `SELECT CHANGE_STREAM_NAME, ` + "`ALL`" + ` FROM INFORMATION_SCHEMA.CHANGE_STREAMS WHERE CHANGE_STREAM_SCHEMA = '' ORDER BY CHANGE_STREAM_NAME;`
+	queryShowChangeStreamTables  = `SELECT TABLE_NAME, ALL_COLUMNS FROM INFORMATION_SCHEMA.CHANGE_STREAM_TABLES WHERE CHANGE_STREAM_SCHEMA = '' AND CHANGE_STREAM_NAME = ? ORDER BY TABLE_NAME;`
+	queryShowChangeStreamColumns = `SELECT TABLE_NAME, COLUMN_NAME FROM INFORMATION_SCHEMA.CHANGE_STREAM_COLUMNS WHERE CHANGE_STREAM_SCHEMA = '' AND CHANGE_STREAM_NAME = ? ORDER BY TABLE_NAME, COLUMN_NAME;`
+	queryShowChangeStreamOptions = `SELECT OPTION_NAME, OPTION_TYPE, OPTION_VALUE FROM INFORMATION_SCHEMA.CHANGE_STREAM_OPTIONS WHERE CHANGE_STREAM_SCHEMA = '' AND CHANGE_STREAM_NAME = ? ORDER BY OPTION_NAME;`
+)
+
+type informationSchemaChangeStream struct {
+	// CHANGE_STREAMS https://cloud.google.com/spanner/docs/information-schema?hl=ja#change_streams
+	ChangeStreamName string `db:"CHANGE_STREAM_NAME"`
+	All              bool   `db:"ALL"`
+}
+
+type informationSchemaChangeStreamTable struct {
+	// CHANGE_STREAM_TABLES https://cloud.google.com/spanner/docs/information-schema?hl=ja#change_stream_tables
+	TableName  string `db:"TABLE_NAME"`
+	AllColumns bool   `db:"ALL_COLUMNS"`
+}
+
+type informationSchemaChangeStreamColumn struct {
+	// CHANGE_STREAM_COLUMNS https://cloud.google.com/spanner/docs/information-schema?hl=ja#change_stream_columns
+	TableName  string `db:"TABLE_NAME"`
+	ColumnName string `db:"COLUMN_NAME"`
+}
+
+const (
+	querySelectModels     = `SELECT MODEL_NAME FROM INFORMATION_SCHEMA.MODELS WHERE MODEL_SCHEMA = '' ORDER BY MODEL_NAME;`
+	queryShowModelColumns = `SELECT COLUMN_KIND, COLUMN_NAME, DATA_TYPE FROM INFORMATION_SCHEMA.MODEL_COLUMNS WHERE MODEL_SCHEMA = '' AND MODEL_NAME = ? ORDER BY COLUMN_KIND, ORDINAL_POSITION;`
+	queryShowModelOptions = `SELECT OPTION_NAME, OPTION_TYPE, OPTION_VALUE FROM INFORMATION_SCHEMA.MODEL_OPTIONS WHERE MODEL_SCHEMA = '' AND MODEL_NAME = ? ORDER BY OPTION_NAME;`
+)
+
+type informationSchemaModel struct {
+	// MODELS https://cloud.google.com/spanner/docs/information-schema?hl=ja#models
+	ModelName string `db:"MODEL_NAME"`
+}
+
+type informationSchemaModelColumn struct {
+	// MODEL_COLUMNS https://cloud.google.com/spanner/docs/information-schema?hl=ja#model_columns
+	ColumnKind string `db:"COLUMN_KIND"` // INPUT or OUTPUT
+	ColumnName string `db:"COLUMN_NAME"`
+	DataType   string `db:"DATA_TYPE"`
 }
 
 type showCreateAllTablesConfig struct {
@@ -177,11 +315,57 @@ func ShowCreateAllTables(ctx context.Context, db sqlQueryerContext, opts ...Show
 		opt.apply(cfg)
 	}
 
+	// PROTO BUNDLE
+	// MEMO: INFORMATION_SCHEMA does not have the proto bundle, so it is derived from the types of the columns.
+	// The types that are not used by any column are not shown.
+	protoTypes := make([]*informationSchemaProtoType, 0)
+	if err := dbz.QueryContext(ctx, &protoTypes, querySelectProtoTypes); err != nil {
+		return "", apperr.Errorf("dbz.QueryContext: %w", err)
+	}
+
+	protoTypeNames := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, t := range protoTypes {
+		for _, m := range protoTypeNameRegex.FindAllStringSubmatch(t.SpannerType, -1) {
+			if !seen[m[1]] {
+				seen[m[1]] = true
+				protoTypeNames = append(protoTypeNames, m[1])
+			}
+		}
+	}
+	if len(protoTypeNames) > 0 {
+		sort.Strings(protoTypeNames)
+		query += "CREATE PROTO BUNDLE (\n    " + strings.Join(protoTypeNames, ",\n    ") + "\n);\n\n"
+	}
+
+	// SEQUENCE
+	// MEMO: Sequences must be created before the tables that use them.
+	sequences := make([]*informationSchemaSequence, 0)
+	if err := dbz.QueryContext(ctx, &sequences, querySelectSequences); err != nil {
+		return "", apperr.Errorf("dbz.QueryContext: %w", err)
+	}
+
+	for _, seq := range sequences {
+		sequenceOptions := make([]*informationSchemaOption, 0)
+		if err := dbz.QueryContext(ctx, &sequenceOptions, queryShowSequenceOptions, seq.Name); err != nil {
+			return "", apperr.Errorf("dbz.QueryContext: %w", err)
+		}
+
+		d := fmt.Sprintf("CREATE SEQUENCE %s", seq.Name) + optionsString(sequenceOptions)
+
+		// append sequence
+		query += d + ";\n"
+	}
+
 	tables := make([]*informationSchemaTable, 0)
 	if err := dbz.QueryContext(ctx, &tables, querySelectTableName); err != nil {
 		return "", apperr.Errorf("dbz.QueryContext: %w", err)
 	}
 
+	if len(sequences) > 0 && len(tables) > 0 {
+		query += "\n"
+	}
+
 	tablesLastIndex := len(tables) - 1
 	for tblIdx, tbl := range tables {
 		// TABLE
@@ -268,26 +452,75 @@ func ShowCreateAllTables(ctx context.Context, db sqlQueryerContext, opts ...Show
 
 		for _, indexName := range indexNames {
 			indexes := make([]*informationSchemaIndex, 0)
-			if err := dbz.QueryContext(ctx, &indexes, queryShowIndexes, tbl.TableName); err != nil {
+			if err := dbz.QueryContext(ctx, &indexes, queryShowIndexes, tbl.TableName, indexName.IndexName); err != nil {
 				return "", apperr.Errorf("dbz.QueryContext: %w", err)
 			}
 
+			keyColumns, storingColumns := make([]string, 0), make([]string, 0)
+			for _, idx := range indexes {
+				if idx.OrdinalPosition == nil {
+					storingColumns = append(storingColumns, idx.ColumnName)
+					continue
+				}
+				if idx.ColumnOrdering != nil && *idx.ColumnOrdering == "DESC" {
+					keyColumns = append(keyColumns, idx.ColumnName+" DESC")
+					continue
+				}
+				keyColumns = append(keyColumns, idx.ColumnName)
+			}
+
 			d := "CREATE "
 			if indexName.IsUnique {
 				d += "UNIQUE "
 			}
-			d += fmt.Sprintf("INDEX %s ON %s (", indexName.IndexName, tbl.TableName)
+			if indexName.IsNullFiltered {
+				d += "NULL_FILTERED "
+			}
+			d += fmt.Sprintf("INDEX %s ON %s (%s)", indexName.IndexName, tbl.TableName, strings.Join(keyColumns, ", "))
+			if len(storingColumns) > 0 {
+				d += fmt.Sprintf(" STORING (%s)", strings.Join(storingColumns, ", "))
+			}
+			if indexName.ParentTableName != "" {
+				d += ", INTERLEAVE IN " + indexName.ParentTableName
+			}
+			d += ";\n"
 
-			indexesLastIndex := len(indexes) - 1
-			for i, idx := range indexes {
-				d += idx.ColumnName
-				if i != indexesLastIndex {
-					d += ", "
+			// append index
+			query += d
+		}
+
+		// SEARCH INDEX
+		searchIndexNames := make([]*informationSchemaIndexName, 0)
+		if err := dbz.QueryContext(ctx, &searchIndexNames, querySelectSearchIndexes, tbl.TableName); err != nil {
+			return "", apperr.Errorf("dbz.QueryContext: %w", err)
+		}
+
+		for _, indexName := range searchIndexNames {
+			indexColumns := make([]*informationSchemaSearchIndexColumn, 0)
+			if err := dbz.QueryContext(ctx, &indexColumns, queryShowSearchIndexes, tbl.TableName, indexName.IndexName); err != nil {
+				return "", apperr.Errorf("dbz.QueryContext: %w", err)
+			}
+
+			tokenlistColumns, storingColumns := make([]string, 0), make([]string, 0)
+			for _, idx := range indexColumns {
+				switch {
+				case idx.OrdinalPosition == nil:
+					storingColumns = append(storingColumns, idx.ColumnName)
+				case idx.SpannerType != nil && *idx.SpannerType == "TOKENLIST":
+					tokenlistColumns = append(tokenlistColumns, idx.ColumnName)
 				}
 			}
-			d += ");\n"
 
-			// append index
+			d := fmt.Sprintf("CREATE SEARCH INDEX %s ON %s (%s)", indexName.IndexName, tbl.TableName, strings.Join(tokenlistColumns, ", "))
+			if len(storingColumns) > 0 {
+				d += fmt.Sprintf(" STORING (%s)", strings.Join(storingColumns, ", "))
+			}
+			if indexName.ParentTableName != "" {
+				d += ", INTERLEAVE IN " + indexName.ParentTableName
+			}
+			d += ";\n"
+
+			// append search index
 			query += d
 		}
 
@@ -296,5 +529,120 @@ func ShowCreateAllTables(ctx context.Context, db sqlQueryerContext, opts ...Show
 		}
 	}
 
+	// MEMO: Views, change streams and models refer to the tables, so they are shown after the tables.
+	others, err := showCreateOthers(ctx, dbz)
+	if err != nil {
+		return "", apperr.Errorf("showCreateOthers: %w", err)
+	}
+	if query != "" && others != "" {
+		query += "\n"
+	}
+	query += others
+
+	return query, nil
+}
+
+//nolint:cyclop,funlen
+func showCreateOthers(ctx context.Context, dbz sqlz.QueryerContext) (query string, err error) {
+	// VIEW
+	views := make([]*informationSchemaView, 0)
+	if err := dbz.QueryContext(ctx, &views, querySelectViews); err != nil {
+		return "", apperr.Errorf("dbz.QueryContext: %w", err)
+	}
+
+	for _, view := range views {
+		d := fmt.Sprintf("CREATE VIEW %s", view.TableName)
+		if view.SecurityType != "" {
+			d += " SQL SECURITY " + view.SecurityType
+		}
+		d += " AS " + view.ViewDefinition
+
+		// append view
+		query += d + ";\n"
+	}
+
+	// CHANGE STREAM
+	changeStreams := make([]*informationSchemaChangeStream, 0)
+	if err := dbz.QueryContext(ctx, &changeStreams, querySelectChangeStreams); err != nil {
+		return "", apperr.Errorf("dbz.QueryContext: %w", err)
+	}
+
+	for _, cs := range changeStreams {
+		d := fmt.Sprintf("CREATE CHANGE STREAM %s", cs.ChangeStreamName)
+		if cs.All {
+			d += " FOR ALL"
+		} else {
+			changeStreamTables := make([]*informationSchemaChangeStreamTable, 0)
+			if err := dbz.QueryContext(ctx, &changeStreamTables, queryShowChangeStreamTables, cs.ChangeStreamName); err != nil {
+				return "", apperr.Errorf("dbz.QueryContext: %w", err)
+			}
+			changeStreamColumns := make([]*informationSchemaChangeStreamColumn, 0)
+			if err := dbz.QueryContext(ctx, &changeStreamColumns, queryShowChangeStreamColumns, cs.ChangeStreamName); err != nil {
+				return "", apperr.Errorf("dbz.QueryContext: %w", err)
+			}
+
+			watches := make([]string, 0, len(changeStreamTables))
+			for _, tbl := range changeStreamTables {
+				if tbl.AllColumns {
+					watches = append(watches, tbl.TableName)
+					continue
+				}
+				columns := make([]string, 0)
+				for _, col := range changeStreamColumns {
+					if col.TableName == tbl.TableName {
+						columns = append(columns, col.ColumnName)
+					}
+				}
+				watches = append(watches, tbl.TableName+"("+strings.Join(columns, ", ")+")")
+			}
+			if len(watches) > 0 {
+				d += " FOR " + strings.Join(watches, ", ")
+			}
+		}
+
+		changeStreamOptions := make([]*informationSchemaOption, 0)
+		if err := dbz.QueryContext(ctx, &changeStreamOptions, queryShowChangeStreamOptions, cs.ChangeStreamName); err != nil {
+			return "", apperr.Errorf("dbz.QueryContext: %w", err)
+		}
+		d += optionsString(changeStreamOptions)
+
+		// append change stream
+		query += d + ";\n"
+	}
+
+	// MODEL
+	models := make([]*informationSchemaModel, 0)
+	if err := dbz.QueryContext(ctx, &models, querySelectModels); err != nil {
+		return "", apperr.Errorf("dbz.QueryContext: %w", err)
+	}
+
+	for _, model := range models {
+		modelColumns := make([]*informationSchemaModelColumn, 0)
+		if err := dbz.QueryContext(ctx, &modelColumns, queryShowModelColumns, model.ModelName); err != nil {
+			return "", apperr.Errorf("dbz.QueryContext: %w", err)
+		}
+
+		inputColumns, outputColumns := make([]string, 0), make([]string, 0)
+		for _, col := range modelColumns {
+			switch col.ColumnKind {
+			case "INPUT":
+				inputColumns = append(inputColumns, col.ColumnName+" "+col.DataType)
+			case "OUTPUT":
+				outputColumns = append(outputColumns, col.ColumnName+" "+col.DataType)
+			}
+		}
+
+		modelOptions := make([]*informationSchemaOption, 0)
+		if err := dbz.QueryContext(ctx, &modelOptions, queryShowModelOptions, model.ModelName); err != nil {
+			return "", apperr.Errorf("dbz.QueryContext: %w", err)
+		}
+
+		d := fmt.Sprintf("CREATE MODEL %s INPUT (%s) OUTPUT (%s) REMOTE", model.ModelName, strings.Join(inputColumns, ", "), strings.Join(outputColumns, ", "))
+		d += optionsString(modelOptions)
+
+		// append model
+		query += d + ";\n"
+	}
+
 	return query, nil
 }
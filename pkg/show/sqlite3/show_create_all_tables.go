@@ -0,0 +1,72 @@
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	sqlz "github.com/kunitsucom/util.go/database/sql"
+
+	apperr "github.com/kunitsucom/ddlctl/pkg/apperr"
+)
+
+type sqlQueryerContext = interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// MEMO: https://www.sqlite.org/schematab.html
+// MEMO: The sql column is NULL for the automatically created indexes (e.g. sqlite_autoindex_*), so they are excluded.
+const formatShowCreateAllTables = `-- CREATE TABLE, CREATE INDEX
+SELECT
+    sql AS create_statement
+FROM
+    %s.sqlite_master
+WHERE
+    type IN ('table', 'index') AND name NOT LIKE 'sqlite_%%' AND sql IS NOT NULL
+ORDER BY
+    CASE type WHEN 'table' THEN 0 ELSE 1 END, rowid
+;
+`
+
+type showCreateAllTablesConfig struct {
+	schema string
+}
+
+type ShowCreateAllTablesOption interface {
+	apply(cfg *showCreateAllTablesConfig)
+}
+
+type showCreateAllTablesOptionSchema struct{ schema string }
+
+func (o *showCreateAllTablesOptionSchema) apply(config *showCreateAllTablesConfig) {
+	config.schema = o.schema
+}
+
+func WithShowCreateAllTablesOptionSchema(schema string) ShowCreateAllTablesOption { //nolint:ireturn
+	return &showCreateAllTablesOptionSchema{schema: schema}
+}
+
+func ShowCreateAllTables(ctx context.Context, db sqlQueryerContext, opts ...ShowCreateAllTablesOption) (query string, err error) {
+	dbz := sqlz.NewDB(db)
+
+	cfg := &showCreateAllTablesConfig{
+		schema: "main",
+	}
+	for _, opt := range opts {
+		opt.apply(cfg)
+	}
+
+	type CreateStatement struct {
+		CreateStatement string `db:"create_statement"`
+	}
+
+	createStmts := new([]*CreateStatement)
+	if err := dbz.QueryContext(ctx, createStmts, fmt.Sprintf(formatShowCreateAllTables, cfg.schema)); err != nil {
+		return "", apperr.Errorf("dbz.QueryContext: %w", err)
+	}
+	for _, stmt := range *createStmts {
+		query += stmt.CreateStatement + ";\n"
+	}
+
+	return query, nil
+}